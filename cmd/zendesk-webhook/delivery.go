@@ -0,0 +1,214 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/nukosuke/go-zendesk/zendesk/webhook"
+)
+
+// replayedHeaders are the request headers recorded and sent again on replay.
+// The signature headers are handled separately.
+var replayedHeaders = []string{"Content-Type", "User-Agent", "X-Zendesk-Account-Id", "X-Zendesk-Webhook-Id", "X-Zendesk-Webhook-Invocation-Id"}
+
+// Delivery is a webhook request stored as a line of a JSONL file
+type Delivery struct {
+	ID         string            `json:"id"`
+	ReceivedAt time.Time         `json:"received_at"`
+	Method     string            `json:"method"`
+	Path       string            `json:"path"`
+	Header     map[string]string `json:"header,omitempty"`
+	Signature  string            `json:"signature"`
+	Timestamp  string            `json:"timestamp"`
+	Body       string            `json:"body"`
+}
+
+// EventType returns the type of the event delivered, or "-" if the body is not an event
+func (d *Delivery) EventType() string {
+	var e webhook.EventHeader
+	if err := json.Unmarshal([]byte(d.Body), &e); err != nil || e.Type == "" {
+		return "-"
+	}
+	return e.Type
+}
+
+// recorder is a http.Handler verifying requests and writing them to w
+type recorder struct {
+	verifier *webhook.Verifier
+	now      func() time.Time
+	logf     func(format string, args ...interface{})
+
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func newRecorder(v *webhook.Verifier, w io.Writer) *recorder {
+	return &recorder{
+		verifier: v,
+		now:      time.Now,
+		logf:     func(string, ...interface{}) {},
+		w:        w,
+	}
+}
+
+func (rec *recorder) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := rec.verifier.VerifyRequest(r)
+	if errors.Is(err, webhook.ErrReplayed) {
+		rec.logf("ignored replayed request %s", r.Header.Get(webhook.SignatureHeader))
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	if err != nil {
+		rec.logf("rejected %s %s: %s", r.Method, r.URL.Path, err)
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	d := Delivery{
+		ID:         newDeliveryID(),
+		ReceivedAt: rec.now().UTC(),
+		Method:     r.Method,
+		Path:       r.URL.RequestURI(),
+		Header:     make(map[string]string),
+		Signature:  r.Header.Get(webhook.SignatureHeader),
+		Timestamp:  r.Header.Get(webhook.SignatureTimestampHeader),
+		Body:       string(body),
+	}
+	for _, name := range replayedHeaders {
+		if value := r.Header.Get(name); value != "" {
+			d.Header[name] = value
+		}
+	}
+
+	// json.Encoder keeps failing after a write error, so each delivery is encoded on its own
+	line, err := json.Marshal(d)
+	if err == nil {
+		rec.mu.Lock()
+		_, err = rec.w.Write(append(line, '\n'))
+		rec.mu.Unlock()
+	}
+	if err != nil {
+		rec.logf("failed to write delivery: %s", err)
+		// the delivery wasn't recorded, so its retry by Zendesk must not be ignored as replayed
+		rec.verifier.Forget(r)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	rec.logf("recorded %s %s", d.ID, d.EventType())
+	w.WriteHeader(http.StatusOK)
+}
+
+func newDeliveryID() string {
+	b := make([]byte, 8)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// readDeliveries reads the deliveries of a JSONL file. Empty lines are skipped.
+func readDeliveries(r io.Reader) ([]Delivery, error) {
+	var deliveries []Delivery
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+		var d Delivery
+		if err := json.Unmarshal(scanner.Bytes(), &d); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		deliveries = append(deliveries, d)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+// replayer sends recorded deliveries to URL
+type replayer struct {
+	URL string
+
+	// Secret signs the deliveries again with the current time. The recorded
+	// signatures are sent if it's empty.
+	Secret string
+
+	// Delay is the time to wait between deliveries
+	Delay time.Duration
+
+	Client *http.Client
+	now    func() time.Time
+}
+
+// replayResult is the response to a replayed delivery
+type replayResult struct {
+	Delivery   Delivery
+	StatusCode int
+	Duration   time.Duration
+}
+
+// Replay sends the deliveries in order. It stops at the first request which
+// can't be sent, or which gets a response other than 2xx.
+func (r *replayer) Replay(ctx context.Context, deliveries []Delivery) ([]replayResult, error) {
+	now := r.now
+	if now == nil {
+		now = time.Now
+	}
+
+	var results []replayResult
+	for i, d := range deliveries {
+		if i > 0 && r.Delay > 0 {
+			select {
+			case <-ctx.Done():
+				return results, ctx.Err()
+			case <-time.After(r.Delay):
+			}
+		}
+
+		method := d.Method
+		if method == "" {
+			method = http.MethodPost
+		}
+		req, err := http.NewRequestWithContext(ctx, method, r.URL, bytes.NewReader([]byte(d.Body)))
+		if err != nil {
+			return results, err
+		}
+		for name, value := range d.Header {
+			req.Header.Set(name, value)
+		}
+
+		signature, timestamp := d.Signature, d.Timestamp
+		if r.Secret != "" {
+			timestamp = now().UTC().Format(time.RFC3339)
+			signature = webhook.Sign(r.Secret, timestamp, []byte(d.Body))
+		}
+		req.Header.Set(webhook.SignatureHeader, signature)
+		req.Header.Set(webhook.SignatureTimestampHeader, timestamp)
+
+		start := time.Now()
+		resp, err := r.Client.Do(req)
+		if err != nil {
+			return results, fmt.Errorf("delivery %s: %w", d.ID, err)
+		}
+		io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+
+		results = append(results, replayResult{Delivery: d, StatusCode: resp.StatusCode, Duration: time.Since(start)})
+		if resp.StatusCode < 200 || resp.StatusCode > 299 {
+			return results, fmt.Errorf("delivery %s: unexpected status %s", d.ID, resp.Status)
+		}
+	}
+	return results, nil
+}
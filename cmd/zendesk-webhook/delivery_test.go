@@ -0,0 +1,124 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/nukosuke/go-zendesk/zendesk/webhook"
+)
+
+var (
+	testBody = []byte(`{"type":"zen:event-type:ticket.created","detail":{"id":"35436"},"event":{}}`)
+	testNow  = func() time.Time { return time.Date(2021, 10, 20, 8, 17, 0, 0, time.UTC) }
+)
+
+func newSignedRequest(secret string, timestamp string, body []byte) *http.Request {
+	r := httptest.NewRequest(http.MethodPost, "/zendesk", bytes.NewReader(body))
+	r.Header.Set("Content-Type", "application/json")
+	r.Header.Set(webhook.SignatureHeader, webhook.Sign(secret, timestamp, body))
+	r.Header.Set(webhook.SignatureTimestampHeader, timestamp)
+	return r
+}
+
+// failingWriter fails the first write
+type failingWriter struct {
+	bytes.Buffer
+	failed bool
+}
+
+func (w *failingWriter) Write(p []byte) (int, error) {
+	if !w.failed {
+		w.failed = true
+		return 0, errors.New("disk is full")
+	}
+	return w.Buffer.Write(p)
+}
+
+func TestRecordRetryAfterWriteFailure(t *testing.T) {
+	var out failingWriter
+	rec := newRecorder(&webhook.Verifier{Secret: "recorded", Replay: webhook.NewMemoryReplayCache(), Now: testNow}, &out)
+
+	expected := []int{http.StatusInternalServerError, http.StatusOK, http.StatusOK}
+	for i, status := range expected {
+		w := httptest.NewRecorder()
+		rec.ServeHTTP(w, newSignedRequest("recorded", "2021-10-20T08:16:28Z", testBody))
+		if w.Code != status {
+			t.Errorf("request %d: expected status is %d, but got %d", i, status, w.Code)
+		}
+	}
+
+	deliveries, err := readDeliveries(&out.Buffer)
+	if err != nil {
+		t.Fatalf("Failed to read deliveries: %s", err)
+	}
+	if len(deliveries) != 1 {
+		t.Fatalf("expected length of deliveries is 1, but got %d", len(deliveries))
+	}
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	var out bytes.Buffer
+	rec := newRecorder(&webhook.Verifier{Secret: "recorded", Now: testNow}, &out)
+
+	cases := []struct {
+		name     string
+		request  *http.Request
+		expected int
+	}{
+		{"valid", newSignedRequest("recorded", "2021-10-20T08:16:28Z", testBody), http.StatusOK},
+		{"invalid signature", newSignedRequest("other", "2021-10-20T08:16:28Z", testBody), http.StatusUnauthorized},
+		{"expired", newSignedRequest("recorded", "2021-10-20T07:00:00Z", testBody), http.StatusUnauthorized},
+	}
+	for _, c := range cases {
+		w := httptest.NewRecorder()
+		rec.ServeHTTP(w, c.request)
+		if w.Code != c.expected {
+			t.Errorf("%s: expected status is %d, but got %d", c.name, c.expected, w.Code)
+		}
+	}
+
+	deliveries, err := readDeliveries(&out)
+	if err != nil {
+		t.Fatalf("Failed to read deliveries: %s", err)
+	}
+	if len(deliveries) != 1 {
+		t.Fatalf("expected length of deliveries is 1, but got %d", len(deliveries))
+	}
+	if d := deliveries[0]; d.Body != string(testBody) || d.EventType() != "zen:event-type:ticket.created" {
+		t.Fatalf("unexpected delivery %+v", d)
+	}
+
+	replayNow := func() time.Time { return time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC) }
+	received := 0
+	server := httptest.NewServer((&webhook.Verifier{Secret: "replayed", Now: replayNow}).Middleware(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			received++
+			body, _ := io.ReadAll(r.Body)
+			if !bytes.Equal(body, testBody) || r.Header.Get("Content-Type") != "application/json" {
+				t.Errorf("unexpected request %s %v", body, r.Header)
+			}
+		}),
+	))
+	defer server.Close()
+
+	r := &replayer{URL: server.URL, Secret: "replayed", Client: server.Client(), now: replayNow}
+	results, err := r.Replay(context.Background(), deliveries)
+	if err != nil {
+		t.Fatalf("Failed to replay: %s", err)
+	}
+	if received != 1 || len(results) != 1 || results[0].StatusCode != http.StatusOK {
+		t.Fatalf("unexpected results %+v", results)
+	}
+
+	// the recorded signature doesn't match the secret of the server
+	r.Secret = ""
+	if _, err := r.Replay(context.Background(), deliveries); err == nil {
+		t.Fatalf("did not get expected error")
+	}
+}
@@ -0,0 +1,143 @@
+// Command zendesk-webhook receives Zendesk webhook deliveries locally and replays them.
+//
+// Record the deliveries of a webhook, rejecting requests with an invalid signature:
+//
+//	zendesk-webhook listen -addr :8080 -secret "$SIGNING_SECRET" -out deliveries.jsonl
+//
+// Replay the recorded deliveries against a service, signing them again with a
+// current timestamp so that they pass verification:
+//
+//	zendesk-webhook replay -in deliveries.jsonl -url http://localhost:3000/zendesk -secret "$SIGNING_SECRET"
+//
+// The secret can also be set with the ZENDESK_WEBHOOK_SECRET environment variable.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/nukosuke/go-zendesk/zendesk/webhook"
+)
+
+const usage = `Usage: zendesk-webhook <command> [flags]
+
+Commands:
+  listen   receive signed webhook deliveries and append them to a JSONL file
+  replay   send recorded deliveries to a URL
+
+Run "zendesk-webhook <command> -h" for the flags of a command.
+`
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("zendesk-webhook: ")
+
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	var err error
+	switch os.Args[1] {
+	case "listen":
+		err = listen(ctx, os.Args[2:])
+	case "replay":
+		err = replay(ctx, os.Args[2:])
+	case "-h", "-help", "--help", "help":
+		fmt.Fprint(os.Stdout, usage)
+		return
+	default:
+		fmt.Fprintf(os.Stderr, "unknown command %q\n\n%s", os.Args[1], usage)
+		os.Exit(2)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func listen(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("listen", flag.ExitOnError)
+	addr := fs.String("addr", ":8080", "address to listen on")
+	secret := fs.String("secret", os.Getenv("ZENDESK_WEBHOOK_SECRET"), "signing secret of the webhook")
+	out := fs.String("out", "deliveries.jsonl", "file the deliveries are appended to")
+	tolerance := fs.Duration("tolerance", webhook.DefaultTolerance, "maximum age of the signature timestamp, or a negative value to accept any")
+	fs.Parse(args)
+
+	if *secret == "" {
+		return errors.New("listen: -secret or ZENDESK_WEBHOOK_SECRET is required")
+	}
+
+	f, err := os.OpenFile(*out, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	v := &webhook.Verifier{
+		Secret:    *secret,
+		Tolerance: *tolerance,
+		Replay:    webhook.NewMemoryReplayCache(),
+	}
+	rec := newRecorder(v, f)
+	rec.logf = log.Printf
+
+	server := &http.Server{Addr: *addr, Handler: rec}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		server.Shutdown(shutdownCtx)
+	}()
+
+	log.Printf("listening on %s, writing deliveries to %s", *addr, *out)
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+func replay(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("replay", flag.ExitOnError)
+	in := fs.String("in", "deliveries.jsonl", "file the deliveries are read from")
+	target := fs.String("url", "", "URL the deliveries are sent to")
+	secret := fs.String("secret", os.Getenv("ZENDESK_WEBHOOK_SECRET"),
+		"signing secret used to sign the deliveries again with the current time; the recorded signatures are sent if it's empty")
+	delay := fs.Duration("delay", 0, "time to wait between deliveries")
+	fs.Parse(args)
+
+	if *target == "" {
+		return errors.New("replay: -url is required")
+	}
+
+	f, err := os.Open(*in)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	deliveries, err := readDeliveries(f)
+	if err != nil {
+		return err
+	}
+
+	r := &replayer{URL: *target, Secret: *secret, Delay: *delay, Client: http.DefaultClient}
+	results, err := r.Replay(ctx, deliveries)
+	for _, res := range results {
+		log.Printf("%s %s: %d %s", res.Delivery.ID, res.Delivery.EventType(), res.StatusCode, res.Duration)
+	}
+	if err != nil {
+		return err
+	}
+	log.Printf("replayed %d deliveries", len(results))
+	return nil
+}
@@ -23,7 +23,24 @@ import (
 {{ else }}
 import "context"
 {{ end }}
-func (z *Client) Get{{.FuncName}}Iterator(ctx context.Context, opts *PaginationOptions) *Iterator[{{.ObjectName}}] {
+{{ if .HelpCenter }}func (z *Client) Get{{.FuncName}}Iterator(ctx context.Context, locale int, opts *PaginationOptions) *Iterator[{{.ObjectName}}] {
+	return &Iterator[{{.ObjectName}}]{
+		CommonOptions: opts.CommonOptions,
+		pageSize:      opts.PageSize,
+		hasMore:       true,
+		isCBP:         opts.IsCBP,
+		pageAfter:     "",
+		pageIndex:     1,
+		ctx:           ctx,
+		obpFunc: func(ctx context.Context, opts *OBPOptions) ([]{{.ObjectName}}, Page, error) {
+			return z.Get{{.FuncName}}OBP(ctx, locale, opts)
+		},
+		cbpFunc: func(ctx context.Context, opts *CBPOptions) ([]{{.ObjectName}}, CursorPaginationMeta, error) {
+			return z.Get{{.FuncName}}CBP(ctx, locale, opts)
+		},
+	}
+}
+{{ else }}func (z *Client) Get{{.FuncName}}Iterator(ctx context.Context, opts *PaginationOptions) *Iterator[{{.ObjectName}}] {
 	return &Iterator[{{.ObjectName}}]{
 		CommonOptions: opts.CommonOptions,
 		pageSize:      opts.PageSize,
@@ -36,8 +53,8 @@ func (z *Client) Get{{.FuncName}}Iterator(ctx context.Context, opts *PaginationO
 		cbpFunc:       z.Get{{.FuncName}}CBP,
 	}
 }
-
-func (z *Client) Get{{.FuncName}}OBP(ctx context.Context, opts *OBPOptions) ([]{{.ObjectName}}, Page, error) {
+{{ end }}
+func (z *Client) Get{{.FuncName}}OBP(ctx context.Context, {{ if .HelpCenter }}locale int, {{ end }}opts *OBPOptions) ([]{{.ObjectName}}, Page, error) {
 	var data struct {
 		{{.ObjectName}}s []{{.ObjectName}} ` + "`json:\"{{.JsonName}}\"`" + `
 		Page
@@ -47,7 +64,13 @@ func (z *Client) Get{{.FuncName}}OBP(ctx context.Context, opts *OBPOptions) ([]{
 	if tmp == nil {
 		tmp = &OBPOptions{}
 	}
-	{{ if .ExtraParam }}
+	{{ if .HelpCenter }}
+	path, err := helpCenterPath(locale, {{ if .ExtraParam }}fmt.Sprintf("{{.ApiEndpoint}}", tmp.Id){{ else }}"{{.ApiEndpoint}}"{{ end }})
+	if err != nil {
+		return nil, Page{}, err
+	}
+	u, err := addOptions(path, tmp)
+	{{ else if .ExtraParam }}
 	path := fmt.Sprintf("{{.ApiEndpoint}}", tmp.Id)
 	u, err := addOptions(path, tmp)
 	{{ else }}
@@ -64,7 +87,7 @@ func (z *Client) Get{{.FuncName}}OBP(ctx context.Context, opts *OBPOptions) ([]{
 	return data.{{.ObjectName}}s, data.Page, nil
 }
 
-func (z *Client) Get{{.FuncName}}CBP(ctx context.Context, opts *CBPOptions) ([]{{.ObjectName}}, CursorPaginationMeta, error) {
+func (z *Client) Get{{.FuncName}}CBP(ctx context.Context, {{ if .HelpCenter }}locale int, {{ end }}opts *CBPOptions) ([]{{.ObjectName}}, CursorPaginationMeta, error) {
 	var data struct {
 		{{.ObjectName}}s []{{.ObjectName}} ` + "`json:\"{{.JsonName}}\"`" + `
 		Meta    CursorPaginationMeta ` + "`json:\"meta\"`" + `
@@ -74,7 +97,13 @@ func (z *Client) Get{{.FuncName}}CBP(ctx context.Context, opts *CBPOptions) ([]{
 	if tmp == nil {
 		tmp = &CBPOptions{}
 	}
-	{{ if .ExtraParam }}
+	{{ if .HelpCenter }}
+	path, err := helpCenterPath(locale, {{ if .ExtraParam }}fmt.Sprintf("{{.ApiEndpoint}}", tmp.Id){{ else }}"{{.ApiEndpoint}}"{{ end }})
+	if err != nil {
+		return nil, data.Meta, err
+	}
+	u, err := addOptions(path, tmp)
+	{{ else if .ExtraParam }}
 	path := fmt.Sprintf("{{.ApiEndpoint}}", tmp.Id)
 	u, err := addOptions(path, tmp)
 	{{ else }}
@@ -100,16 +129,50 @@ type FuncTemplateData struct {
 	JsonName    string
 	FileName    string
 	ExtraParam  bool
+
+	// HelpCenter endpoints are relative to /help_center and scoped to the locale given as an argument
+	HelpCenter bool
 }
 
 var funcData []FuncTemplateData = []FuncTemplateData{
+	{
+		FuncName:    "Articles",
+		ObjectName:  "Article",
+		ApiEndpoint: "/articles.json",
+		JsonName:    "articles",
+		FileName:    "article",
+		HelpCenter:  true,
+	},
+	{
+		FuncName:    "ArticlesBySection",
+		ObjectName:  "Article",
+		ApiEndpoint: "/sections/%d/articles.json",
+		JsonName:    "articles",
+		FileName:    "article_by_section",
+		ExtraParam:  true,
+		HelpCenter:  true,
+	},
+	{
+		FuncName:    "ArticleLabels",
+		ObjectName:  "ArticleLabel",
+		ApiEndpoint: "/help_center/articles/labels.json",
+		JsonName:    "labels",
+		FileName:    "article_label",
+	},
 	{
 		FuncName:    "Automations",
 		ObjectName:  "Automation",
-		ApiEndpoint: "/automation.json",
+		ApiEndpoint: "/automations.json",
 		JsonName:    "automations",
 		FileName:    "automation",
 	},
+	{
+		FuncName:    "Brands",
+		ObjectName:  "Brand",
+		ApiEndpoint: "/brands.json",
+		JsonName:    "brands",
+		FileName:    "brand",
+	},
 	{
 		FuncName:    "GroupMemberships",
 		ObjectName:  "GroupMembership",
@@ -117,6 +180,29 @@ var funcData []FuncTemplateData = []FuncTemplateData{
 		JsonName:    "group_memberships",
 		FileName:    "group_membership",
 	},
+	{
+		FuncName:    "GroupMembershipsByUser",
+		ObjectName:  "GroupMembership",
+		ApiEndpoint: "/users/%d/group_memberships.json",
+		JsonName:    "group_memberships",
+		FileName:    "group_membership_by_user",
+		ExtraParam:  true,
+	},
+	{
+		FuncName:    "GroupMembershipsByGroup",
+		ObjectName:  "GroupMembership",
+		ApiEndpoint: "/groups/%d/memberships.json",
+		JsonName:    "group_memberships",
+		FileName:    "group_membership_by_group",
+		ExtraParam:  true,
+	},
+	{
+		FuncName:    "AssignableGroupMemberships",
+		ObjectName:  "GroupMembership",
+		ApiEndpoint: "/group_memberships/assignable.json",
+		JsonName:    "group_memberships",
+		FileName:    "assignable_group_membership",
+	},
 	{
 		FuncName:    "Macros",
 		ObjectName:  "Macro",
@@ -131,6 +217,14 @@ var funcData []FuncTemplateData = []FuncTemplateData{
 		JsonName:    "organization_fields",
 		FileName:    "organization_field",
 	},
+	{
+		FuncName:    "OrganizationFieldOptions",
+		ObjectName:  "CustomFieldOption",
+		ApiEndpoint: "/organization_fields/%d/options.json",
+		JsonName:    "custom_field_options",
+		FileName:    "organization_field_option",
+		ExtraParam:  true,
+	},
 	{
 		FuncName:    "OrganizationMemberships",
 		ObjectName:  "OrganizationMembership",
@@ -152,6 +246,38 @@ var funcData []FuncTemplateData = []FuncTemplateData{
 		JsonName:    "results",
 		FileName:    "search",
 	},
+	{
+		FuncName:    "Categories",
+		ObjectName:  "Category",
+		ApiEndpoint: "/categories.json",
+		JsonName:    "categories",
+		FileName:    "category",
+		HelpCenter:  true,
+	},
+	{
+		FuncName:    "Sections",
+		ObjectName:  "Section",
+		ApiEndpoint: "/sections.json",
+		JsonName:    "sections",
+		FileName:    "section",
+		HelpCenter:  true,
+	},
+	{
+		FuncName:    "SectionsByCategory",
+		ObjectName:  "Section",
+		ApiEndpoint: "/categories/%d/sections.json",
+		JsonName:    "sections",
+		FileName:    "section_by_category",
+		ExtraParam:  true,
+		HelpCenter:  true,
+	},
+	{
+		FuncName:    "RoutingQueues",
+		ObjectName:  "RoutingQueue",
+		ApiEndpoint: "/routing/queues.json",
+		JsonName:    "queues",
+		FileName:    "routing_queue",
+	},
 	{
 		FuncName:    "SLAPolicies",
 		ObjectName:  "SLAPolicy",
@@ -181,6 +307,14 @@ var funcData []FuncTemplateData = []FuncTemplateData{
 		JsonName:    "ticket_fields",
 		FileName:    "ticket_field",
 	},
+	{
+		FuncName:    "TicketFieldOptions",
+		ObjectName:  "CustomFieldOption",
+		ApiEndpoint: "/ticket_fields/%d/options.json",
+		JsonName:    "custom_field_options",
+		FileName:    "ticket_field_option",
+		ExtraParam:  true,
+	},
 	{
 		FuncName:    "TicketForms",
 		ObjectName:  "TicketForm",
@@ -202,6 +336,14 @@ var funcData []FuncTemplateData = []FuncTemplateData{
 		JsonName:    "user_fields",
 		FileName:    "user_field",
 	},
+	{
+		FuncName:    "UserFieldOptions",
+		ObjectName:  "CustomFieldOption",
+		ApiEndpoint: "/user_fields/%d/options.json",
+		JsonName:    "custom_field_options",
+		FileName:    "user_field_option",
+		ExtraParam:  true,
+	},
 	{
 		FuncName:    "Users",
 		ObjectName:  "User",
@@ -209,6 +351,14 @@ var funcData []FuncTemplateData = []FuncTemplateData{
 		JsonName:    "users",
 		FileName:    "user",
 	},
+	{
+		FuncName:    "UserIdentities",
+		ObjectName:  "UserIdentity",
+		ApiEndpoint: "/users/%d/identities.json",
+		JsonName:    "identities",
+		FileName:    "user_identity",
+		ExtraParam:  true,
+	},
 	{
 		FuncName:    "OrganizationUsers",
 		ObjectName:  "User",
@@ -254,6 +404,29 @@ var funcData []FuncTemplateData = []FuncTemplateData{
 		JsonName:    "groups",
 		FileName:    "group",
 	},
+	{
+		FuncName:    "AssignableGroups",
+		ObjectName:  "Group",
+		ApiEndpoint: "/groups/assignable.json",
+		JsonName:    "groups",
+		FileName:    "assignable_group",
+	},
+	{
+		FuncName:    "UserGroups",
+		ObjectName:  "Group",
+		ApiEndpoint: "/users/%d/groups.json",
+		JsonName:    "groups",
+		FileName:    "user_groups",
+		ExtraParam:  true,
+	},
+	{
+		FuncName:    "GroupUsers",
+		ObjectName:  "User",
+		ApiEndpoint: "/groups/%d/users.json",
+		JsonName:    "users",
+		FileName:    "group_users",
+		ExtraParam:  true,
+	},
 	{
 		FuncName:    "OrganizationTickets",
 		ObjectName:  "Ticket",
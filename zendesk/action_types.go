@@ -43,6 +43,12 @@ const (
 	ActionFieldCommentModeIsPublic
 	// ActionFieldTicketFormID ticket_form_id
 	ActionFieldTicketFormID
+	// ActionFieldBrandID brand_id
+	ActionFieldBrandID
+	// ActionFieldCustomStatusID custom_status_id
+	ActionFieldCustomStatusID
+	// ActionFieldNotificationWebhook notification_webhook
+	ActionFieldNotificationWebhook
 )
 
 var actionFieldText = map[int]string{
@@ -66,6 +72,9 @@ var actionFieldText = map[int]string{
 	ActionFieldCommentValueHTML:    "comment_value_html",
 	ActionFieldCommentModeIsPublic: "comment_mode_is_public",
 	ActionFieldTicketFormID:        "ticket_form_id",
+	ActionFieldBrandID:             "brand_id",
+	ActionFieldCustomStatusID:      "custom_status_id",
+	ActionFieldNotificationWebhook: "notification_webhook",
 }
 
 // ActionFieldText takes field type and returns field name string
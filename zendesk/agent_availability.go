@@ -0,0 +1,240 @@
+package zendesk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Agent availability channel names
+const (
+	AgentChannelMessaging = "messaging"
+	AgentChannelSupport   = "support"
+	AgentChannelTalk      = "talk"
+)
+
+type (
+	// AgentAvailability is the availability of an agent across channels.
+	// The API returns JSON:API documents which are flattened into this struct.
+	// https://developer.zendesk.com/api-reference/agent-availability/agent-availability-api/agent-availabilities/
+	AgentAvailability struct {
+		AgentID     int64                      `json:"agent_id"`
+		AgentStatus AgentStatus                `json:"agent_status"`
+		Version     int64                      `json:"version"`
+		Channels    []AgentChannelAvailability `json:"channels"`
+		UpdatedAt   time.Time                  `json:"updated_at"`
+	}
+
+	// AgentStatus is the unified status of an agent
+	AgentStatus struct {
+		ID        int64     `json:"id"`
+		Name      string    `json:"name"`
+		UpdatedAt time.Time `json:"updated_at"`
+	}
+
+	// AgentChannelAvailability is the status of an agent in a channel
+	AgentChannelAvailability struct {
+		Name      string    `json:"name"`
+		Status    string    `json:"status"`
+		UpdatedAt time.Time `json:"updated_at"`
+	}
+
+	// AgentAvailabilityListOptions is options for GetAgentAvailabilities
+	// ref: https://developer.zendesk.com/api-reference/agent-availability/agent-availability-api/agent-availabilities/#list-agent-availabilities
+	AgentAvailabilityListOptions struct {
+		CursorPagination
+		AgentStatusID    int64  `url:"filter[agent_status_id],omitempty"`
+		MessagingStatus  string `url:"filter[channel_status][messaging],omitempty"`
+		SupportStatus    string `url:"filter[channel_status][support],omitempty"`
+		TalkStatus       string `url:"filter[channel_status][talk],omitempty"`
+		SelectedChannels string `url:"filter[select_channels],omitempty"`
+	}
+
+	// AgentAvailabilityAPI is an interface containing agent availability related methods
+	AgentAvailabilityAPI interface {
+		GetAgentAvailabilities(ctx context.Context, opts *AgentAvailabilityListOptions) ([]AgentAvailability, CursorPaginationMeta, error)
+		GetAgentAvailability(ctx context.Context, agentID int64) (AgentAvailability, error)
+		GetAgentAvailabilitiesIterator(ctx context.Context, opts *PaginationOptions) *Iterator[AgentAvailability]
+		GetFilteredAgentAvailabilitiesIterator(
+			ctx context.Context, filter AgentAvailabilityListOptions, opts *PaginationOptions,
+		) *Iterator[AgentAvailability]
+		GetAgentAvailabilitiesCBP(ctx context.Context, opts *CBPOptions) ([]AgentAvailability, CursorPaginationMeta, error)
+	}
+)
+
+// jsonAPIResource is a resource object of a JSON:API document
+type jsonAPIResource struct {
+	ID            string                             `json:"id"`
+	Type          string                             `json:"type"`
+	Attributes    json.RawMessage                    `json:"attributes"`
+	Relationships map[string]jsonAPIRelationshipData `json:"relationships"`
+}
+
+// jsonAPIRelationshipData is the linkage of a JSON:API relationship
+type jsonAPIRelationshipData struct {
+	Data []struct {
+		ID   string `json:"id"`
+		Type string `json:"type"`
+	} `json:"data"`
+}
+
+// agentAvailabilityDocument is the JSON:API document returned by agent availability endpoints.
+// Data is either a single resource or an array of resources.
+type agentAvailabilityDocument struct {
+	Data     json.RawMessage      `json:"data"`
+	Included []jsonAPIResource    `json:"included"`
+	Meta     CursorPaginationMeta `json:"meta"`
+}
+
+// GetAgentAvailabilities fetches the availability of agents
+// ref: https://developer.zendesk.com/api-reference/agent-availability/agent-availability-api/agent-availabilities/#list-agent-availabilities
+func (z *Client) GetAgentAvailabilities(ctx context.Context, opts *AgentAvailabilityListOptions) ([]AgentAvailability, CursorPaginationMeta, error) {
+	var doc agentAvailabilityDocument
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &AgentAvailabilityListOptions{}
+	}
+
+	u, err := addOptions("/agent_availabilities", tmp)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	if err := json.Unmarshal(body, &doc); err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	var resources []jsonAPIResource
+	if err := json.Unmarshal(doc.Data, &resources); err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	availabilities, err := decodeAgentAvailabilities(resources, doc.Included)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+	return availabilities, doc.Meta, nil
+}
+
+// GetAgentAvailability gets the availability of the specified agent
+// ref: https://developer.zendesk.com/api-reference/agent-availability/agent-availability-api/agent-availabilities/#show-agent-availability
+func (z *Client) GetAgentAvailability(ctx context.Context, agentID int64) (AgentAvailability, error) {
+	var doc agentAvailabilityDocument
+
+	body, err := z.get(ctx, fmt.Sprintf("/agent_availabilities/%d", agentID))
+	if err != nil {
+		return AgentAvailability{}, err
+	}
+
+	if err := json.Unmarshal(body, &doc); err != nil {
+		return AgentAvailability{}, err
+	}
+
+	var resource jsonAPIResource
+	if err := json.Unmarshal(doc.Data, &resource); err != nil {
+		return AgentAvailability{}, err
+	}
+
+	availabilities, err := decodeAgentAvailabilities([]jsonAPIResource{resource}, doc.Included)
+	if err != nil {
+		return AgentAvailability{}, err
+	}
+	return availabilities[0], nil
+}
+
+// GetAgentAvailabilitiesIterator returns an iterator over agent availabilities.
+// The endpoint supports cursor based pagination only, so IsCBP is ignored.
+// Use GetFilteredAgentAvailabilitiesIterator to filter agents by status or channel.
+func (z *Client) GetAgentAvailabilitiesIterator(ctx context.Context, opts *PaginationOptions) *Iterator[AgentAvailability] {
+	return &Iterator[AgentAvailability]{
+		CommonOptions: opts.CommonOptions,
+		pageSize:      opts.PageSize,
+		hasMore:       true,
+		isCBP:         true,
+		pageAfter:     "",
+		pageIndex:     1,
+		ctx:           ctx,
+		cbpFunc:       z.GetAgentAvailabilitiesCBP,
+	}
+}
+
+// GetAgentAvailabilitiesCBP fetches a page of agent availabilities using cursor based pagination.
+// Only the pagination of opts is used, since CommonOptions has no agent availability filters;
+// use GetAgentAvailabilities to filter agents.
+func (z *Client) GetAgentAvailabilitiesCBP(ctx context.Context, opts *CBPOptions) ([]AgentAvailability, CursorPaginationMeta, error) {
+	tmp := opts
+	if tmp == nil {
+		tmp = &CBPOptions{}
+	}
+
+	return z.GetAgentAvailabilities(ctx, &AgentAvailabilityListOptions{
+		CursorPagination: tmp.CursorPagination,
+	})
+}
+
+// GetFilteredAgentAvailabilitiesIterator returns an iterator over the agent availabilities
+// matching the status and channel filters of filter. The pagination of filter is ignored.
+// The endpoint supports cursor based pagination only, so IsCBP is ignored.
+func (z *Client) GetFilteredAgentAvailabilitiesIterator(
+	ctx context.Context, filter AgentAvailabilityListOptions, opts *PaginationOptions,
+) *Iterator[AgentAvailability] {
+	return &Iterator[AgentAvailability]{
+		CommonOptions: opts.CommonOptions,
+		pageSize:      opts.PageSize,
+		hasMore:       true,
+		isCBP:         true,
+		pageAfter:     "",
+		pageIndex:     1,
+		ctx:           ctx,
+		cbpFunc: func(ctx context.Context, opts *CBPOptions) ([]AgentAvailability, CursorPaginationMeta, error) {
+			page := filter
+			page.CursorPagination = opts.CursorPagination
+			return z.GetAgentAvailabilities(ctx, &page)
+		},
+	}
+}
+
+// decodeAgentAvailabilities resolves the channels of each availability from the included resources
+func decodeAgentAvailabilities(resources []jsonAPIResource, included []jsonAPIResource) ([]AgentAvailability, error) {
+	channels := map[string]AgentChannelAvailability{}
+	for _, inc := range included {
+		if inc.Type != "channels" {
+			continue
+		}
+		var channel AgentChannelAvailability
+		if err := json.Unmarshal(inc.Attributes, &channel); err != nil {
+			return nil, err
+		}
+		channels[inc.ID] = channel
+	}
+
+	availabilities := make([]AgentAvailability, 0, len(resources))
+	for _, resource := range resources {
+		var availability AgentAvailability
+		if len(resource.Attributes) > 0 && !bytes.Equal(resource.Attributes, []byte("null")) {
+			if err := json.Unmarshal(resource.Attributes, &availability); err != nil {
+				return nil, err
+			}
+		}
+		if availability.AgentID == 0 {
+			if _, err := fmt.Sscan(resource.ID, &availability.AgentID); err != nil {
+				return nil, fmt.Errorf("invalid agent availability id %q: %w", resource.ID, err)
+			}
+		}
+		for _, ref := range resource.Relationships["channels"].Data {
+			if channel, ok := channels[ref.ID]; ok {
+				availability.Channels = append(availability.Channels, channel)
+			}
+		}
+		availabilities = append(availabilities, availability)
+	}
+	return availabilities, nil
+}
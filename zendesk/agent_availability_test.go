@@ -0,0 +1,109 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+)
+
+func TestGetAgentAvailabilities(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if status := r.URL.Query().Get("filter[channel_status][messaging]"); status != "online" {
+			t.Errorf("unexpected messaging status filter: %s", status)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "agent_availabilities.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	availabilities, _, err := client.GetAgentAvailabilities(ctx, &AgentAvailabilityListOptions{
+		MessagingStatus: "online",
+	})
+	if err != nil {
+		t.Fatalf("Failed to get agent availabilities: %s", err)
+	}
+
+	if len(availabilities) != 2 {
+		t.Fatalf("expected length of agent availabilities is 2, but got %d", len(availabilities))
+	}
+
+	if len(availabilities[0].Channels) != 2 || availabilities[0].Channels[0].Name != AgentChannelMessaging {
+		t.Fatalf("unexpected channels of agent availability: %v", availabilities[0].Channels)
+	}
+
+	if availabilities[1].AgentID != 6784729637758 {
+		t.Fatalf("expected agent id to be resolved from the resource id, but got %d", availabilities[1].AgentID)
+	}
+}
+
+func TestGetAgentAvailabilitiesIterator(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "agent_availabilities.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	opts := NewPaginationOptions()
+	opts.IsCBP = false
+	it := client.GetAgentAvailabilitiesIterator(ctx, opts)
+
+	count := 0
+	for it.HasMore() {
+		availabilities, err := it.GetNext()
+		if err != nil {
+			t.Fatalf("Failed to get agent availabilities: %s", err)
+		}
+		count += len(availabilities)
+	}
+	if count != 2 {
+		t.Fatalf("expected length of agent availabilities is 2, but got %d", count)
+	}
+}
+
+func TestGetFilteredAgentAvailabilitiesIterator(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if status := r.URL.Query().Get("filter[channel_status][support]"); status != "online" {
+			t.Errorf("unexpected support status filter: %s", status)
+		}
+		if size := r.URL.Query().Get("page[size]"); size != "50" {
+			t.Errorf("unexpected page size: %s", size)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "agent_availabilities.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	opts := NewPaginationOptions()
+	opts.PageSize = 50
+	it := client.GetFilteredAgentAvailabilitiesIterator(ctx, AgentAvailabilityListOptions{SupportStatus: "online"}, opts)
+
+	count := 0
+	for it.HasMore() {
+		availabilities, err := it.GetNext()
+		if err != nil {
+			t.Fatalf("Failed to get agent availabilities: %s", err)
+		}
+		count += len(availabilities)
+	}
+	if count != 2 {
+		t.Fatalf("expected length of agent availabilities is 2, but got %d", count)
+	}
+}
+
+func TestGetAgentAvailability(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "agent_availability.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	availability, err := client.GetAgentAvailability(ctx, 6784729637757)
+	if err != nil {
+		t.Fatalf("Failed to get agent availability: %s", err)
+	}
+
+	if availability.AgentStatus.Name != "online" {
+		t.Fatalf("expected agent status is online, but got %s", availability.AgentStatus.Name)
+	}
+
+	if len(availability.Channels) != 1 || availability.Channels[0].Status != "offline" {
+		t.Fatalf("unexpected channels of agent availability: %v", availability.Channels)
+	}
+}
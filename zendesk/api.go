@@ -5,35 +5,55 @@ package zendesk
 
 // API an interface containing all of the zendesk client methods
 type API interface {
+	AgentAvailabilityAPI
 	AppAPI
+	ArticleAPI
+	ArticleAttachmentAPI
+	ArticleLabelAPI
 	AttachmentAPI
 	AutomationAPI
 	BaseAPI
 	BrandAPI
+	BusinessHoursAPI
+	CapacityRuleAPI
+	CategoryAPI
 	CustomRoleAPI
 	DynamicContentAPI
 	GroupAPI
 	GroupMembershipAPI
+	JobStatusAPI
 	LocaleAPI
+	LookupRelationshipAPI
 	MacroAPI
 	OrganizationAPI
 	OrganizationFieldAPI
 	OrganizationMembershipAPI
+	OrganizationSubscriptionAPI
+	RoutingAttributeAPI
+	RoutingQueueAPI
 	SearchAPI
+	SectionAPI
 	SLAPolicyAPI
 	TagAPI
 	TargetAPI
+	TargetFailureAPI
+	TargetMigrationAPI
 	TicketAuditAPI
 	TicketAPI
 	TicketCommentAPI
 	TicketFieldAPI
 	TicketFormAPI
+	TranslationAPI
 	TriggerAPI
+	TriggerCategoryAPI
 	UserAPI
 	UserFieldAPI
+	UserIdentityAPI
 	ViewAPI
 	WebhookAPI
 	CustomObjectAPI
+	CustomObjectFieldAPI
+	CustomStatusAPI
 }
 
 var _ API = (*Client)(nil)
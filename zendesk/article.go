@@ -0,0 +1,230 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Article is struct for Help Center article payload.
+// The title and the body are those of the locale the article is fetched in.
+// Flags left nil are not sent, so UpdateArticle keeps their current values.
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/articles/
+type Article struct {
+	ID                int64      `json:"id,omitempty"`
+	URL               string     `json:"url,omitempty"`
+	HTMLURL           string     `json:"html_url,omitempty"`
+	Title             string     `json:"title,omitempty"`
+	Body              string     `json:"body,omitempty"`
+	Locale            string     `json:"locale,omitempty"`
+	SourceLocale      string     `json:"source_locale,omitempty"`
+	AuthorID          int64      `json:"author_id,omitempty"`
+	SectionID         int64      `json:"section_id,omitempty"`
+	PermissionGroupID int64      `json:"permission_group_id,omitempty"`
+	UserSegmentID     *int64     `json:"user_segment_id,omitempty"`
+	CommentsDisabled  *bool      `json:"comments_disabled,omitempty"`
+	Draft             *bool      `json:"draft,omitempty"`
+	Promoted          *bool      `json:"promoted,omitempty"`
+	Outdated          bool       `json:"outdated,omitempty"`
+	OutdatedLocales   []string   `json:"outdated_locales,omitempty"`
+	Position          int64      `json:"position,omitempty"`
+	VoteSum           int64      `json:"vote_sum,omitempty"`
+	VoteCount         int64      `json:"vote_count,omitempty"`
+	LabelNames        []string   `json:"label_names,omitempty"`
+	ContentTagIDs     []string   `json:"content_tag_ids,omitempty"`
+	EditedAt          *time.Time `json:"edited_at,omitempty"`
+	CreatedAt         *time.Time `json:"created_at,omitempty"`
+	UpdatedAt         *time.Time `json:"updated_at,omitempty"`
+}
+
+// ArticleSearchOptions is options for SearchArticles
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/articles/#search-articles
+type ArticleSearchOptions struct {
+	PageOptions
+
+	// Query is the search text. Either Query or LabelNames must be set.
+	Query string `url:"query,omitempty"`
+
+	// Locale restricts the results to a locale such as LocaleENUS
+	Locale int `url:"-"`
+
+	// LabelNames restricts the results to the articles with any of the labels
+	LabelNames []string `url:"-"`
+
+	Category int64 `url:"category,omitempty"`
+	Section  int64 `url:"section,omitempty"`
+	BrandID  int64 `url:"brand_id,omitempty"`
+
+	// Dates are formatted as "YYYY-MM-DD"
+	CreatedBefore string `url:"created_before,omitempty"`
+	CreatedAfter  string `url:"created_after,omitempty"`
+	UpdatedBefore string `url:"updated_before,omitempty"`
+	UpdatedAfter  string `url:"updated_after,omitempty"`
+
+	// SortBy is "created_at", "updated_at" or "relevance" by default
+	SortBy    string `url:"sort_by,omitempty"`
+	SortOrder string `url:"sort_order,omitempty"`
+}
+
+// ArticleSearchResult is an article matching the search, with the snippet of the matching text
+type ArticleSearchResult struct {
+	Article
+	Snippet    string `json:"snippet"`
+	ResultType string `json:"result_type"`
+}
+
+// ArticleAPI an interface containing all Help Center article related methods
+type ArticleAPI interface {
+	GetArticle(ctx context.Context, locale int, articleID int64) (Article, error)
+	CreateArticle(ctx context.Context, locale int, sectionID int64, article Article, notifySubscribers bool) (Article, error)
+	UpdateArticle(ctx context.Context, locale int, articleID int64, article Article) (Article, error)
+	DeleteArticle(ctx context.Context, articleID int64) error
+	SearchArticles(ctx context.Context, opts *ArticleSearchOptions) ([]ArticleSearchResult, Page, error)
+	GetArticlesIterator(ctx context.Context, locale int, opts *PaginationOptions) *Iterator[Article]
+	GetArticlesOBP(ctx context.Context, locale int, opts *OBPOptions) ([]Article, Page, error)
+	GetArticlesCBP(ctx context.Context, locale int, opts *CBPOptions) ([]Article, CursorPaginationMeta, error)
+	GetArticlesBySectionIterator(ctx context.Context, locale int, opts *PaginationOptions) *Iterator[Article]
+	GetArticlesBySectionOBP(ctx context.Context, locale int, opts *OBPOptions) ([]Article, Page, error)
+	GetArticlesBySectionCBP(ctx context.Context, locale int, opts *CBPOptions) ([]Article, CursorPaginationMeta, error)
+}
+
+// GetArticle gets the specified article in the locale, or in the default locale if it's zero
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/articles/#show-article
+func (z *Client) GetArticle(ctx context.Context, locale int, articleID int64) (Article, error) {
+	var result struct {
+		Article Article `json:"article"`
+	}
+
+	path, err := helpCenterPath(locale, fmt.Sprintf("/articles/%d.json", articleID))
+	if err != nil {
+		return Article{}, err
+	}
+
+	body, err := z.get(ctx, path)
+	if err != nil {
+		return Article{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Article{}, err
+	}
+	return result.Article, nil
+}
+
+// CreateArticle creates an article in the section. The title and the body are
+// those of the locale, which becomes the source locale of the article.
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/articles/#create-article
+func (z *Client) CreateArticle(
+	ctx context.Context, locale int, sectionID int64, article Article, notifySubscribers bool,
+) (Article, error) {
+	var data struct {
+		Article           Article `json:"article"`
+		NotifySubscribers bool    `json:"notify_subscribers"`
+	}
+	var result struct {
+		Article Article `json:"article"`
+	}
+	data.Article = article
+	data.NotifySubscribers = notifySubscribers
+
+	path, err := helpCenterPath(locale, fmt.Sprintf("/sections/%d/articles.json", sectionID))
+	if err != nil {
+		return Article{}, err
+	}
+
+	body, err := z.post(ctx, path, data)
+	if err != nil {
+		return Article{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Article{}, err
+	}
+	return result.Article, nil
+}
+
+// UpdateArticle updates the metadata of the specified article, such as its section or labels.
+// Use UpdateTranslation to change its title or body.
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/articles/#update-article
+func (z *Client) UpdateArticle(ctx context.Context, locale int, articleID int64, article Article) (Article, error) {
+	var data, result struct {
+		Article Article `json:"article"`
+	}
+	data.Article = article
+
+	path, err := helpCenterPath(locale, fmt.Sprintf("/articles/%d.json", articleID))
+	if err != nil {
+		return Article{}, err
+	}
+
+	body, err := z.put(ctx, path, data)
+	if err != nil {
+		return Article{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Article{}, err
+	}
+	return result.Article, nil
+}
+
+// DeleteArticle archives the specified article
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/articles/#archive-article
+func (z *Client) DeleteArticle(ctx context.Context, articleID int64) error {
+	return z.delete(ctx, fmt.Sprintf("/help_center/articles/%d.json", articleID))
+}
+
+// SearchArticles searches the articles of the Help Center. The endpoint supports offset based pagination only.
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/articles/#search-articles
+func (z *Client) SearchArticles(ctx context.Context, opts *ArticleSearchOptions) ([]ArticleSearchResult, Page, error) {
+	var result struct {
+		Results []ArticleSearchResult `json:"results"`
+		Page
+	}
+
+	if opts == nil {
+		return nil, Page{}, &OptionsError{opts}
+	}
+
+	var req struct {
+		*ArticleSearchOptions
+		Locale     string `url:"locale,omitempty"`
+		LabelNames string `url:"label_names,omitempty"`
+	}
+	req.ArticleSearchOptions = opts
+	req.LabelNames = strings.Join(opts.LabelNames, ",")
+	if opts.Locale != 0 {
+		req.Locale = LocaleTypeText(opts.Locale)
+		if req.Locale == "" {
+			return nil, Page{}, fmt.Errorf("unknown locale %d", opts.Locale)
+		}
+	}
+
+	u, err := addOptions("/help_center/articles/search.json", req)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	return result.Results, result.Page, nil
+}
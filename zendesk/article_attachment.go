@@ -0,0 +1,110 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+// ArticleAttachment is struct for Help Center article attachment payload.
+// Inline attachments are images embedded in the body of the article.
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/article_attachments/
+type ArticleAttachment struct {
+	ID              int64      `json:"id,omitempty"`
+	URL             string     `json:"url,omitempty"`
+	ArticleID       int64      `json:"article_id,omitempty"`
+	FileName        string     `json:"file_name"`
+	DisplayFileName string     `json:"display_file_name,omitempty"`
+	ContentURL      string     `json:"content_url,omitempty"`
+	RelativePath    string     `json:"relative_path,omitempty"`
+	ContentType     string     `json:"content_type,omitempty"`
+	Size            int64      `json:"size,omitempty"`
+	Inline          bool       `json:"inline"`
+	CreatedAt       *time.Time `json:"created_at,omitempty"`
+	UpdatedAt       *time.Time `json:"updated_at,omitempty"`
+}
+
+// ArticleAttachmentAPI an interface containing all Help Center article attachment related methods
+type ArticleAttachmentAPI interface {
+	GetArticleAttachments(ctx context.Context, articleID int64) ([]ArticleAttachment, error)
+	GetArticleAttachment(ctx context.Context, attachmentID int64) (ArticleAttachment, error)
+	CreateArticleAttachment(
+		ctx context.Context, articleID int64, filename string, file io.Reader, inline bool,
+	) (ArticleAttachment, error)
+	DeleteArticleAttachment(ctx context.Context, attachmentID int64) error
+}
+
+// GetArticleAttachments lists the attachments of the specified article
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/article_attachments/#list-article-attachments
+func (z *Client) GetArticleAttachments(ctx context.Context, articleID int64) ([]ArticleAttachment, error) {
+	var result struct {
+		ArticleAttachments []ArticleAttachment `json:"article_attachments"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/help_center/articles/%d/attachments.json", articleID))
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.ArticleAttachments, nil
+}
+
+// GetArticleAttachment gets the specified article attachment
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/article_attachments/#show-article-attachment
+func (z *Client) GetArticleAttachment(ctx context.Context, attachmentID int64) (ArticleAttachment, error) {
+	var result struct {
+		ArticleAttachment ArticleAttachment `json:"article_attachment"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/help_center/articles/attachments/%d.json", attachmentID))
+	if err != nil {
+		return ArticleAttachment{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return ArticleAttachment{}, err
+	}
+	return result.ArticleAttachment, nil
+}
+
+// CreateArticleAttachment uploads a file and attaches it to the specified article.
+// Inline attachments can be embedded in the body of the article with their relative path.
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/article_attachments/#create-article-attachment
+func (z *Client) CreateArticleAttachment(
+	ctx context.Context, articleID int64, filename string, file io.Reader, inline bool,
+) (ArticleAttachment, error) {
+	var result struct {
+		ArticleAttachment ArticleAttachment `json:"article_attachment"`
+	}
+
+	path := fmt.Sprintf("/help_center/articles/%d/attachments.json", articleID)
+	body, err := z.postMultipart(ctx, path, "file", filename, file, map[string]string{"inline": strconv.FormatBool(inline)})
+	if err != nil {
+		return ArticleAttachment{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return ArticleAttachment{}, err
+	}
+	return result.ArticleAttachment, nil
+}
+
+// DeleteArticleAttachment deletes the specified article attachment
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/article_attachments/#delete-article-attachment
+func (z *Client) DeleteArticleAttachment(ctx context.Context, attachmentID int64) error {
+	return z.delete(ctx, fmt.Sprintf("/help_center/articles/attachments/%d.json", attachmentID))
+}
@@ -0,0 +1,58 @@
+package zendesk
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGetArticleAttachments(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "article_attachments.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	attachments, err := client.GetArticleAttachments(ctx, 360001234567)
+	if err != nil {
+		t.Fatalf("Failed to get article attachments: %s", err)
+	}
+
+	if len(attachments) != 1 {
+		t.Fatalf("expected length of article attachments is 1, but got %d", len(attachments))
+	}
+}
+
+func TestCreateArticleAttachment(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/help_center/articles/360001234567/attachments.json" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if inline := r.FormValue("inline"); inline != "false" {
+			t.Errorf("unexpected inline: %s", inline)
+		}
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			t.Errorf("Failed to read file: %s", err)
+		} else {
+			content, _ := io.ReadAll(file)
+			if string(content) != "pdf" || header.Filename != "guide.pdf" {
+				t.Errorf("unexpected file: %s %s", header.Filename, content)
+			}
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write(readFixture(filepath.Join(http.MethodPost, "article_attachment.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	attachment, err := client.CreateArticleAttachment(ctx, 360001234567, "guide.pdf", strings.NewReader("pdf"), false)
+	if err != nil {
+		t.Fatalf("Failed to create article attachment: %s", err)
+	}
+
+	if attachment.ID != 360000022223 || attachment.Inline {
+		t.Fatalf("unexpected article attachment %v", attachment)
+	}
+}
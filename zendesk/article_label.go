@@ -0,0 +1,98 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// ArticleLabel is struct for Help Center article label payload
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/labels/
+type ArticleLabel struct {
+	ID        int64      `json:"id,omitempty"`
+	URL       string     `json:"url,omitempty"`
+	Name      string     `json:"name"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// ArticleLabelAPI an interface containing all Help Center article label related methods
+type ArticleLabelAPI interface {
+	GetArticleLabel(ctx context.Context, labelID int64) (ArticleLabel, error)
+	GetLabelsOfArticle(ctx context.Context, articleID int64) ([]ArticleLabel, error)
+	CreateArticleLabel(ctx context.Context, articleID int64, name string) (ArticleLabel, error)
+	DeleteArticleLabel(ctx context.Context, articleID int64, labelID int64) error
+	GetArticleLabelsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[ArticleLabel]
+	GetArticleLabelsOBP(ctx context.Context, opts *OBPOptions) ([]ArticleLabel, Page, error)
+	GetArticleLabelsCBP(ctx context.Context, opts *CBPOptions) ([]ArticleLabel, CursorPaginationMeta, error)
+}
+
+// GetArticleLabel gets the specified label
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/labels/#show-label
+func (z *Client) GetArticleLabel(ctx context.Context, labelID int64) (ArticleLabel, error) {
+	var result struct {
+		Label ArticleLabel `json:"label"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/help_center/articles/labels/%d.json", labelID))
+	if err != nil {
+		return ArticleLabel{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return ArticleLabel{}, err
+	}
+	return result.Label, nil
+}
+
+// GetLabelsOfArticle lists the labels of the specified article
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/labels/#list-labels
+func (z *Client) GetLabelsOfArticle(ctx context.Context, articleID int64) ([]ArticleLabel, error) {
+	var result struct {
+		Labels []ArticleLabel `json:"labels"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/help_center/articles/%d/labels.json", articleID))
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Labels, nil
+}
+
+// CreateArticleLabel adds a label to the specified article. The label is created if it doesn't exist.
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/labels/#create-label
+func (z *Client) CreateArticleLabel(ctx context.Context, articleID int64, name string) (ArticleLabel, error) {
+	var data, result struct {
+		Label ArticleLabel `json:"label"`
+	}
+	data.Label = ArticleLabel{Name: name}
+
+	body, err := z.post(ctx, fmt.Sprintf("/help_center/articles/%d/labels.json", articleID), data)
+	if err != nil {
+		return ArticleLabel{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return ArticleLabel{}, err
+	}
+	return result.Label, nil
+}
+
+// DeleteArticleLabel removes the label from the specified article
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/labels/#delete-label
+func (z *Client) DeleteArticleLabel(ctx context.Context, articleID int64, labelID int64) error {
+	return z.delete(ctx, fmt.Sprintf("/help_center/articles/%d/labels/%d.json", articleID, labelID))
+}
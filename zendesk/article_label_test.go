@@ -0,0 +1,36 @@
+package zendesk
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestGetArticleLabelsCBP(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "article_labels.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	labels, _, err := client.GetArticleLabelsCBP(ctx, &CBPOptions{})
+	if err != nil {
+		t.Fatalf("Failed to get article labels: %s", err)
+	}
+
+	if len(labels) != 2 {
+		t.Fatalf("expected length of article labels is 2, but got %d", len(labels))
+	}
+}
+
+func TestCreateArticleLabel(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodPost, "article_label.json", http.StatusCreated)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	label, err := client.CreateArticleLabel(ctx, 360001234567, "security")
+	if err != nil {
+		t.Fatalf("Failed to create article label: %s", err)
+	}
+
+	if label.Name != "security" {
+		t.Fatalf("expected name is security, but got %s", label.Name)
+	}
+}
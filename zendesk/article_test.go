@@ -0,0 +1,222 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestGetArticle(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/help_center/de/articles/360001234567.json" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write(readFixture(filepath.Join(http.MethodGet, "article.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	article, err := client.GetArticle(ctx, LocaleDE, 360001234567)
+	if err != nil {
+		t.Fatalf("Failed to get article: %s", err)
+	}
+
+	if article.ID != 360001234567 || article.Locale != "de" {
+		t.Fatalf("unexpected article %v", article)
+	}
+	if article.UserSegmentID != nil {
+		t.Fatalf("expected user segment id is nil, but got %d", *article.UserSegmentID)
+	}
+}
+
+func TestGetArticleUnknownLocale(t *testing.T) {
+	client, _ := NewClient(nil)
+
+	_, err := client.GetArticle(ctx, -1, 360001234567)
+	if err == nil {
+		t.Fatal("expected an error for unknown locale, but got nil")
+	}
+}
+
+func TestGetArticlesCBP(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/help_center/articles.json" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if size := r.URL.Query().Get("page[size]"); size != "2" {
+			t.Errorf("unexpected page size: %s", size)
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write(readFixture(filepath.Join(http.MethodGet, "articles.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	opts := &CBPOptions{CursorPagination: CursorPagination{PageSize: 2}}
+	articles, meta, err := client.GetArticlesCBP(ctx, 0, opts)
+	if err != nil {
+		t.Fatalf("Failed to get articles: %s", err)
+	}
+
+	if len(articles) != 2 {
+		t.Fatalf("expected length of articles is 2, but got %d", len(articles))
+	}
+	if !meta.HasMore || meta.AfterCursor != "xxx" {
+		t.Fatalf("unexpected meta %v", meta)
+	}
+}
+
+func TestGetArticlesBySectionIterator(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/help_center/en-US/sections/360000123456/articles.json" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write(readFixture(filepath.Join(http.MethodGet, "articles.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	opts := NewPaginationOptions()
+	opts.Id = 360000123456
+	it := client.GetArticlesBySectionIterator(ctx, LocaleENUS, opts)
+
+	articles, err := it.GetNext()
+	if err != nil {
+		t.Fatalf("Failed to get articles by section: %s", err)
+	}
+	if len(articles) != 2 {
+		t.Fatalf("expected length of articles is 2, but got %d", len(articles))
+	}
+}
+
+func TestCreateArticle(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/help_center/en-US/sections/360000123456/articles.json" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		var data struct {
+			Article           Article `json:"article"`
+			NotifySubscribers bool    `json:"notify_subscribers"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+			t.Errorf("Failed to decode request body: %s", err)
+		}
+		if data.Article.Title != "How to reset your password" || data.NotifySubscribers {
+			t.Errorf("unexpected request body: %v", data)
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write(readFixture(filepath.Join(http.MethodPost, "article.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	article, err := client.CreateArticle(ctx, LocaleENUS, 360000123456, Article{
+		Title:             "How to reset your password",
+		Body:              "<p>Click <strong>Forgot password</strong> on the sign in page.</p>",
+		PermissionGroupID: 5937511,
+	}, false)
+	if err != nil {
+		t.Fatalf("Failed to create article: %s", err)
+	}
+
+	if article.ID != 360001234567 {
+		t.Fatalf("expected id is 360001234567, but got %d", article.ID)
+	}
+}
+
+func TestUpdateArticle(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/help_center/en-US/articles/360001234567.json" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		var data struct {
+			Article map[string]interface{} `json:"article"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+			t.Errorf("Failed to decode request body: %s", err)
+		}
+		expected := map[string]interface{}{
+			"section_id":        float64(360000123456),
+			"promoted":          false,
+			"comments_disabled": false,
+		}
+		if !reflect.DeepEqual(data.Article, expected) {
+			t.Errorf("unexpected request body: %v", data.Article)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodPost, "article.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	off := false
+	_, err := client.UpdateArticle(ctx, LocaleENUS, 360001234567, Article{
+		SectionID:        360000123456,
+		Promoted:         &off,
+		CommentsDisabled: &off,
+	})
+	if err != nil {
+		t.Fatalf("Failed to update article: %s", err)
+	}
+}
+
+func TestDeleteArticle(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+		w.Write(nil)
+	}))
+
+	c := newTestClient(mockAPI)
+	err := c.DeleteArticle(ctx, 360001234567)
+	if err != nil {
+		t.Fatalf("Failed to delete article: %s", err)
+	}
+}
+
+func TestSearchArticles(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/help_center/articles/search.json" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		q := r.URL.Query()
+		if q.Get("query") != "password" || q.Get("locale") != "en-US" || q.Get("label_names") != "account,security" {
+			t.Errorf("unexpected query: %s", r.URL.RawQuery)
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write(readFixture(filepath.Join(http.MethodGet, "article_search.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	results, page, err := client.SearchArticles(ctx, &ArticleSearchOptions{
+		Query:      "password",
+		Locale:     LocaleENUS,
+		LabelNames: []string{"account", "security"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to search articles: %s", err)
+	}
+
+	if len(results) != 1 {
+		t.Fatalf("expected length of results is 1, but got %d", len(results))
+	}
+	if results[0].ID != 360001234567 || results[0].Snippet == "" {
+		t.Fatalf("unexpected result %v", results[0])
+	}
+	if page.HasNext() {
+		t.Fatal("expected no next page")
+	}
+}
+
+func TestSearchArticlesWithoutOptions(t *testing.T) {
+	client, _ := NewClient(nil)
+
+	_, _, err := client.SearchArticles(ctx, nil)
+	if _, ok := err.(*OptionsError); !ok {
+		t.Fatalf("expected OptionsError, but got %v", err)
+	}
+}
@@ -35,7 +35,7 @@ func (z *Client) GetAutomationsOBP(ctx context.Context, opts *OBPOptions) ([]Aut
 		tmp = &OBPOptions{}
 	}
 	
-	u, err := addOptions("/automation.json", tmp)
+	u, err := addOptions("/automations.json", tmp)
 	
 	if err != nil {
 		return nil, Page{}, err
@@ -59,7 +59,7 @@ func (z *Client) GetAutomationsCBP(ctx context.Context, opts *CBPOptions) ([]Aut
 		tmp = &CBPOptions{}
 	}
 	
-	u, err := addOptions("/automation.json", tmp)
+	u, err := addOptions("/automations.json", tmp)
 	
 	if err != nil {
 		return nil, data.Meta, err
@@ -3,6 +3,7 @@ package zendesk
 import (
 	"net/http"
 	"net/http/httptest"
+	"path/filepath"
 	"testing"
 )
 
@@ -36,6 +37,26 @@ func TestGetAutomationsWithNil(t *testing.T) {
 	}
 }
 
+func TestGetAutomationsCBP(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/automations.json" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "automations.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	automations, _, err := client.GetAutomationsCBP(ctx, &CBPOptions{})
+	if err != nil {
+		t.Fatalf("Failed to get automations: %s", err)
+	}
+
+	if len(automations) != 3 {
+		t.Fatalf("expected length of automations is 3, but got %d", len(automations))
+	}
+}
+
 func TestCreateAutomation(t *testing.T) {
 	mockAPI := newMockAPIWithStatus(http.MethodPost, "automations.json", http.StatusCreated)
 	client := newTestClient(mockAPI)
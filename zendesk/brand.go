@@ -33,6 +33,9 @@ type BrandAPI interface {
 	GetBrand(ctx context.Context, brandID int64) (Brand, error)
 	UpdateBrand(ctx context.Context, brandID int64, brand Brand) (Brand, error)
 	DeleteBrand(ctx context.Context, brandID int64) error
+	GetBrandsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[Brand]
+	GetBrandsOBP(ctx context.Context, opts *OBPOptions) ([]Brand, Page, error)
+	GetBrandsCBP(ctx context.Context, opts *CBPOptions) ([]Brand, CursorPaginationMeta, error)
 }
 
 // CreateBrand creates new brand
@@ -89,3 +89,38 @@ func TestDeleteBrand(t *testing.T) {
 		t.Fatalf("Failed to delete brand: %s", err)
 	}
 }
+
+func TestGetBrandsIterator(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "brands.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	it := client.GetBrandsIterator(ctx, NewPaginationOptions())
+
+	brandsCount := 0
+	for it.HasMore() {
+		brands, err := it.GetNext()
+		if err != nil {
+			t.Fatalf("Failed to get brands: %s", err)
+		}
+		brandsCount += len(brands)
+	}
+	if brandsCount != 2 {
+		t.Fatalf("expected length of brands is 2, but got %d", brandsCount)
+	}
+}
+
+func TestGetBrandsCBP(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "brands.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	brands, _, err := client.GetBrandsCBP(ctx, nil)
+	if err != nil {
+		t.Fatalf("Failed to get brands: %s", err)
+	}
+
+	if len(brands) != 2 {
+		t.Fatalf("expected length of brands is 2, but got %d", len(brands))
+	}
+}
@@ -0,0 +1,330 @@
+// Package bundle exports the configuration of a Zendesk account, such as triggers,
+// views and ticket fields, into a deterministic JSON or YAML bundle, and applies a
+// bundle back to an account.
+//
+// Records are matched by their title, name or other natural key instead of their ID,
+// so that a bundle taken from a sandbox can be applied to production:
+//
+//	b, err := bundle.Snapshot(ctx, sandbox)
+//	...
+//	plan, err := bundle.NewPlan(ctx, production, b, bundle.PlanOptions{})
+//	...
+//	fmt.Print(plan)
+//	err = plan.Apply(ctx, production)
+//
+// IDs referring to other records, such as group IDs in trigger actions, are copied as is.
+// Use Remapper to rewrite them to the IDs of the destination account before planning.
+// Records which don't exist in the destination yet, such as new trigger categories,
+// can't be remapped, so apply them first and then remap and plan the records referring to them.
+package bundle
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/nukosuke/go-zendesk/zendesk"
+	"gopkg.in/yaml.v3"
+)
+
+// Kind is a kind of records in a bundle
+type Kind string
+
+// Kinds of records. Kinds are listed in the order they are created, so that
+// records referred to by other records are created first.
+const (
+	KindGroups            Kind = "groups"
+	KindBrands            Kind = "brands"
+	KindTicketFields      Kind = "ticket_fields"
+	KindTicketForms       Kind = "ticket_forms"
+	KindDynamicContent    Kind = "dynamic_content"
+	KindWebhooks          Kind = "webhooks"
+	KindSLAPolicies       Kind = "sla_policies"
+	KindViews             Kind = "views"
+	KindMacros            Kind = "macros"
+	KindTriggerCategories Kind = "trigger_categories"
+	KindTriggers          Kind = "triggers"
+	KindAutomations       Kind = "automations"
+)
+
+// AllKinds is the list of all kinds in creation order
+var AllKinds = []Kind{
+	KindGroups,
+	KindBrands,
+	KindTicketFields,
+	KindTicketForms,
+	KindDynamicContent,
+	KindWebhooks,
+	KindSLAPolicies,
+	KindViews,
+	KindMacros,
+	KindTriggerCategories,
+	KindTriggers,
+	KindAutomations,
+}
+
+// Format is the encoding of a bundle
+type Format string
+
+// Formats of bundles
+const (
+	FormatJSON Format = "json"
+	FormatYAML Format = "yaml"
+)
+
+// API is the set of client methods used to snapshot and apply bundles.
+// It's implemented by *zendesk.Client.
+type API interface {
+	zendesk.AutomationAPI
+	zendesk.BrandAPI
+	zendesk.DynamicContentAPI
+	zendesk.GroupAPI
+	zendesk.MacroAPI
+	zendesk.SLAPolicyAPI
+	zendesk.TicketFieldAPI
+	zendesk.TicketFormAPI
+	zendesk.TriggerAPI
+	zendesk.TriggerCategoryAPI
+	zendesk.ViewAPI
+	zendesk.WebhookAPI
+}
+
+// Bundle is a snapshot of the configuration of an account.
+// Records of each kind are sorted by their key.
+type Bundle struct {
+	// Kinds is the list of kinds managed by the bundle. Records of other kinds are
+	// never changed when the bundle is applied. All kinds are managed if it's empty.
+	Kinds []Kind `json:"kinds,omitempty"`
+
+	Groups            []zendesk.Group              `json:"groups,omitempty"`
+	Brands            []zendesk.Brand              `json:"brands,omitempty"`
+	TicketFields      []zendesk.TicketField        `json:"ticket_fields,omitempty"`
+	TicketForms       []zendesk.TicketForm         `json:"ticket_forms,omitempty"`
+	DynamicContent    []zendesk.DynamicContentItem `json:"dynamic_content,omitempty"`
+	Webhooks          []zendesk.Webhook            `json:"webhooks,omitempty"`
+	SLAPolicies       []zendesk.SLAPolicy          `json:"sla_policies,omitempty"`
+	Views             []zendesk.View               `json:"views,omitempty"`
+	Macros            []zendesk.Macro              `json:"macros,omitempty"`
+	TriggerCategories []zendesk.TriggerCategory    `json:"trigger_categories,omitempty"`
+	Triggers          []zendesk.Trigger            `json:"triggers,omitempty"`
+	Automations       []zendesk.Automation         `json:"automations,omitempty"`
+}
+
+// Snapshot fetches the records of the specified kinds, or all kinds if none is specified.
+// System ticket fields, which can't be created or deleted, are not included.
+// Webhook credentials are not returned by the API, so they are not included either.
+func Snapshot(ctx context.Context, api API, kinds ...Kind) (*Bundle, error) {
+	if len(kinds) == 0 {
+		kinds = AllKinds
+	}
+
+	b := &Bundle{Kinds: kinds}
+	for _, kind := range kinds {
+		r, ok := resources[kind]
+		if !ok {
+			return nil, fmt.Errorf("unknown kind %q", kind)
+		}
+		if err := r.snapshot(ctx, api, b); err != nil {
+			return nil, fmt.Errorf("failed to fetch %s: %w", kind, err)
+		}
+	}
+	return b, nil
+}
+
+// managedKinds returns the kinds managed by the bundle in creation order
+func (b *Bundle) managedKinds() ([]Kind, error) {
+	if len(b.Kinds) == 0 {
+		return AllKinds, nil
+	}
+
+	managed := make(map[Kind]bool, len(b.Kinds))
+	for _, kind := range b.Kinds {
+		if _, ok := resources[kind]; !ok {
+			return nil, fmt.Errorf("unknown kind %q", kind)
+		}
+		managed[kind] = true
+	}
+
+	var kinds []Kind
+	for _, kind := range AllKinds {
+		if managed[kind] {
+			kinds = append(kinds, kind)
+		}
+	}
+	return kinds, nil
+}
+
+// Encode writes the bundle in the specified format. Record IDs, URLs and timestamps,
+// including those of ticket field options and dynamic content variants, are left out,
+// and the keys of each object are sorted, so the same configuration always encodes
+// to the same bytes.
+func (b *Bundle) Encode(w io.Writer, format Format) error {
+	doc := make(map[string]interface{})
+	if len(b.Kinds) > 0 {
+		doc["kinds"] = b.Kinds
+	}
+
+	kinds, err := b.managedKinds()
+	if err != nil {
+		return err
+	}
+	for _, kind := range kinds {
+		records, err := resources[kind].records(b)
+		if err != nil {
+			return err
+		}
+		if len(records) == 0 {
+			continue
+		}
+
+		objects := make([]interface{}, len(records))
+		for i, r := range records {
+			objects[i] = r.fields
+		}
+		doc[string(kind)] = objects
+	}
+
+	switch format {
+	case FormatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		enc.SetEscapeHTML(false)
+		return enc.Encode(doc)
+	case FormatYAML:
+		enc := yaml.NewEncoder(w)
+		enc.SetIndent(2)
+		if err := enc.Encode(doc); err != nil {
+			return err
+		}
+		return enc.Close()
+	}
+	return fmt.Errorf("unknown format %q", format)
+}
+
+// Decode reads a bundle written by Encode
+func Decode(r io.Reader, format Format) (*Bundle, error) {
+	var data []byte
+	switch format {
+	case FormatJSON:
+		var err error
+		data, err = io.ReadAll(r)
+		if err != nil {
+			return nil, err
+		}
+	case FormatYAML:
+		var doc interface{}
+		if err := yaml.NewDecoder(r).Decode(&doc); err != nil && err != io.EOF {
+			return nil, err
+		}
+
+		var err error
+		data, err = json.Marshal(doc)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unknown format %q", format)
+	}
+
+	b := &Bundle{}
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return b, nil
+	}
+	if err := json.Unmarshal(data, b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// volatileFields are the fields which differ between accounts or change on every update
+var volatileFields = []string{"id", "url", "created_at", "updated_at", "created_by", "updated_by"}
+
+// normalize converts a record to a JSON object without volatile fields.
+// nested lists the arrays of sub-records, such as the options of a ticket field,
+// whose volatile fields are left out too. Numbers are converted to int64 where
+// possible so that IDs survive the conversion to YAML.
+func normalize(v interface{}, nested []string) (map[string]interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var fields map[string]interface{}
+	if err := dec.Decode(&fields); err != nil {
+		return nil, err
+	}
+
+	deleteVolatileFields(fields)
+	for _, name := range nested {
+		items, _ := fields[name].([]interface{})
+		for _, item := range items {
+			if sub, ok := item.(map[string]interface{}); ok {
+				deleteVolatileFields(sub)
+			}
+		}
+	}
+	return convertNumbers(fields).(map[string]interface{}), nil
+}
+
+func deleteVolatileFields(fields map[string]interface{}) {
+	for _, name := range volatileFields {
+		delete(fields, name)
+	}
+}
+
+func convertNumbers(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			v[key] = convertNumbers(value)
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = convertNumbers(value)
+		}
+	case json.Number:
+		if n, err := v.Int64(); err == nil {
+			return n
+		}
+		if f, err := v.Float64(); err == nil {
+			return f
+		}
+	}
+	return v
+}
+
+// changedFields returns the sorted names of the top level fields which differ
+func changedFields(a, b map[string]interface{}) []string {
+	var fields []string
+	for key, value := range a {
+		other, ok := b[key]
+		if !ok || !jsonEqual(value, other) {
+			fields = append(fields, key)
+		}
+	}
+	for key := range b {
+		if _, ok := a[key]; !ok {
+			fields = append(fields, key)
+		}
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+func jsonEqual(a, b interface{}) bool {
+	x, err := json.Marshal(a)
+	if err != nil {
+		return false
+	}
+	y, err := json.Marshal(b)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(x, y)
+}
@@ -0,0 +1,430 @@
+package bundle
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/nukosuke/go-zendesk/zendesk"
+)
+
+var ctx = context.Background()
+
+// stringIDCollections are the collections whose records have string IDs
+var stringIDCollections = map[string]bool{"trigger_categories": true, "webhooks": true}
+
+// fakeAccount is an in-memory account serving the list, create, update and delete
+// endpoints of collections such as /triggers.json
+type fakeAccount struct {
+	mu          sync.Mutex
+	t           *testing.T
+	collections map[string][]map[string]interface{}
+	nextID      int64
+}
+
+func newFakeAccount(t *testing.T, fixtures map[string]string) *fakeAccount {
+	a := &fakeAccount{t: t, collections: make(map[string][]map[string]interface{}), nextID: 1}
+	for name, file := range fixtures {
+		data, err := os.ReadFile(filepath.Join("..", "..", "fixture", http.MethodGet, file))
+		if err != nil {
+			t.Fatalf("Failed to read fixture: %s", err)
+		}
+
+		var result map[string]json.RawMessage
+		if err := json.Unmarshal(data, &result); err != nil {
+			t.Fatalf("Failed to unmarshal fixture: %s", err)
+		}
+		var items []map[string]interface{}
+		if err := json.Unmarshal(result[name], &items); err != nil {
+			t.Fatalf("Failed to unmarshal fixture: %s", err)
+		}
+		a.collections[name] = items
+	}
+	return a
+}
+
+func (a *fakeAccount) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	path := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/"), ".json")
+	path = strings.TrimPrefix(path, "dynamic_content/")
+	parts := strings.Split(path, "/")
+	name := parts[0]
+	singular := strings.TrimSuffix(name, "s")
+	if strings.HasSuffix(name, "ies") {
+		singular = strings.TrimSuffix(name, "ies") + "y"
+	}
+
+	var body map[string]map[string]interface{}
+	if r.Method == http.MethodPost || r.Method == http.MethodPut || r.Method == http.MethodPatch {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			a.t.Errorf("Failed to decode request body: %s", err)
+		}
+	}
+
+	switch {
+	case r.Method == http.MethodGet && len(parts) == 1:
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			name:   a.collections[name],
+			"meta": map[string]interface{}{"has_more": false},
+		})
+	case r.Method == http.MethodPost && len(parts) == 1:
+		item := body[singular]
+		item["id"] = a.nextID
+		if stringIDCollections[name] {
+			item["id"] = strconv.FormatInt(a.nextID, 10)
+		}
+		a.nextID++
+		a.collections[name] = append(a.collections[name], item)
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(map[string]interface{}{singular: item})
+	case (r.Method == http.MethodPut || r.Method == http.MethodPatch) && len(parts) == 2:
+		for i, item := range a.collections[name] {
+			if idString(item["id"]) == parts[1] {
+				body[singular]["id"] = item["id"]
+				a.collections[name][i] = body[singular]
+			}
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{singular: body[singular]})
+	case r.Method == http.MethodDelete && len(parts) == 2:
+		items := a.collections[name][:0]
+		for _, item := range a.collections[name] {
+			if idString(item["id"]) != parts[1] {
+				items = append(items, item)
+			}
+		}
+		a.collections[name] = items
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		a.t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// renumber makes the account look like another account with the same configuration
+// by shifting all IDs, including those of nested records, and changing URLs
+func (a *fakeAccount) renumber(offset float64) {
+	var walk func(v interface{})
+	walk = func(v interface{}) {
+		switch v := v.(type) {
+		case map[string]interface{}:
+			for key, value := range v {
+				switch value := value.(type) {
+				case float64:
+					if key == "id" {
+						v[key] = value + offset
+					}
+				case string:
+					if key == "url" {
+						v[key] = strings.Replace(value, "example.zendesk.com", "production.zendesk.com", 1)
+					}
+				default:
+					walk(value)
+				}
+			}
+		case []interface{}:
+			for _, value := range v {
+				walk(value)
+			}
+		}
+	}
+	for _, items := range a.collections {
+		for _, item := range items {
+			walk(item)
+		}
+	}
+}
+
+func jsonString(v interface{}) string {
+	data, _ := json.Marshal(v)
+	return string(data)
+}
+
+// idString returns the ID as it appears in URLs
+func idString(id interface{}) string {
+	if s, ok := id.(string); ok {
+		return s
+	}
+	return jsonString(id)
+}
+
+func newTestClient(t *testing.T, account *fakeAccount) (*zendesk.Client, func()) {
+	server := httptest.NewServer(account)
+	client, err := zendesk.NewClient(nil)
+	if err != nil {
+		t.Fatalf("Failed to create client: %s", err)
+	}
+	if err := client.SetEndpointURL(server.URL); err != nil {
+		t.Fatalf("Failed to set endpoint: %s", err)
+	}
+	return client, server.Close
+}
+
+func TestSnapshotEncodeDecode(t *testing.T) {
+	account := newFakeAccount(t, map[string]string{"triggers": "triggers.json", "groups": "groups.json"})
+	client, closeFunc := newTestClient(t, account)
+	defer closeFunc()
+
+	b, err := Snapshot(ctx, client, KindTriggers, KindGroups)
+	if err != nil {
+		t.Fatalf("Failed to snapshot: %s", err)
+	}
+	if len(b.Triggers) != 8 || len(b.Groups) != 1 {
+		t.Fatalf("unexpected number of records: %d triggers, %d groups", len(b.Triggers), len(b.Groups))
+	}
+	for i := 1; i < len(b.Triggers); i++ {
+		if b.Triggers[i-1].Title > b.Triggers[i].Title {
+			t.Fatalf("triggers are not sorted by title: %q > %q", b.Triggers[i-1].Title, b.Triggers[i].Title)
+		}
+	}
+
+	for _, format := range []Format{FormatJSON, FormatYAML} {
+		var first, second bytes.Buffer
+		if err := b.Encode(&first, format); err != nil {
+			t.Fatalf("Failed to encode %s: %s", format, err)
+		}
+		if strings.Contains(first.String(), "360056295714") {
+			t.Fatalf("%s bundle contains record IDs", format)
+		}
+
+		decoded, err := Decode(bytes.NewReader(first.Bytes()), format)
+		if err != nil {
+			t.Fatalf("Failed to decode %s: %s", format, err)
+		}
+		if err := decoded.Encode(&second, format); err != nil {
+			t.Fatalf("Failed to encode %s: %s", format, err)
+		}
+		if first.String() != second.String() {
+			t.Fatalf("%s bundle changed after decoding:\n%s\n%s", format, first.String(), second.String())
+		}
+	}
+}
+
+func TestPlanApply(t *testing.T) {
+	account := newFakeAccount(t, map[string]string{"triggers": "triggers.json", "groups": "groups.json"})
+	client, closeFunc := newTestClient(t, account)
+	defer closeFunc()
+
+	desired, err := Snapshot(ctx, client, KindTriggers)
+	if err != nil {
+		t.Fatalf("Failed to snapshot: %s", err)
+	}
+
+	plan, err := NewPlan(ctx, client, desired, PlanOptions{Prune: true})
+	if err != nil {
+		t.Fatalf("Failed to plan: %s", err)
+	}
+	if !plan.Empty() {
+		t.Fatalf("expected empty plan, but got\n%s", plan)
+	}
+
+	desired.Triggers[0].Title = "Renamed trigger"
+	desired.Triggers[1].Description = "Changed in sandbox"
+	desired.Triggers = append(desired.Triggers, zendesk.Trigger{Title: "New trigger", Active: true})
+
+	plan, err = NewPlan(ctx, client, desired, PlanOptions{})
+	if err != nil {
+		t.Fatalf("Failed to plan: %s", err)
+	}
+	if len(plan.Changes) != 3 {
+		t.Fatalf("expected length of changes is 3, but got\n%s", plan)
+	}
+
+	plan, err = NewPlan(ctx, client, desired, PlanOptions{Prune: true})
+	if err != nil {
+		t.Fatalf("Failed to plan: %s", err)
+	}
+
+	expected := []struct {
+		action Action
+		key    string
+	}{
+		{ActionCreate, "New trigger"},
+		{ActionUpdate, desired.Triggers[1].Title},
+		{ActionCreate, "Renamed trigger"},
+		{ActionDelete, "Auto-assign to first email responding agent"},
+	}
+	if len(plan.Changes) != len(expected) {
+		t.Fatalf("expected length of changes is %d, but got\n%s", len(expected), plan)
+	}
+	for i, e := range expected {
+		c := plan.Changes[i]
+		if c.Action != e.action || c.Key != e.key {
+			t.Fatalf("expected change %d is %s %q, but got %s", i, e.action, e.key, c)
+		}
+	}
+	if fields := plan.Changes[1].Fields; len(fields) != 1 || fields[0] != "description" {
+		t.Fatalf("expected changed fields are [description], but got %v", fields)
+	}
+
+	if err := plan.Apply(ctx, client); err != nil {
+		t.Fatalf("Failed to apply: %s", err)
+	}
+
+	plan, err = NewPlan(ctx, client, desired, PlanOptions{Prune: true})
+	if err != nil {
+		t.Fatalf("Failed to plan: %s", err)
+	}
+	if !plan.Empty() {
+		t.Fatalf("expected empty plan after apply, but got\n%s", plan)
+	}
+	if len(account.collections["groups"]) != 1 {
+		t.Fatalf("groups are not managed by the bundle, but changed")
+	}
+}
+
+func TestPlanAcrossAccounts(t *testing.T) {
+	fixtures := map[string]string{"ticket_fields": "ticket_fields.json", "items": "dynamic_content/items.json"}
+	sandbox := newFakeAccount(t, fixtures)
+	sandboxClient, closeSandbox := newTestClient(t, sandbox)
+	defer closeSandbox()
+
+	production := newFakeAccount(t, fixtures)
+	production.renumber(1000)
+	productionClient, closeProduction := newTestClient(t, production)
+	defer closeProduction()
+
+	desired, err := Snapshot(ctx, sandboxClient, KindTicketFields, KindDynamicContent)
+	if err != nil {
+		t.Fatalf("Failed to snapshot: %s", err)
+	}
+	if len(desired.TicketFields) == 0 || len(desired.DynamicContent) == 0 {
+		t.Fatalf("unexpected number of records: %d ticket fields, %d dynamic content items", len(desired.TicketFields), len(desired.DynamicContent))
+	}
+
+	var buf bytes.Buffer
+	if err := desired.Encode(&buf, FormatYAML); err != nil {
+		t.Fatalf("Failed to encode: %s", err)
+	}
+	if strings.Contains(buf.String(), "360001100153") || strings.Contains(buf.String(), "example.zendesk.com") {
+		t.Fatalf("bundle contains IDs or URLs of nested records:\n%s", buf.String())
+	}
+	decoded, err := Decode(&buf, FormatYAML)
+	if err != nil {
+		t.Fatalf("Failed to decode: %s", err)
+	}
+
+	for _, b := range []*Bundle{desired, decoded} {
+		plan, err := NewPlan(ctx, productionClient, b, PlanOptions{Prune: true})
+		if err != nil {
+			t.Fatalf("Failed to plan: %s", err)
+		}
+		if !plan.Empty() {
+			t.Fatalf("expected empty plan, but got\n%s", plan)
+		}
+	}
+
+	var tagger *zendesk.TicketField
+	for i := range desired.TicketFields {
+		if desired.TicketFields[i].Type == "tagger" {
+			tagger = &desired.TicketFields[i]
+		}
+	}
+	if tagger == nil || len(tagger.CustomFieldOptions) == 0 {
+		t.Fatal("expected a tagger field with options")
+	}
+	sandboxOptionID := tagger.CustomFieldOptions[0].ID
+	tagger.CustomFieldOptions[0].Name = "Renamed option"
+
+	plan, err := NewPlan(ctx, productionClient, desired, PlanOptions{})
+	if err != nil {
+		t.Fatalf("Failed to plan: %s", err)
+	}
+	if len(plan.Changes) != 1 || plan.Changes[0].Action != ActionUpdate {
+		t.Fatalf("expected an update of the tagger field, but got\n%s", plan)
+	}
+	if err := plan.Apply(ctx, productionClient); err != nil {
+		t.Fatalf("Failed to apply: %s", err)
+	}
+	if tagger.CustomFieldOptions[0].ID != sandboxOptionID {
+		t.Fatal("options of the bundle were changed by apply")
+	}
+
+	for _, field := range production.collections["ticket_fields"] {
+		if field["type"] != "tagger" {
+			continue
+		}
+		option := field["custom_field_options"].([]interface{})[0].(map[string]interface{})
+		if option["name"] != "Renamed option" || option["id"] != float64(sandboxOptionID)+1000 {
+			t.Fatalf("expected the option to keep its production ID, but got %v", option)
+		}
+	}
+}
+
+func TestPlanTriggerCategories(t *testing.T) {
+	sandbox := newFakeAccount(t, map[string]string{"trigger_categories": "trigger_categories.json"})
+	sandboxClient, closeSandbox := newTestClient(t, sandbox)
+	defer closeSandbox()
+
+	production := newFakeAccount(t, map[string]string{})
+	productionClient, closeProduction := newTestClient(t, production)
+	defer closeProduction()
+
+	for i := 1; i < len(AllKinds); i++ {
+		if AllKinds[i] == KindTriggers && AllKinds[i-1] != KindTriggerCategories {
+			t.Fatalf("trigger categories must be created right before triggers: %v", AllKinds)
+		}
+	}
+
+	desired, err := Snapshot(ctx, sandboxClient, KindTriggerCategories)
+	if err != nil {
+		t.Fatalf("Failed to snapshot: %s", err)
+	}
+	if len(desired.TriggerCategories) != 2 || desired.TriggerCategories[0].RuleCounts != nil {
+		t.Fatalf("unexpected trigger categories: %+v", desired.TriggerCategories)
+	}
+
+	plan, err := NewPlan(ctx, productionClient, desired, PlanOptions{})
+	if err != nil {
+		t.Fatalf("Failed to plan: %s", err)
+	}
+	if len(plan.Changes) != 2 || plan.Changes[0].Action != ActionCreate || plan.Changes[0].Key != "Notifications" {
+		t.Fatalf("expected creates of the trigger categories, but got\n%s", plan)
+	}
+	if err := plan.Apply(ctx, productionClient); err != nil {
+		t.Fatalf("Failed to apply: %s", err)
+	}
+
+	desired.TriggerCategories[1].Name = "Assignment"
+	plan, err = NewPlan(ctx, productionClient, desired, PlanOptions{Prune: true})
+	if err != nil {
+		t.Fatalf("Failed to plan: %s", err)
+	}
+	if err := plan.Apply(ctx, productionClient); err != nil {
+		t.Fatalf("Failed to apply: %s", err)
+	}
+
+	plan, err = NewPlan(ctx, productionClient, desired, PlanOptions{Prune: true})
+	if err != nil {
+		t.Fatalf("Failed to plan: %s", err)
+	}
+	if !plan.Empty() {
+		t.Fatalf("expected empty plan after apply, but got\n%s", plan)
+	}
+	if n := len(production.collections["trigger_categories"]); n != 2 {
+		t.Fatalf("expected number of trigger categories is 2, but got %d", n)
+	}
+}
+
+func TestNewPlanDuplicateKeys(t *testing.T) {
+	account := newFakeAccount(t, map[string]string{"triggers": "triggers.json"})
+	client, closeFunc := newTestClient(t, account)
+	defer closeFunc()
+
+	desired := &Bundle{
+		Kinds:    []Kind{KindTriggers},
+		Triggers: []zendesk.Trigger{{Title: "Same"}, {Title: "Same"}},
+	}
+	if _, err := NewPlan(ctx, client, desired, PlanOptions{}); err == nil {
+		t.Fatalf("did not get expected error")
+	}
+}
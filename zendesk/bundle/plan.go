@@ -0,0 +1,206 @@
+package bundle
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// Action is an action of a change
+type Action string
+
+// Actions of changes
+const (
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+)
+
+// PlanOptions is options for NewPlan
+type PlanOptions struct {
+	// Prune deletes the records of the managed kinds which are not in the bundle
+	Prune bool
+}
+
+// Change is a change made to an account when a plan is applied
+type Change struct {
+	Kind   Kind
+	Action Action
+
+	// Key is the title, name or other natural key of the record
+	Key string
+
+	// ID is the ID of the record in the account. It's empty for ActionCreate.
+	ID string
+
+	// Fields is the sorted list of top level fields changed by ActionUpdate
+	Fields []string
+
+	current interface{}
+	desired interface{}
+}
+
+// Plan is the list of changes needed to make an account match a bundle.
+// Creates and updates are ordered by kind as listed in AllKinds, and deletes
+// follow in the reverse order.
+type Plan struct {
+	Changes []Change
+}
+
+// NewPlan compares the bundle with the account and returns the changes to apply.
+// The plan is empty when the account matches the bundle, which can be used to detect drift.
+// Keys must be unique within each managed kind both in the bundle and in the account.
+func NewPlan(ctx context.Context, api API, desired *Bundle, opts PlanOptions) (*Plan, error) {
+	kinds, err := desired.managedKinds()
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &Plan{}
+	var deletes []Change
+	for _, kind := range kinds {
+		r := resources[kind]
+
+		want, err := r.records(desired)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkUniqueKeys(kind, want); err != nil {
+			return nil, fmt.Errorf("invalid bundle: %w", err)
+		}
+
+		have, err := r.fetch(ctx, api)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch %s: %w", kind, err)
+		}
+		if err := checkUniqueKeys(kind, have); err != nil {
+			return nil, err
+		}
+
+		current := make(map[string]record, len(have))
+		for _, rec := range have {
+			current[rec.key] = rec
+		}
+
+		wanted := make(map[string]bool, len(want))
+		for _, rec := range want {
+			wanted[rec.key] = true
+
+			cur, ok := current[rec.key]
+			if !ok {
+				plan.Changes = append(plan.Changes, Change{
+					Kind:    kind,
+					Action:  ActionCreate,
+					Key:     rec.key,
+					desired: rec.value,
+				})
+				continue
+			}
+
+			fields := changedFields(cur.fields, rec.fields)
+			if len(fields) == 0 {
+				continue
+			}
+			plan.Changes = append(plan.Changes, Change{
+				Kind:    kind,
+				Action:  ActionUpdate,
+				Key:     rec.key,
+				ID:      cur.id,
+				Fields:  fields,
+				current: cur.value,
+				desired: rec.value,
+			})
+		}
+
+		if !opts.Prune {
+			continue
+		}
+
+		var kindDeletes []Change
+		for _, rec := range have {
+			if wanted[rec.key] {
+				continue
+			}
+			kindDeletes = append(kindDeletes, Change{
+				Kind:    kind,
+				Action:  ActionDelete,
+				Key:     rec.key,
+				ID:      rec.id,
+				current: rec.value,
+			})
+		}
+		deletes = append(kindDeletes, deletes...)
+	}
+
+	plan.Changes = append(plan.Changes, deletes...)
+	return plan, nil
+}
+
+// Empty reports whether the plan has no changes
+func (p *Plan) Empty() bool {
+	return len(p.Changes) == 0
+}
+
+// String returns a human readable summary of the plan, one change per line
+func (p *Plan) String() string {
+	var sb strings.Builder
+	for _, c := range p.Changes {
+		sb.WriteString(c.String())
+		sb.WriteByte('\n')
+	}
+	return sb.String()
+}
+
+// String returns a human readable summary of the change such as `~ triggers "Notify requester" (360056295714): actions`
+func (c Change) String() string {
+	switch c.Action {
+	case ActionCreate:
+		return fmt.Sprintf("+ %s %q", c.Kind, c.Key)
+	case ActionUpdate:
+		return fmt.Sprintf("~ %s %q (%s): %s", c.Kind, c.Key, c.ID, strings.Join(c.Fields, ", "))
+	case ActionDelete:
+		return fmt.Sprintf("- %s %q (%s)", c.Kind, c.Key, c.ID)
+	}
+	return fmt.Sprintf("? %s %q", c.Kind, c.Key)
+}
+
+// Apply makes the changes in order and stops at the first error.
+// Applying the plan of the same bundle again after a success makes no change.
+func (p *Plan) Apply(ctx context.Context, api API) error {
+	for _, c := range p.Changes {
+		r, ok := resources[c.Kind]
+		if !ok {
+			return fmt.Errorf("unknown kind %q", c.Kind)
+		}
+
+		var err error
+		switch c.Action {
+		case ActionCreate:
+			err = r.create(ctx, api, c.desired)
+		case ActionUpdate:
+			err = r.update(ctx, api, c.current, c.desired)
+		case ActionDelete:
+			err = r.delete(ctx, api, c.current)
+		default:
+			err = fmt.Errorf("unknown action %q", c.Action)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to %s %s %q: %w", c.Action, c.Kind, c.Key, err)
+		}
+	}
+	return nil
+}
+
+func checkUniqueKeys(kind Kind, records []record) error {
+	seen := make(map[string]bool, len(records))
+	for _, rec := range records {
+		if rec.key == "" {
+			return fmt.Errorf("%s record %s has no key", kind, rec.id)
+		}
+		if seen[rec.key] {
+			return fmt.Errorf("duplicate %s record %q", kind, rec.key)
+		}
+		seen[rec.key] = true
+	}
+	return nil
+}
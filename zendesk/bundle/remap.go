@@ -0,0 +1,569 @@
+package bundle
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/nukosuke/go-zendesk/zendesk"
+)
+
+// RefKind is a kind of records referred to by the conditions and actions of rules
+type RefKind string
+
+// Kinds of referred records
+const (
+	RefGroup           RefKind = "group"
+	RefBrand           RefKind = "brand"
+	RefTicketField     RefKind = "ticket_field"
+	RefTicketForm      RefKind = "ticket_form"
+	RefCustomStatus    RefKind = "custom_status"
+	RefTarget          RefKind = "target"
+	RefWebhook         RefKind = "webhook"
+	RefTriggerCategory RefKind = "trigger_category"
+)
+
+// Reasons of unresolved references
+const (
+	ReasonNotFoundInSource      = "not found in source"
+	ReasonNotFoundInDestination = "not found in destination"
+	ReasonAmbiguous             = "ambiguous in destination"
+)
+
+// RemapAPI is the set of client methods used to index referred records.
+// It's implemented by *zendesk.Client.
+type RemapAPI interface {
+	zendesk.BrandAPI
+	zendesk.CustomStatusAPI
+	zendesk.GroupAPI
+	zendesk.TargetAPI
+	zendesk.TicketFieldAPI
+	zendesk.TicketFormAPI
+	zendesk.TriggerCategoryAPI
+	zendesk.WebhookAPI
+}
+
+// Index maps the IDs of the records referred to by rules to their natural keys and back.
+// The keys are the name of groups, brands, ticket forms, webhooks and trigger categories,
+// the title of ticket fields and targets, and the agent label of custom statuses.
+type Index struct {
+	keys map[RefKind]map[string]string
+	ids  map[RefKind]map[string][]string
+}
+
+// NewIndex fetches the records which can be referred to by rules
+func NewIndex(ctx context.Context, api RemapAPI) (*Index, error) {
+	x := &Index{}
+
+	groups, err := listAllCBP(ctx, api.GetGroupsCBP)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch groups: %w", err)
+	}
+	for _, g := range groups {
+		if !g.Deleted {
+			x.Add(RefGroup, formatID(g.ID), g.Name)
+		}
+	}
+
+	brands, err := listAllCBP(ctx, api.GetBrandsCBP)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch brands: %w", err)
+	}
+	for _, b := range brands {
+		x.Add(RefBrand, formatID(b.ID), b.Name)
+	}
+
+	fields, err := listAllCBP(ctx, api.GetTicketFieldsCBP)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch ticket fields: %w", err)
+	}
+	for _, f := range fields {
+		x.Add(RefTicketField, formatID(f.ID), f.Title)
+	}
+
+	forms, err := listAllCBP(ctx, api.GetTicketFormsCBP)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch ticket forms: %w", err)
+	}
+	for _, f := range forms {
+		x.Add(RefTicketForm, formatID(f.ID), f.Name)
+	}
+
+	statuses, err := api.GetCustomStatuses(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch custom statuses: %w", err)
+	}
+	for _, s := range statuses {
+		x.Add(RefCustomStatus, formatID(s.ID), s.AgentLabel)
+	}
+
+	targets, _, err := api.GetTargets(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch targets: %w", err)
+	}
+	for _, t := range targets {
+		x.Add(RefTarget, formatID(t.ID), t.Title)
+	}
+
+	webhooks, err := listAllCBP(ctx, func(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.Webhook, zendesk.CursorPaginationMeta, error) {
+		return api.GetWebhooks(ctx, &zendesk.WebhookListOptions{CursorPagination: opts.CursorPagination})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch webhooks: %w", err)
+	}
+	for _, w := range webhooks {
+		x.Add(RefWebhook, w.ID, w.Name)
+	}
+
+	categories, err := listAllCBP(ctx, func(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.TriggerCategory, zendesk.CursorPaginationMeta, error) {
+		return api.GetTriggerCategories(ctx, &zendesk.TriggerCategoryListOptions{CursorPagination: opts.CursorPagination})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch trigger categories: %w", err)
+	}
+	for _, c := range categories {
+		x.Add(RefTriggerCategory, c.ID, c.Name)
+	}
+	return x, nil
+}
+
+// Add adds a record to the index
+func (x *Index) Add(kind RefKind, id string, key string) {
+	if x.keys == nil {
+		x.keys = make(map[RefKind]map[string]string)
+		x.ids = make(map[RefKind]map[string][]string)
+	}
+	if x.keys[kind] == nil {
+		x.keys[kind] = make(map[string]string)
+		x.ids[kind] = make(map[string][]string)
+	}
+	x.keys[kind][id] = key
+	x.ids[kind][key] = append(x.ids[kind][key], id)
+}
+
+// Key returns the natural key of the record
+func (x *Index) Key(kind RefKind, id string) (string, bool) {
+	key, ok := x.keys[kind][id]
+	return key, ok
+}
+
+// IDs returns the IDs of the records with the natural key
+func (x *Index) IDs(kind RefKind, key string) []string {
+	return x.ids[kind][key]
+}
+
+// UnresolvedRef is a reference which couldn't be rewritten. The reference is left unchanged.
+type UnresolvedRef struct {
+	// Rule is the title of the rule or SLA policy, or the name of the ticket form
+	Rule string
+
+	// Field is the field of the condition or action
+	Field string
+
+	Kind RefKind
+
+	// ID is the ID in the source account
+	ID string
+
+	// Key is the natural key in the source account, which is empty if ID is not found
+	Key string
+
+	// Reason is one of ReasonNotFoundInSource, ReasonNotFoundInDestination and ReasonAmbiguous
+	Reason string
+}
+
+// String returns a human readable description of the reference
+func (r UnresolvedRef) String() string {
+	if r.Key == "" {
+		return fmt.Sprintf("%q: %s %s %s: %s", r.Rule, r.Field, r.Kind, r.ID, r.Reason)
+	}
+	return fmt.Sprintf("%q: %s %s %s (%q): %s", r.Rule, r.Field, r.Kind, r.ID, r.Key, r.Reason)
+}
+
+// Remapper rewrites the IDs referred to by rules, SLA policies and ticket forms copied from a source account,
+// such as a sandbox, to the IDs of the records with the same natural key in the
+// destination account.
+type Remapper struct {
+	source      *Index
+	destination *Index
+}
+
+// NewRemapper creates a remapper from the indexes of the source and destination accounts
+func NewRemapper(source, destination *Index) *Remapper {
+	return &Remapper{source: source, destination: destination}
+}
+
+// refFields maps condition and action fields whose value is an ID to the kind of the record
+var refFields = map[string]RefKind{
+	"group_id":         RefGroup,
+	"brand_id":         RefBrand,
+	"ticket_form_id":   RefTicketForm,
+	"custom_status_id": RefCustomStatus,
+}
+
+// refListFields maps action fields whose first value is an ID to the kind of the record
+var refListFields = map[string]RefKind{
+	"notification_group":   RefGroup,
+	"notification_target":  RefTarget,
+	"notification_webhook": RefWebhook,
+}
+
+// Trigger returns a copy of the trigger with the IDs rewritten, including the ID of its category
+func (m *Remapper) Trigger(trigger zendesk.Trigger) (zendesk.Trigger, []UnresolvedRef) {
+	r := &remapping{m: m, rule: trigger.Title}
+
+	if trigger.CategoryID != "" {
+		if id, ok := r.resolve("category_id", RefTriggerCategory, trigger.CategoryID); ok {
+			trigger.CategoryID = id
+		}
+	}
+
+	all := make([]zendesk.TriggerCondition, len(trigger.Conditions.All))
+	for i, c := range trigger.Conditions.All {
+		c.Field, c.Value = r.remap(c.Field, c.Value, false)
+		all[i] = c
+	}
+	any := make([]zendesk.TriggerCondition, len(trigger.Conditions.Any))
+	for i, c := range trigger.Conditions.Any {
+		c.Field, c.Value = r.remap(c.Field, c.Value, false)
+		any[i] = c
+	}
+	actions := make([]zendesk.TriggerAction, len(trigger.Actions))
+	for i, a := range trigger.Actions {
+		a.Field, a.Value = r.remap(a.Field, a.Value, true)
+		actions[i] = a
+	}
+
+	trigger.Conditions.All = all
+	trigger.Conditions.Any = any
+	trigger.Actions = actions
+	return trigger, r.unresolved
+}
+
+// Automation returns a copy of the automation with the IDs rewritten
+func (m *Remapper) Automation(automation zendesk.Automation) (zendesk.Automation, []UnresolvedRef) {
+	r := &remapping{m: m, rule: automation.Title}
+
+	all := make([]zendesk.AutomationCondition, len(automation.Conditions.All))
+	for i, c := range automation.Conditions.All {
+		c.Field, c.Value = r.remapString(c.Field, c.Value)
+		all[i] = c
+	}
+	any := make([]zendesk.AutomationCondition, len(automation.Conditions.Any))
+	for i, c := range automation.Conditions.Any {
+		c.Field, c.Value = r.remapString(c.Field, c.Value)
+		any[i] = c
+	}
+	actions := make([]zendesk.AutomationAction, len(automation.Actions))
+	for i, a := range automation.Actions {
+		a.Field, a.Value = r.remap(a.Field, a.Value, true)
+		actions[i] = a
+	}
+
+	automation.Conditions.All = all
+	automation.Conditions.Any = any
+	automation.Actions = actions
+	return automation, r.unresolved
+}
+
+// Macro returns a copy of the macro with the IDs rewritten
+func (m *Remapper) Macro(macro zendesk.Macro) (zendesk.Macro, []UnresolvedRef) {
+	r := &remapping{m: m, rule: macro.Title}
+
+	actions := make([]zendesk.MacroAction, len(macro.Actions))
+	for i, a := range macro.Actions {
+		a.Field, a.Value = r.remapString(a.Field, a.Value)
+		actions[i] = a
+	}
+
+	macro.Actions = actions
+	return macro, r.unresolved
+}
+
+// View returns a copy of the view with the IDs rewritten. Views restricted
+// to users are left unchanged, since users are not indexed.
+func (m *Remapper) View(view zendesk.View) (zendesk.View, []UnresolvedRef) {
+	r := &remapping{m: m, rule: view.Title}
+
+	all := make([]zendesk.TriggerCondition, len(view.Conditions.All))
+	for i, c := range view.Conditions.All {
+		c.Field, c.Value = r.remap(c.Field, c.Value, false)
+		all[i] = c
+	}
+	any := make([]zendesk.TriggerCondition, len(view.Conditions.Any))
+	for i, c := range view.Conditions.Any {
+		c.Field, c.Value = r.remap(c.Field, c.Value, false)
+		any[i] = c
+	}
+	view.Conditions.All = all
+	view.Conditions.Any = any
+
+	execution := &view.Execution
+	execution.GroupBy = r.remapID("group_by", RefTicketField, execution.GroupBy).(string)
+	execution.SortBy = r.remapID("sort_by", RefTicketField, execution.SortBy).(string)
+	if execution.Group != nil {
+		group := *execution.Group
+		group.ID = r.remapID("group_by", RefTicketField, group.ID)
+		execution.Group = &group
+	}
+	if execution.Sort != nil {
+		sort := *execution.Sort
+		sort.ID = r.remapID("sort_by", RefTicketField, sort.ID)
+		execution.Sort = &sort
+	}
+	execution.Columns = r.remapColumns("columns", execution.Columns)
+	execution.Fields = r.remapColumns("fields", execution.Fields)
+	execution.CustomFields = r.remapColumns("custom_fields", execution.CustomFields)
+
+	if view.Restriction != nil && view.Restriction.Type == "Group" {
+		restriction := *view.Restriction
+		if restriction.ID != 0 {
+			restriction.ID = r.remapInt64("restriction", RefGroup, restriction.ID)
+		}
+		restriction.IDs = r.remapInt64s("restriction", RefGroup, restriction.IDs)
+		view.Restriction = &restriction
+	}
+	return view, r.unresolved
+}
+
+// SLAPolicy returns a copy of the SLA policy with the IDs rewritten
+func (m *Remapper) SLAPolicy(policy zendesk.SLAPolicy) (zendesk.SLAPolicy, []UnresolvedRef) {
+	r := &remapping{m: m, rule: policy.Title}
+
+	all := make([]zendesk.SLAPolicyFilter, len(policy.Filter.All))
+	for i, f := range policy.Filter.All {
+		f.Field, f.Value = r.remapString(f.Field, f.Value)
+		all[i] = f
+	}
+	any := make([]zendesk.SLAPolicyFilter, len(policy.Filter.Any))
+	for i, f := range policy.Filter.Any {
+		f.Field, f.Value = r.remapString(f.Field, f.Value)
+		any[i] = f
+	}
+
+	policy.Filter.All = all
+	policy.Filter.Any = any
+	return policy, r.unresolved
+}
+
+// TicketForm returns a copy of the ticket form with the IDs of its fields,
+// brands and conditions rewritten
+func (m *Remapper) TicketForm(form zendesk.TicketForm) (zendesk.TicketForm, []UnresolvedRef) {
+	r := &remapping{m: m, rule: form.Name}
+
+	form.TicketFieldIDs = r.remapInt64s("ticket_field_ids", RefTicketField, form.TicketFieldIDs)
+	form.RestrictedBrandIDs = r.remapInt64s("restricted_brand_ids", RefBrand, form.RestrictedBrandIDs)
+	form.AgentConditions = r.remapFormConditions("agent_conditions", form.AgentConditions)
+	form.EndUserConditions = r.remapFormConditions("end_user_conditions", form.EndUserConditions)
+	return form, r.unresolved
+}
+
+// Bundle rewrites the IDs in the triggers, automations, macros, views, SLA policies
+// and ticket forms of the bundle
+func (m *Remapper) Bundle(b *Bundle) []UnresolvedRef {
+	var unresolved []UnresolvedRef
+	for i, f := range b.TicketForms {
+		var refs []UnresolvedRef
+		b.TicketForms[i], refs = m.TicketForm(f)
+		unresolved = append(unresolved, refs...)
+	}
+	for i, p := range b.SLAPolicies {
+		var refs []UnresolvedRef
+		b.SLAPolicies[i], refs = m.SLAPolicy(p)
+		unresolved = append(unresolved, refs...)
+	}
+	for i, v := range b.Views {
+		var refs []UnresolvedRef
+		b.Views[i], refs = m.View(v)
+		unresolved = append(unresolved, refs...)
+	}
+	for i, mac := range b.Macros {
+		var refs []UnresolvedRef
+		b.Macros[i], refs = m.Macro(mac)
+		unresolved = append(unresolved, refs...)
+	}
+	for i, t := range b.Triggers {
+		var refs []UnresolvedRef
+		b.Triggers[i], refs = m.Trigger(t)
+		unresolved = append(unresolved, refs...)
+	}
+	for i, a := range b.Automations {
+		var refs []UnresolvedRef
+		b.Automations[i], refs = m.Automation(a)
+		unresolved = append(unresolved, refs...)
+	}
+	return unresolved
+}
+
+// remapping collects the unresolved references of a rule
+type remapping struct {
+	m          *Remapper
+	rule       string
+	unresolved []UnresolvedRef
+}
+
+// remap rewrites the field and the value of a condition or an action
+func (r *remapping) remap(field string, value interface{}, action bool) (string, interface{}) {
+	if id := strings.TrimPrefix(field, "custom_fields_"); id != field {
+		if newID, ok := r.resolve(field, RefTicketField, id); ok {
+			field = "custom_fields_" + newID
+		}
+		return field, value
+	}
+
+	if kind, ok := refFields[field]; ok {
+		return field, r.remapID(field, kind, value)
+	}
+
+	if kind, ok := refListFields[field]; ok && action {
+		switch values := value.(type) {
+		case []interface{}:
+			if len(values) > 0 {
+				remapped := append([]interface{}(nil), values...)
+				remapped[0] = r.remapID(field, kind, values[0])
+				return field, remapped
+			}
+		case []string:
+			if len(values) > 0 {
+				remapped := append([]string(nil), values...)
+				remapped[0] = r.remapID(field, kind, values[0]).(string)
+				return field, remapped
+			}
+		}
+	}
+	return field, value
+}
+
+// remapString rewrites the field and the value of a condition or an action whose value is a string
+func (r *remapping) remapString(field string, value string) (string, string) {
+	field, remapped := r.remap(field, value, false)
+	return field, remapped.(string)
+}
+
+// remapID rewrites an ID keeping its type. Values which are not IDs, such as
+// "current_groups" or an empty string, are returned as is.
+func (r *remapping) remapID(field string, kind RefKind, value interface{}) interface{} {
+	var id string
+	switch v := value.(type) {
+	case string:
+		id = v
+	case float64:
+		id = strconv.FormatFloat(v, 'f', -1, 64)
+	case json.Number:
+		id = v.String()
+	case int64:
+		id = strconv.FormatInt(v, 10)
+	default:
+		return value
+	}
+	if !isRefID(kind, id) {
+		return value
+	}
+
+	newID, ok := r.resolve(field, kind, id)
+	if !ok {
+		return value
+	}
+	if _, ok := value.(string); ok {
+		return newID
+	}
+	return json.Number(newID)
+}
+
+// remapInt64 rewrites a numeric ID
+func (r *remapping) remapInt64(field string, kind RefKind, id int64) int64 {
+	newID, ok := r.resolve(field, kind, formatID(id))
+	if !ok {
+		return id
+	}
+	n, err := strconv.ParseInt(newID, 10, 64)
+	if err != nil {
+		return id
+	}
+	return n
+}
+
+// remapInt64s returns a copy of the IDs rewritten
+func (r *remapping) remapInt64s(field string, kind RefKind, ids []int64) []int64 {
+	if ids == nil {
+		return nil
+	}
+	remapped := make([]int64, len(ids))
+	for i, id := range ids {
+		remapped[i] = r.remapInt64(field, kind, id)
+	}
+	return remapped
+}
+
+// remapColumns returns a copy of the view columns with the IDs of custom fields rewritten.
+// System fields, such as "subject", are kept as is.
+func (r *remapping) remapColumns(field string, columns []zendesk.ViewColumn) []zendesk.ViewColumn {
+	if columns == nil {
+		return nil
+	}
+	remapped := make([]zendesk.ViewColumn, len(columns))
+	for i, c := range columns {
+		c.ID = r.remapID(field, RefTicketField, c.ID)
+		remapped[i] = c
+	}
+	return remapped
+}
+
+// remapFormConditions returns a copy of the ticket form conditions with the IDs of
+// the parent and child fields rewritten
+func (r *remapping) remapFormConditions(field string, conditions []zendesk.TicketFormCondition) []zendesk.TicketFormCondition {
+	if conditions == nil {
+		return nil
+	}
+	remapped := make([]zendesk.TicketFormCondition, len(conditions))
+	for i, c := range conditions {
+		c.ParentFieldID = r.remapInt64(field, RefTicketField, c.ParentFieldID)
+		children := make([]zendesk.TicketFormConditionChildField, len(c.ChildFields))
+		for j, child := range c.ChildFields {
+			child.ID = r.remapInt64(field, RefTicketField, child.ID)
+			children[j] = child
+		}
+		c.ChildFields = children
+		remapped[i] = c
+	}
+	return remapped
+}
+
+// resolve returns the ID of the record in the destination with the same key as the record in the source
+func (r *remapping) resolve(field string, kind RefKind, id string) (string, bool) {
+	ref := UnresolvedRef{Rule: r.rule, Field: field, Kind: kind, ID: id}
+
+	key, ok := r.m.source.Key(kind, id)
+	if !ok {
+		ref.Reason = ReasonNotFoundInSource
+		r.unresolved = append(r.unresolved, ref)
+		return "", false
+	}
+	ref.Key = key
+
+	ids := r.m.destination.IDs(kind, key)
+	switch len(ids) {
+	case 1:
+		return ids[0], true
+	case 0:
+		ref.Reason = ReasonNotFoundInDestination
+	default:
+		ref.Reason = ReasonAmbiguous
+	}
+	r.unresolved = append(r.unresolved, ref)
+	return "", false
+}
+
+// isRefID reports whether the value is an ID. Webhook IDs are strings, and other IDs are numbers.
+func isRefID(kind RefKind, value string) bool {
+	if value == "" {
+		return false
+	}
+	if kind == RefWebhook {
+		return true
+	}
+	_, err := strconv.ParseInt(value, 10, 64)
+	return err == nil
+}
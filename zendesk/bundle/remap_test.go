@@ -0,0 +1,281 @@
+package bundle
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/nukosuke/go-zendesk/zendesk"
+)
+
+func TestNewIndex(t *testing.T) {
+	account := newFakeAccount(t, map[string]string{
+		"groups":             "groups.json",
+		"brands":             "brands.json",
+		"ticket_fields":      "ticket_fields.json",
+		"ticket_forms":       "ticket_forms.json",
+		"custom_statuses":    "custom_statuses.json",
+		"targets":            "targets.json",
+		"webhooks":           "webhooks.json",
+		"trigger_categories": "trigger_categories.json",
+	})
+	client, closeFunc := newTestClient(t, account)
+	defer closeFunc()
+
+	index, err := NewIndex(ctx, client)
+	if err != nil {
+		t.Fatalf("Failed to create index: %s", err)
+	}
+
+	cases := []struct {
+		kind RefKind
+		id   string
+		key  string
+	}{
+		{RefGroup, "360002440594", "Support"},
+		{RefBrand, "360002143133", "brand2"},
+		{RefTicketField, "360011737434", "Subject"},
+		{RefCustomStatus, "35437", "Waiting for vendor"},
+		{RefTarget, "360000217438", "target :: http :: postbin"},
+		{RefWebhook, "01EJFTSCC78X5V07NPY2MHR00M", "Example Webhook"},
+		{RefTriggerCategory, "10027", "Routing"},
+	}
+	for _, c := range cases {
+		if key, ok := index.Key(c.kind, c.id); !ok || key != c.key {
+			t.Errorf("expected key of %s %s is %q, but got %q", c.kind, c.id, c.key, key)
+		}
+		if ids := index.IDs(c.kind, c.key); len(ids) != 1 || ids[0] != c.id {
+			t.Errorf("expected IDs of %s %q are [%s], but got %v", c.kind, c.key, c.id, ids)
+		}
+	}
+}
+
+func TestRemapperTrigger(t *testing.T) {
+	source := &Index{}
+	source.Add(RefGroup, "100", "Support")
+	source.Add(RefTicketField, "200", "Product")
+	source.Add(RefWebhook, "01SANDBOX", "Slack")
+	source.Add(RefCustomStatus, "300", "Waiting for vendor")
+	source.Add(RefBrand, "400", "Acme")
+	source.Add(RefTarget, "500", "Legacy")
+
+	destination := &Index{}
+	destination.Add(RefGroup, "1100", "Support")
+	destination.Add(RefTicketField, "1200", "Product")
+	destination.Add(RefWebhook, "01PRODUCTION", "Slack")
+	destination.Add(RefCustomStatus, "1300", "Waiting for vendor")
+	destination.Add(RefTarget, "1500", "Legacy")
+	destination.Add(RefTarget, "1501", "Legacy")
+
+	var trigger zendesk.Trigger
+	trigger.Title = "Route product questions"
+	trigger.Conditions.All = []zendesk.TriggerCondition{
+		{Field: "custom_fields_200", Operator: "is", Value: "widgets"},
+		{Field: "brand_id", Operator: "is", Value: "400"},
+		{Field: "group_id", Operator: "is", Value: ""},
+	}
+	trigger.Actions = []zendesk.TriggerAction{
+		{Field: "group_id", Value: float64(100)},
+		{Field: "custom_status_id", Value: "300"},
+		{Field: "notification_webhook", Value: []interface{}{"01SANDBOX", "{}"}},
+		{Field: "notification_target", Value: []string{"500", "message"}},
+		{Field: "notification_group", Value: []interface{}{"999", "subject", "body"}},
+		{Field: "notification_group", Value: []interface{}{"group_id", "subject", "body"}},
+	}
+
+	remapped, unresolved := NewRemapper(source, destination).Trigger(trigger)
+
+	if remapped.Conditions.All[0].Field != "custom_fields_1200" {
+		t.Fatalf("unexpected condition %+v", remapped.Conditions.All[0])
+	}
+	if remapped.Conditions.All[2].Value != "" {
+		t.Fatalf("unexpected condition %+v", remapped.Conditions.All[2])
+	}
+	expectedActions := []zendesk.TriggerAction{
+		{Field: "group_id", Value: json.Number("1100")},
+		{Field: "custom_status_id", Value: "1300"},
+		{Field: "notification_webhook", Value: []interface{}{"01PRODUCTION", "{}"}},
+		{Field: "notification_target", Value: []string{"500", "message"}},
+		{Field: "notification_group", Value: []interface{}{"999", "subject", "body"}},
+		{Field: "notification_group", Value: []interface{}{"group_id", "subject", "body"}},
+	}
+	if !reflect.DeepEqual(remapped.Actions, expectedActions) {
+		t.Fatalf("expected actions are %+v, but got %+v", expectedActions, remapped.Actions)
+	}
+	if trigger.Actions[0].Value != float64(100) {
+		t.Fatalf("source trigger has been modified: %+v", trigger.Actions)
+	}
+
+	expectedUnresolved := []UnresolvedRef{
+		{Rule: trigger.Title, Field: "brand_id", Kind: RefBrand, ID: "400", Key: "Acme", Reason: ReasonNotFoundInDestination},
+		{Rule: trigger.Title, Field: "notification_target", Kind: RefTarget, ID: "500", Key: "Legacy", Reason: ReasonAmbiguous},
+		{Rule: trigger.Title, Field: "notification_group", Kind: RefGroup, ID: "999", Reason: ReasonNotFoundInSource},
+	}
+	if !reflect.DeepEqual(unresolved, expectedUnresolved) {
+		t.Fatalf("expected unresolved references are %+v, but got %+v", expectedUnresolved, unresolved)
+	}
+}
+
+func TestRemapperMacro(t *testing.T) {
+	source := &Index{}
+	source.Add(RefGroup, "100", "Support")
+	source.Add(RefTicketField, "200", "Product")
+	destination := &Index{}
+	destination.Add(RefGroup, "1100", "Support")
+	destination.Add(RefTicketField, "1200", "Product")
+
+	macro := zendesk.Macro{
+		Title: "Escalate",
+		Actions: []zendesk.MacroAction{
+			{Field: "group_id", Value: "100"},
+			{Field: "custom_fields_200", Value: "widgets"},
+			{Field: "comment_value", Value: "Escalated to group 100"},
+		},
+	}
+
+	b := &Bundle{Macros: []zendesk.Macro{macro}}
+	unresolved := NewRemapper(source, destination).Bundle(b)
+	if len(unresolved) != 0 {
+		t.Fatalf("expected no unresolved references, but got %+v", unresolved)
+	}
+
+	expected := []zendesk.MacroAction{
+		{Field: "group_id", Value: "1100"},
+		{Field: "custom_fields_1200", Value: "widgets"},
+		{Field: "comment_value", Value: "Escalated to group 100"},
+	}
+	if !reflect.DeepEqual(b.Macros[0].Actions, expected) {
+		t.Fatalf("expected actions are %+v, but got %+v", expected, b.Macros[0].Actions)
+	}
+}
+
+func TestRemapperBundleViewsPoliciesAndForms(t *testing.T) {
+	source := &Index{}
+	source.Add(RefGroup, "100", "Support")
+	source.Add(RefTicketField, "200", "Product")
+	source.Add(RefBrand, "400", "Acme")
+	destination := &Index{}
+	destination.Add(RefGroup, "1100", "Support")
+	destination.Add(RefTicketField, "1200", "Product")
+	destination.Add(RefBrand, "1400", "Acme")
+
+	var view zendesk.View
+	view.Title = "Product tickets"
+	view.Conditions.All = []zendesk.TriggerCondition{
+		{Field: "group_id", Operator: "is", Value: "100"},
+		{Field: "custom_fields_200", Operator: "is", Value: "widgets"},
+	}
+	view.Conditions.Any = []zendesk.TriggerCondition{
+		{Field: "brand_id", Operator: "is", Value: float64(400)},
+	}
+	view.Execution.GroupBy = "200"
+	view.Execution.SortBy = "created"
+	view.Execution.Columns = []zendesk.ViewColumn{{ID: "subject"}, {ID: float64(200)}}
+	view.Restriction = &zendesk.ViewRestriction{Type: "Group", ID: 100, IDs: []int64{100}}
+
+	var policy zendesk.SLAPolicy
+	policy.Title = "Acme"
+	policy.Filter.All = []zendesk.SLAPolicyFilter{
+		{Field: "brand_id", Operator: "is", Value: "400"},
+		{Field: "group_id", Operator: "is", Value: "999"},
+	}
+
+	form := zendesk.TicketForm{
+		Name:               "Product",
+		TicketFieldIDs:     []int64{200},
+		RestrictedBrandIDs: []int64{400},
+		AgentConditions: []zendesk.TicketFormCondition{
+			{ParentFieldID: 200, Value: "widgets", ChildFields: []zendesk.TicketFormConditionChildField{{ID: 200}}},
+		},
+	}
+
+	b := &Bundle{
+		Views:       []zendesk.View{view},
+		SLAPolicies: []zendesk.SLAPolicy{policy},
+		TicketForms: []zendesk.TicketForm{form},
+	}
+	unresolved := NewRemapper(source, destination).Bundle(b)
+
+	remapped := b.Views[0]
+	expectedConditions := zendesk.ViewConditions{
+		All: []zendesk.TriggerCondition{
+			{Field: "group_id", Operator: "is", Value: "1100"},
+			{Field: "custom_fields_1200", Operator: "is", Value: "widgets"},
+		},
+		Any: []zendesk.TriggerCondition{
+			{Field: "brand_id", Operator: "is", Value: json.Number("1400")},
+		},
+	}
+	if !reflect.DeepEqual(remapped.Conditions, expectedConditions) {
+		t.Fatalf("expected conditions are %+v, but got %+v", expectedConditions, remapped.Conditions)
+	}
+	if remapped.Execution.GroupBy != "1200" || remapped.Execution.SortBy != "created" {
+		t.Fatalf("expected execution is grouped by 1200 and sorted by created, but got %+v", remapped.Execution)
+	}
+	expectedColumns := []zendesk.ViewColumn{{ID: "subject"}, {ID: json.Number("1200")}}
+	if !reflect.DeepEqual(remapped.Execution.Columns, expectedColumns) {
+		t.Fatalf("expected columns are %+v, but got %+v", expectedColumns, remapped.Execution.Columns)
+	}
+	expectedRestriction := &zendesk.ViewRestriction{Type: "Group", ID: 1100, IDs: []int64{1100}}
+	if !reflect.DeepEqual(remapped.Restriction, expectedRestriction) {
+		t.Fatalf("expected restriction is %+v, but got %+v", expectedRestriction, remapped.Restriction)
+	}
+	if view.Restriction.ID != 100 || view.Conditions.All[0].Value != "100" {
+		t.Fatalf("source view has been modified: %+v", view)
+	}
+
+	expectedFilters := []zendesk.SLAPolicyFilter{
+		{Field: "brand_id", Operator: "is", Value: "1400"},
+		{Field: "group_id", Operator: "is", Value: "999"},
+	}
+	if !reflect.DeepEqual(b.SLAPolicies[0].Filter.All, expectedFilters) {
+		t.Fatalf("expected filters are %+v, but got %+v", expectedFilters, b.SLAPolicies[0].Filter.All)
+	}
+
+	expectedForm := zendesk.TicketForm{
+		Name:               "Product",
+		TicketFieldIDs:     []int64{1200},
+		RestrictedBrandIDs: []int64{1400},
+		AgentConditions: []zendesk.TicketFormCondition{
+			{ParentFieldID: 1200, Value: "widgets", ChildFields: []zendesk.TicketFormConditionChildField{{ID: 1200}}},
+		},
+	}
+	if !reflect.DeepEqual(b.TicketForms[0], expectedForm) {
+		t.Fatalf("expected ticket form is %+v, but got %+v", expectedForm, b.TicketForms[0])
+	}
+
+	expectedUnresolved := []UnresolvedRef{
+		{Rule: policy.Title, Field: "group_id", Kind: RefGroup, ID: "999", Reason: ReasonNotFoundInSource},
+	}
+	if !reflect.DeepEqual(unresolved, expectedUnresolved) {
+		t.Fatalf("expected unresolved references are %+v, but got %+v", expectedUnresolved, unresolved)
+	}
+}
+
+func TestRemapperTriggerCategory(t *testing.T) {
+	source := &Index{}
+	source.Add(RefTriggerCategory, "10026", "Notifications")
+	source.Add(RefTriggerCategory, "10027", "Routing")
+	destination := &Index{}
+	destination.Add(RefTriggerCategory, "20026", "Notifications")
+
+	b := &Bundle{Triggers: []zendesk.Trigger{
+		{Title: "Notify requester", CategoryID: "10026"},
+		{Title: "Route to support", CategoryID: "10027"},
+	}}
+	unresolved := NewRemapper(source, destination).Bundle(b)
+
+	if b.Triggers[0].CategoryID != "20026" {
+		t.Fatalf("expected category of the trigger is 20026, but got %s", b.Triggers[0].CategoryID)
+	}
+	if b.Triggers[1].CategoryID != "10027" {
+		t.Fatalf("expected unresolved category to be left unchanged, but got %s", b.Triggers[1].CategoryID)
+	}
+
+	expectedUnresolved := []UnresolvedRef{
+		{Rule: "Route to support", Field: "category_id", Kind: RefTriggerCategory, ID: "10027", Key: "Routing", Reason: ReasonNotFoundInDestination},
+	}
+	if !reflect.DeepEqual(unresolved, expectedUnresolved) {
+		t.Fatalf("expected unresolved references are %+v, but got %+v", expectedUnresolved, unresolved)
+	}
+}
@@ -0,0 +1,472 @@
+package bundle
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/nukosuke/go-zendesk/zendesk"
+)
+
+// pageSize is the number of records fetched per request
+const pageSize = 100
+
+// record is a record of a bundle or an account
+type record struct {
+	key    string
+	id     string
+	value  interface{}
+	fields map[string]interface{}
+}
+
+// resource fetches and changes the records of a kind
+type resource interface {
+	snapshot(ctx context.Context, api API, b *Bundle) error
+	records(b *Bundle) ([]record, error)
+	fetch(ctx context.Context, api API) ([]record, error)
+	create(ctx context.Context, api API, desired interface{}) error
+	update(ctx context.Context, api API, current, desired interface{}) error
+	delete(ctx context.Context, api API, current interface{}) error
+}
+
+// typedResource implements resource for records of type T
+type typedResource[T any] struct {
+	kind  Kind
+	items func(b *Bundle) *[]T
+	key   func(T) string
+	id    func(T) string
+	list  func(ctx context.Context, api API, opts *zendesk.CBPOptions) ([]T, zendesk.CursorPaginationMeta, error)
+
+	// managed reports whether the record can be managed by bundles. All records are managed if it's nil.
+	managed func(T) bool
+
+	// nested lists the fields holding sub-records, whose IDs and timestamps are left out as well
+	nested []string
+
+	createFunc func(ctx context.Context, api API, desired T) error
+	updateFunc func(ctx context.Context, api API, current, desired T) error
+	deleteFunc func(ctx context.Context, api API, current T) error
+}
+
+var resources = map[Kind]resource{
+	KindGroups: &typedResource[zendesk.Group]{
+		kind:  KindGroups,
+		items: func(b *Bundle) *[]zendesk.Group { return &b.Groups },
+		key:   func(g zendesk.Group) string { return g.Name },
+		id:    func(g zendesk.Group) string { return formatID(g.ID) },
+		list: func(ctx context.Context, api API, opts *zendesk.CBPOptions) ([]zendesk.Group, zendesk.CursorPaginationMeta, error) {
+			return api.GetGroupsCBP(ctx, opts)
+		},
+		managed: func(g zendesk.Group) bool { return !g.Deleted },
+		createFunc: func(ctx context.Context, api API, desired zendesk.Group) error {
+			desired.ID = 0
+			_, err := api.CreateGroup(ctx, desired)
+			return err
+		},
+		updateFunc: func(ctx context.Context, api API, current, desired zendesk.Group) error {
+			desired.ID = current.ID
+			_, err := api.UpdateGroup(ctx, current.ID, desired)
+			return err
+		},
+		deleteFunc: func(ctx context.Context, api API, current zendesk.Group) error {
+			return api.DeleteGroup(ctx, current.ID)
+		},
+	},
+	KindBrands: &typedResource[zendesk.Brand]{
+		kind:  KindBrands,
+		items: func(b *Bundle) *[]zendesk.Brand { return &b.Brands },
+		key:   func(b zendesk.Brand) string { return b.Name },
+		id:    func(b zendesk.Brand) string { return formatID(b.ID) },
+		list: func(ctx context.Context, api API, opts *zendesk.CBPOptions) ([]zendesk.Brand, zendesk.CursorPaginationMeta, error) {
+			return api.GetBrandsCBP(ctx, opts)
+		},
+		createFunc: func(ctx context.Context, api API, desired zendesk.Brand) error {
+			desired.ID = 0
+			_, err := api.CreateBrand(ctx, desired)
+			return err
+		},
+		updateFunc: func(ctx context.Context, api API, current, desired zendesk.Brand) error {
+			desired.ID = current.ID
+			_, err := api.UpdateBrand(ctx, current.ID, desired)
+			return err
+		},
+		deleteFunc: func(ctx context.Context, api API, current zendesk.Brand) error {
+			return api.DeleteBrand(ctx, current.ID)
+		},
+	},
+	KindTicketFields: &typedResource[zendesk.TicketField]{
+		kind:  KindTicketFields,
+		items: func(b *Bundle) *[]zendesk.TicketField { return &b.TicketFields },
+		key:   func(f zendesk.TicketField) string { return f.Title },
+		id:    func(f zendesk.TicketField) string { return formatID(f.ID) },
+		list: func(ctx context.Context, api API, opts *zendesk.CBPOptions) ([]zendesk.TicketField, zendesk.CursorPaginationMeta, error) {
+			return api.GetTicketFieldsCBP(ctx, opts)
+		},
+		managed: func(f zendesk.TicketField) bool { return f.Removable },
+		nested:  []string{"custom_field_options"},
+		createFunc: func(ctx context.Context, api API, desired zendesk.TicketField) error {
+			desired.ID = 0
+			desired.CustomFieldOptions = matchCustomFieldOptions(nil, desired.CustomFieldOptions)
+			_, err := api.CreateTicketField(ctx, desired)
+			return err
+		},
+		updateFunc: func(ctx context.Context, api API, current, desired zendesk.TicketField) error {
+			desired.ID = current.ID
+			desired.CustomFieldOptions = matchCustomFieldOptions(current.CustomFieldOptions, desired.CustomFieldOptions)
+			_, err := api.UpdateTicketField(ctx, current.ID, desired)
+			return err
+		},
+		deleteFunc: func(ctx context.Context, api API, current zendesk.TicketField) error {
+			return api.DeleteTicketField(ctx, current.ID)
+		},
+	},
+	KindTicketForms: &typedResource[zendesk.TicketForm]{
+		kind:  KindTicketForms,
+		items: func(b *Bundle) *[]zendesk.TicketForm { return &b.TicketForms },
+		key:   func(f zendesk.TicketForm) string { return f.Name },
+		id:    func(f zendesk.TicketForm) string { return formatID(f.ID) },
+		list: func(ctx context.Context, api API, opts *zendesk.CBPOptions) ([]zendesk.TicketForm, zendesk.CursorPaginationMeta, error) {
+			return api.GetTicketFormsCBP(ctx, opts)
+		},
+		createFunc: func(ctx context.Context, api API, desired zendesk.TicketForm) error {
+			desired.ID = 0
+			_, err := api.CreateTicketForm(ctx, desired)
+			return err
+		},
+		updateFunc: func(ctx context.Context, api API, current, desired zendesk.TicketForm) error {
+			desired.ID = current.ID
+			_, err := api.UpdateTicketForm(ctx, current.ID, desired)
+			return err
+		},
+		deleteFunc: func(ctx context.Context, api API, current zendesk.TicketForm) error {
+			return api.DeleteTicketForm(ctx, current.ID)
+		},
+	},
+	KindDynamicContent: &typedResource[zendesk.DynamicContentItem]{
+		kind:  KindDynamicContent,
+		items: func(b *Bundle) *[]zendesk.DynamicContentItem { return &b.DynamicContent },
+		key:   func(i zendesk.DynamicContentItem) string { return i.Name },
+		id:    func(i zendesk.DynamicContentItem) string { return formatID(i.ID) },
+		list: func(ctx context.Context, api API, opts *zendesk.CBPOptions) ([]zendesk.DynamicContentItem, zendesk.CursorPaginationMeta, error) {
+			return api.GetDynamicContentItemsCBP(ctx, opts)
+		},
+		nested: []string{"variants"},
+		createFunc: func(ctx context.Context, api API, desired zendesk.DynamicContentItem) error {
+			desired.ID = 0
+			desired.Variants = matchDynamicContentVariants(nil, desired.Variants)
+			_, err := api.CreateDynamicContentItem(ctx, desired)
+			return err
+		},
+		updateFunc: func(ctx context.Context, api API, current, desired zendesk.DynamicContentItem) error {
+			desired.ID = current.ID
+			desired.Variants = matchDynamicContentVariants(current.Variants, desired.Variants)
+			_, err := api.UpdateDynamicContentItem(ctx, current.ID, desired)
+			return err
+		},
+		deleteFunc: func(ctx context.Context, api API, current zendesk.DynamicContentItem) error {
+			return api.DeleteDynamicContentItem(ctx, current.ID)
+		},
+	},
+	KindWebhooks: &typedResource[zendesk.Webhook]{
+		kind:  KindWebhooks,
+		items: func(b *Bundle) *[]zendesk.Webhook { return &b.Webhooks },
+		key:   func(w zendesk.Webhook) string { return w.Name },
+		id:    func(w zendesk.Webhook) string { return w.ID },
+		list: func(ctx context.Context, api API, opts *zendesk.CBPOptions) ([]zendesk.Webhook, zendesk.CursorPaginationMeta, error) {
+			return api.GetWebhooks(ctx, &zendesk.WebhookListOptions{CursorPagination: opts.CursorPagination})
+		},
+		createFunc: func(ctx context.Context, api API, desired zendesk.Webhook) error {
+			desired.ID = ""
+			_, err := api.CreateWebhook(ctx, &desired)
+			return err
+		},
+		updateFunc: func(ctx context.Context, api API, current, desired zendesk.Webhook) error {
+			desired.ID = current.ID
+			return api.UpdateWebhook(ctx, current.ID, &desired)
+		},
+		deleteFunc: func(ctx context.Context, api API, current zendesk.Webhook) error {
+			return api.DeleteWebhook(ctx, current.ID)
+		},
+	},
+	KindSLAPolicies: &typedResource[zendesk.SLAPolicy]{
+		kind:  KindSLAPolicies,
+		items: func(b *Bundle) *[]zendesk.SLAPolicy { return &b.SLAPolicies },
+		key:   func(p zendesk.SLAPolicy) string { return p.Title },
+		id:    func(p zendesk.SLAPolicy) string { return formatID(p.ID) },
+		list: func(ctx context.Context, api API, opts *zendesk.CBPOptions) ([]zendesk.SLAPolicy, zendesk.CursorPaginationMeta, error) {
+			return api.GetSLAPoliciesCBP(ctx, opts)
+		},
+		createFunc: func(ctx context.Context, api API, desired zendesk.SLAPolicy) error {
+			desired.ID = 0
+			_, err := api.CreateSLAPolicy(ctx, desired)
+			return err
+		},
+		updateFunc: func(ctx context.Context, api API, current, desired zendesk.SLAPolicy) error {
+			desired.ID = current.ID
+			_, err := api.UpdateSLAPolicy(ctx, current.ID, desired)
+			return err
+		},
+		deleteFunc: func(ctx context.Context, api API, current zendesk.SLAPolicy) error {
+			return api.DeleteSLAPolicy(ctx, current.ID)
+		},
+	},
+	KindViews: &typedResource[zendesk.View]{
+		kind:  KindViews,
+		items: func(b *Bundle) *[]zendesk.View { return &b.Views },
+		key:   func(v zendesk.View) string { return v.Title },
+		id:    func(v zendesk.View) string { return formatID(v.ID) },
+		list: func(ctx context.Context, api API, opts *zendesk.CBPOptions) ([]zendesk.View, zendesk.CursorPaginationMeta, error) {
+			return api.GetViewsCBP(ctx, opts)
+		},
+		createFunc: func(ctx context.Context, api API, desired zendesk.View) error {
+			desired.ID = 0
+			_, err := api.CreateView(ctx, desired)
+			return err
+		},
+		updateFunc: func(ctx context.Context, api API, current, desired zendesk.View) error {
+			desired.ID = current.ID
+			_, err := api.UpdateView(ctx, current.ID, desired)
+			return err
+		},
+		deleteFunc: func(ctx context.Context, api API, current zendesk.View) error {
+			return api.DeleteView(ctx, current.ID)
+		},
+	},
+	KindMacros: &typedResource[zendesk.Macro]{
+		kind:  KindMacros,
+		items: func(b *Bundle) *[]zendesk.Macro { return &b.Macros },
+		key:   func(m zendesk.Macro) string { return m.Title },
+		id:    func(m zendesk.Macro) string { return formatID(m.ID) },
+		list: func(ctx context.Context, api API, opts *zendesk.CBPOptions) ([]zendesk.Macro, zendesk.CursorPaginationMeta, error) {
+			return api.GetMacrosCBP(ctx, opts)
+		},
+		createFunc: func(ctx context.Context, api API, desired zendesk.Macro) error {
+			desired.ID = 0
+			_, err := api.CreateMacro(ctx, desired)
+			return err
+		},
+		updateFunc: func(ctx context.Context, api API, current, desired zendesk.Macro) error {
+			desired.ID = current.ID
+			_, err := api.UpdateMacro(ctx, current.ID, desired)
+			return err
+		},
+		deleteFunc: func(ctx context.Context, api API, current zendesk.Macro) error {
+			return api.DeleteMacro(ctx, current.ID)
+		},
+	},
+	KindTriggerCategories: &typedResource[zendesk.TriggerCategory]{
+		kind:  KindTriggerCategories,
+		items: func(b *Bundle) *[]zendesk.TriggerCategory { return &b.TriggerCategories },
+		key:   func(c zendesk.TriggerCategory) string { return c.Name },
+		id:    func(c zendesk.TriggerCategory) string { return c.ID },
+		list: func(ctx context.Context, api API, opts *zendesk.CBPOptions) ([]zendesk.TriggerCategory, zendesk.CursorPaginationMeta, error) {
+			categories, meta, err := api.GetTriggerCategories(ctx, &zendesk.TriggerCategoryListOptions{CursorPagination: opts.CursorPagination})
+			// rule counts change with the triggers, so they are not part of the configuration
+			for i := range categories {
+				categories[i].RuleCounts = nil
+			}
+			return categories, meta, err
+		},
+		createFunc: func(ctx context.Context, api API, desired zendesk.TriggerCategory) error {
+			desired.ID = ""
+			_, err := api.CreateTriggerCategory(ctx, desired)
+			return err
+		},
+		updateFunc: func(ctx context.Context, api API, current, desired zendesk.TriggerCategory) error {
+			desired.ID = ""
+			_, err := api.UpdateTriggerCategory(ctx, current.ID, desired)
+			return err
+		},
+		deleteFunc: func(ctx context.Context, api API, current zendesk.TriggerCategory) error {
+			return api.DeleteTriggerCategory(ctx, current.ID)
+		},
+	},
+	KindTriggers: &typedResource[zendesk.Trigger]{
+		kind:  KindTriggers,
+		items: func(b *Bundle) *[]zendesk.Trigger { return &b.Triggers },
+		key:   func(t zendesk.Trigger) string { return t.Title },
+		id:    func(t zendesk.Trigger) string { return formatID(t.ID) },
+		list: func(ctx context.Context, api API, opts *zendesk.CBPOptions) ([]zendesk.Trigger, zendesk.CursorPaginationMeta, error) {
+			return api.GetTriggersCBP(ctx, opts)
+		},
+		createFunc: func(ctx context.Context, api API, desired zendesk.Trigger) error {
+			desired.ID = 0
+			_, err := api.CreateTrigger(ctx, desired)
+			return err
+		},
+		updateFunc: func(ctx context.Context, api API, current, desired zendesk.Trigger) error {
+			desired.ID = current.ID
+			_, err := api.UpdateTrigger(ctx, current.ID, desired)
+			return err
+		},
+		deleteFunc: func(ctx context.Context, api API, current zendesk.Trigger) error {
+			return api.DeleteTrigger(ctx, current.ID)
+		},
+	},
+	KindAutomations: &typedResource[zendesk.Automation]{
+		kind:  KindAutomations,
+		items: func(b *Bundle) *[]zendesk.Automation { return &b.Automations },
+		key:   func(a zendesk.Automation) string { return a.Title },
+		id:    func(a zendesk.Automation) string { return formatID(a.ID) },
+		list: func(ctx context.Context, api API, opts *zendesk.CBPOptions) ([]zendesk.Automation, zendesk.CursorPaginationMeta, error) {
+			return api.GetAutomationsCBP(ctx, opts)
+		},
+		createFunc: func(ctx context.Context, api API, desired zendesk.Automation) error {
+			desired.ID = 0
+			_, err := api.CreateAutomation(ctx, desired)
+			return err
+		},
+		updateFunc: func(ctx context.Context, api API, current, desired zendesk.Automation) error {
+			desired.ID = current.ID
+			_, err := api.UpdateAutomation(ctx, current.ID, desired)
+			return err
+		},
+		deleteFunc: func(ctx context.Context, api API, current zendesk.Automation) error {
+			return api.DeleteAutomation(ctx, current.ID)
+		},
+	},
+}
+
+// listAll fetches all pages of managed records
+func (r *typedResource[T]) listAll(ctx context.Context, api API) ([]T, error) {
+	items, err := listAllCBP(ctx, func(ctx context.Context, opts *zendesk.CBPOptions) ([]T, zendesk.CursorPaginationMeta, error) {
+		return r.list(ctx, api, opts)
+	})
+	if err != nil || r.managed == nil {
+		return items, err
+	}
+
+	managed := items[:0]
+	for _, item := range items {
+		if r.managed(item) {
+			managed = append(managed, item)
+		}
+	}
+	return managed, nil
+}
+
+// listAllCBP fetches all pages of a cursor based paginated endpoint
+func listAllCBP[T any](ctx context.Context, list func(context.Context, *zendesk.CBPOptions) ([]T, zendesk.CursorPaginationMeta, error)) ([]T, error) {
+	opts := &zendesk.CBPOptions{}
+	opts.PageSize = pageSize
+
+	var all []T
+	for {
+		items, meta, err := list(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, items...)
+		if !meta.HasMore || meta.AfterCursor == "" {
+			return all, nil
+		}
+		opts.PageAfter = meta.AfterCursor
+	}
+}
+
+func (r *typedResource[T]) snapshot(ctx context.Context, api API, b *Bundle) error {
+	items, err := r.listAll(ctx, api)
+	if err != nil {
+		return err
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		ki, kj := r.key(items[i]), r.key(items[j])
+		if ki != kj {
+			return ki < kj
+		}
+		return r.id(items[i]) < r.id(items[j])
+	})
+	*r.items(b) = items
+	return nil
+}
+
+func (r *typedResource[T]) records(b *Bundle) ([]record, error) {
+	return r.toRecords(*r.items(b))
+}
+
+func (r *typedResource[T]) fetch(ctx context.Context, api API) ([]record, error) {
+	items, err := r.listAll(ctx, api)
+	if err != nil {
+		return nil, err
+	}
+	return r.toRecords(items)
+}
+
+// toRecords converts the items to records sorted by key
+func (r *typedResource[T]) toRecords(items []T) ([]record, error) {
+	records := make([]record, 0, len(items))
+	for _, item := range items {
+		fields, err := normalize(item, r.nested)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record{key: r.key(item), id: r.id(item), value: item, fields: fields})
+	}
+
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].key < records[j].key
+	})
+	return records, nil
+}
+
+func (r *typedResource[T]) create(ctx context.Context, api API, desired interface{}) error {
+	return r.createFunc(ctx, api, desired.(T))
+}
+
+func (r *typedResource[T]) update(ctx context.Context, api API, current, desired interface{}) error {
+	return r.updateFunc(ctx, api, current.(T), desired.(T))
+}
+
+func (r *typedResource[T]) delete(ctx context.Context, api API, current interface{}) error {
+	return r.deleteFunc(ctx, api, current.(T))
+}
+
+// matchCustomFieldOptions returns a copy of the desired options with the IDs of the
+// current options of the same value, so that options of another account are not referred to
+func matchCustomFieldOptions(current, desired []zendesk.CustomFieldOption) []zendesk.CustomFieldOption {
+	if desired == nil {
+		return nil
+	}
+
+	ids := make(map[string]int64, len(current))
+	for _, option := range current {
+		ids[option.Value] = option.ID
+	}
+
+	options := make([]zendesk.CustomFieldOption, len(desired))
+	for i, option := range desired {
+		option.ID = ids[option.Value]
+		option.URL = ""
+		options[i] = option
+	}
+	return options
+}
+
+// matchDynamicContentVariants returns a copy of the desired variants with the IDs of the
+// current variants of the same locale, so that variants of another account are not referred to
+func matchDynamicContentVariants(current, desired []zendesk.DynamicContentVariant) []zendesk.DynamicContentVariant {
+	if desired == nil {
+		return nil
+	}
+
+	ids := make(map[int64]int64, len(current))
+	for _, variant := range current {
+		ids[variant.LocaleID] = variant.ID
+	}
+
+	variants := make([]zendesk.DynamicContentVariant, len(desired))
+	for i, variant := range desired {
+		variant.ID = ids[variant.LocaleID]
+		variant.URL = ""
+		variant.CreatedAt = time.Time{}
+		variant.UpdatedAt = time.Time{}
+		variants[i] = variant
+	}
+	return variants
+}
+
+func formatID(id int64) string {
+	return strconv.FormatInt(id, 10)
+}
@@ -0,0 +1,272 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// ScheduleInterval is a business hours interval of a schedule.
+// StartTime and EndTime are expressed in minutes from Sunday 00:00
+// in the time zone of the schedule.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/schedules/#json-format
+type ScheduleInterval struct {
+	StartTime int64 `json:"start_time"`
+	EndTime   int64 `json:"end_time"`
+}
+
+// Schedule is struct for business hours schedule payload
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/schedules/
+type Schedule struct {
+	ID        int64              `json:"id,omitempty"`
+	Name      string             `json:"name"`
+	TimeZone  string             `json:"time_zone"`
+	Intervals []ScheduleInterval `json:"intervals,omitempty"`
+	CreatedAt *time.Time         `json:"created_at,omitempty"`
+	UpdatedAt *time.Time         `json:"updated_at,omitempty"`
+}
+
+// Holiday is struct for schedule holiday payload.
+// StartDate and EndDate are formatted as YYYY-MM-DD and both are inclusive.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/schedules/#json-format-for-holidays
+type Holiday struct {
+	ID        int64  `json:"id,omitempty"`
+	Name      string `json:"name"`
+	StartDate string `json:"start_date"`
+	EndDate   string `json:"end_date"`
+}
+
+// BusinessHoursAPI an interface containing all business hours schedule related methods
+type BusinessHoursAPI interface {
+	GetSchedules(ctx context.Context) ([]Schedule, error)
+	GetSchedule(ctx context.Context, scheduleID int64) (Schedule, error)
+	CreateSchedule(ctx context.Context, schedule Schedule) (Schedule, error)
+	UpdateSchedule(ctx context.Context, scheduleID int64, schedule Schedule) (Schedule, error)
+	UpdateScheduleIntervals(ctx context.Context, scheduleID int64, intervals []ScheduleInterval) ([]ScheduleInterval, error)
+	DeleteSchedule(ctx context.Context, scheduleID int64) error
+	GetHolidays(ctx context.Context, scheduleID int64) ([]Holiday, error)
+	GetHoliday(ctx context.Context, scheduleID, holidayID int64) (Holiday, error)
+	CreateHoliday(ctx context.Context, scheduleID int64, holiday Holiday) (Holiday, error)
+	UpdateHoliday(ctx context.Context, scheduleID, holidayID int64, holiday Holiday) (Holiday, error)
+	DeleteHoliday(ctx context.Context, scheduleID, holidayID int64) error
+}
+
+// GetSchedules fetches all business hours schedules
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/schedules/#list-schedules
+func (z *Client) GetSchedules(ctx context.Context) ([]Schedule, error) {
+	var result struct {
+		Schedules []Schedule `json:"schedules"`
+	}
+
+	body, err := z.get(ctx, "/business_hours/schedules.json")
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Schedules, nil
+}
+
+// GetSchedule returns the specified schedule
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/schedules/#show-schedule
+func (z *Client) GetSchedule(ctx context.Context, scheduleID int64) (Schedule, error) {
+	var result struct {
+		Schedule Schedule `json:"schedule"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/business_hours/schedules/%d.json", scheduleID))
+	if err != nil {
+		return Schedule{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Schedule{}, err
+	}
+	return result.Schedule, nil
+}
+
+// CreateSchedule creates new schedule
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/schedules/#create-schedule
+func (z *Client) CreateSchedule(ctx context.Context, schedule Schedule) (Schedule, error) {
+	var data, result struct {
+		Schedule Schedule `json:"schedule"`
+	}
+	data.Schedule = schedule
+
+	body, err := z.post(ctx, "/business_hours/schedules.json", data)
+	if err != nil {
+		return Schedule{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Schedule{}, err
+	}
+	return result.Schedule, nil
+}
+
+// UpdateSchedule updates the name or time zone of the specified schedule
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/schedules/#update-schedule
+func (z *Client) UpdateSchedule(ctx context.Context, scheduleID int64, schedule Schedule) (Schedule, error) {
+	var data, result struct {
+		Schedule Schedule `json:"schedule"`
+	}
+	data.Schedule = schedule
+
+	body, err := z.put(ctx, fmt.Sprintf("/business_hours/schedules/%d.json", scheduleID), data)
+	if err != nil {
+		return Schedule{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Schedule{}, err
+	}
+	return result.Schedule, nil
+}
+
+// UpdateScheduleIntervals replaces the intervals of the specified schedule
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/schedules/#update-intervals-for-a-schedule
+func (z *Client) UpdateScheduleIntervals(ctx context.Context, scheduleID int64, intervals []ScheduleInterval) ([]ScheduleInterval, error) {
+	var data struct {
+		Workweek struct {
+			Intervals []ScheduleInterval `json:"intervals"`
+		} `json:"workweek"`
+	}
+	var result struct {
+		Workweek struct {
+			Intervals []ScheduleInterval `json:"intervals"`
+		} `json:"workweek"`
+	}
+	data.Workweek.Intervals = intervals
+
+	body, err := z.put(ctx, fmt.Sprintf("/business_hours/schedules/%d/workweek.json", scheduleID), data)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Workweek.Intervals, nil
+}
+
+// DeleteSchedule deletes the specified schedule
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/schedules/#delete-schedule
+func (z *Client) DeleteSchedule(ctx context.Context, scheduleID int64) error {
+	err := z.delete(ctx, fmt.Sprintf("/business_hours/schedules/%d.json", scheduleID))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetHolidays fetches the holidays of the specified schedule
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/schedules/#list-holidays-for-a-schedule
+func (z *Client) GetHolidays(ctx context.Context, scheduleID int64) ([]Holiday, error) {
+	var result struct {
+		Holidays []Holiday `json:"holidays"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/business_hours/schedules/%d/holidays.json", scheduleID))
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Holidays, nil
+}
+
+// GetHoliday returns the specified holiday of a schedule
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/schedules/#show-holiday
+func (z *Client) GetHoliday(ctx context.Context, scheduleID, holidayID int64) (Holiday, error) {
+	var result struct {
+		Holiday Holiday `json:"holiday"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/business_hours/schedules/%d/holidays/%d.json", scheduleID, holidayID))
+	if err != nil {
+		return Holiday{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Holiday{}, err
+	}
+	return result.Holiday, nil
+}
+
+// CreateHoliday creates new holiday in the specified schedule
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/schedules/#create-holiday
+func (z *Client) CreateHoliday(ctx context.Context, scheduleID int64, holiday Holiday) (Holiday, error) {
+	var data, result struct {
+		Holiday Holiday `json:"holiday"`
+	}
+	data.Holiday = holiday
+
+	body, err := z.post(ctx, fmt.Sprintf("/business_hours/schedules/%d/holidays.json", scheduleID), data)
+	if err != nil {
+		return Holiday{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Holiday{}, err
+	}
+	return result.Holiday, nil
+}
+
+// UpdateHoliday updates the specified holiday of a schedule
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/schedules/#update-holiday
+func (z *Client) UpdateHoliday(ctx context.Context, scheduleID, holidayID int64, holiday Holiday) (Holiday, error) {
+	var data, result struct {
+		Holiday Holiday `json:"holiday"`
+	}
+	data.Holiday = holiday
+
+	body, err := z.put(ctx, fmt.Sprintf("/business_hours/schedules/%d/holidays/%d.json", scheduleID, holidayID), data)
+	if err != nil {
+		return Holiday{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Holiday{}, err
+	}
+	return result.Holiday, nil
+}
+
+// DeleteHoliday deletes the specified holiday of a schedule
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/schedules/#delete-holiday
+func (z *Client) DeleteHoliday(ctx context.Context, scheduleID, holidayID int64) error {
+	err := z.delete(ctx, fmt.Sprintf("/business_hours/schedules/%d/holidays/%d.json", scheduleID, holidayID))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
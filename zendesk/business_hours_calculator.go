@@ -0,0 +1,373 @@
+package zendesk
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+const (
+	minutesPerDay  = 24 * 60
+	minutesPerWeek = 7 * minutesPerDay
+
+	// holidayDateLayout is the date format of Holiday.StartDate and Holiday.EndDate
+	holidayDateLayout = "2006-01-02"
+
+	// maxBusinessDaysLookahead bounds the search for business time so that
+	// a schedule fully covered by holidays does not loop forever
+	maxBusinessDaysLookahead = 5 * 366
+)
+
+// ErrNoBusinessHours is returned when a schedule has no business time left to consume
+var ErrNoBusinessHours = errors.New("schedule has no business hours")
+
+// BusinessHoursCalculator computes business time offline from a Schedule and its Holidays.
+// All calculations are performed in the time zone of the schedule, so daylight saving
+// transitions and holidays are honored the same way Zendesk does for SLA targets.
+type BusinessHoursCalculator struct {
+	location  *time.Location
+	intervals []ScheduleInterval
+	holidays  map[string]bool
+}
+
+// NewBusinessHoursCalculator creates a calculator for the schedule and holidays.
+// Schedule.TimeZone may be either a Zendesk (Rails) time zone name such as
+// "Eastern Time (US & Canada)" or an IANA name such as "America/New_York".
+func NewBusinessHoursCalculator(schedule Schedule, holidays []Holiday) (*BusinessHoursCalculator, error) {
+	loc, err := LoadScheduleLocation(schedule.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+
+	intervals := make([]ScheduleInterval, 0, len(schedule.Intervals))
+	for _, interval := range schedule.Intervals {
+		if interval.StartTime < 0 || interval.EndTime > minutesPerWeek || interval.StartTime >= interval.EndTime {
+			return nil, fmt.Errorf("invalid schedule interval: %d-%d", interval.StartTime, interval.EndTime)
+		}
+		intervals = append(intervals, interval)
+	}
+	sort.Slice(intervals, func(i, j int) bool {
+		return intervals[i].StartTime < intervals[j].StartTime
+	})
+
+	days := map[string]bool{}
+	for _, holiday := range holidays {
+		start, err := time.ParseInLocation(holidayDateLayout, holiday.StartDate, time.UTC)
+		if err != nil {
+			return nil, fmt.Errorf("invalid holiday start_date %q: %w", holiday.StartDate, err)
+		}
+		end, err := time.ParseInLocation(holidayDateLayout, holiday.EndDate, time.UTC)
+		if err != nil {
+			return nil, fmt.Errorf("invalid holiday end_date %q: %w", holiday.EndDate, err)
+		}
+		for d := start; !d.After(end); d = d.AddDate(0, 0, 1) {
+			days[d.Format(holidayDateLayout)] = true
+		}
+	}
+
+	return &BusinessHoursCalculator{
+		location:  loc,
+		intervals: intervals,
+		holidays:  days,
+	}, nil
+}
+
+// LoadScheduleLocation resolves a schedule time zone name to *time.Location.
+// Zendesk time zone names are translated to IANA names first.
+func LoadScheduleLocation(name string) (*time.Location, error) {
+	if name == "" {
+		return time.UTC, nil
+	}
+	if iana, ok := railsTimeZones[name]; ok {
+		name = iana
+	}
+	return time.LoadLocation(name)
+}
+
+// Location returns the time zone the calculator works in
+func (c *BusinessHoursCalculator) Location() *time.Location {
+	return c.location
+}
+
+// IsBusinessTime reports whether t falls in business hours
+func (c *BusinessHoursCalculator) IsBusinessTime(t time.Time) bool {
+	t = t.In(c.location)
+	for _, span := range c.spansOn(t) {
+		if !t.Before(span.start) && t.Before(span.end) {
+			return true
+		}
+	}
+	return false
+}
+
+// BusinessMinutesBetween returns the number of business minutes between start and end.
+// It returns 0 if end is not after start.
+func (c *BusinessHoursCalculator) BusinessMinutesBetween(start, end time.Time) int64 {
+	return int64(c.BusinessDurationBetween(start, end) / time.Minute)
+}
+
+// BusinessDurationBetween returns the business time elapsed between start and end
+func (c *BusinessHoursCalculator) BusinessDurationBetween(start, end time.Time) time.Duration {
+	if !end.After(start) {
+		return 0
+	}
+
+	var total time.Duration
+	day := startOfDay(start.In(c.location)).AddDate(0, 0, -1)
+	last := startOfDay(end.In(c.location))
+	for !day.After(last) {
+		for _, span := range c.spansOn(day) {
+			from, to := span.start, span.end
+			if from.Before(start) {
+				from = start
+			}
+			if to.After(end) {
+				to = end
+			}
+			if to.After(from) {
+				total += to.Sub(from)
+			}
+		}
+		day = day.AddDate(0, 0, 1)
+	}
+	return total
+}
+
+// NextBusinessTime returns t if it is in business hours,
+// otherwise the start of the next business hours after t.
+func (c *BusinessHoursCalculator) NextBusinessTime(t time.Time) (time.Time, error) {
+	return c.AddBusinessDuration(t, 0)
+}
+
+// AddBusinessMinutes returns the deadline reached after consuming the given
+// business minutes from start, e.g. the breach time of an SLA target.
+func (c *BusinessHoursCalculator) AddBusinessMinutes(start time.Time, minutes int64) (time.Time, error) {
+	return c.AddBusinessDuration(start, time.Duration(minutes)*time.Minute)
+}
+
+// AddBusinessDuration returns the time reached after consuming d of business time from start
+func (c *BusinessHoursCalculator) AddBusinessDuration(start time.Time, d time.Duration) (time.Time, error) {
+	if d < 0 {
+		return time.Time{}, fmt.Errorf("negative business duration: %s", d)
+	}
+	if len(c.intervals) == 0 {
+		return time.Time{}, ErrNoBusinessHours
+	}
+
+	remaining := d
+	day := startOfDay(start.In(c.location)).AddDate(0, 0, -1)
+	for i := 0; i < maxBusinessDaysLookahead; i++ {
+		for _, span := range c.spansOn(day) {
+			from := span.start
+			if from.Before(start) {
+				from = start
+			}
+			if !span.end.After(from) {
+				continue
+			}
+			available := span.end.Sub(from)
+			if remaining < available {
+				return from.Add(remaining), nil
+			}
+			remaining -= available
+			if remaining == 0 {
+				return span.end, nil
+			}
+		}
+		day = day.AddDate(0, 0, 1)
+	}
+	return time.Time{}, ErrNoBusinessHours
+}
+
+// businessSpan is a concrete business hours period
+type businessSpan struct {
+	start time.Time
+	end   time.Time
+}
+
+// spansOn returns the business periods starting on the local calendar day of t.
+// An interval which crosses midnight is attributed to the day it starts on.
+func (c *BusinessHoursCalculator) spansOn(t time.Time) []businessSpan {
+	t = t.In(c.location)
+	if c.holidays[t.Format(holidayDateLayout)] {
+		return nil
+	}
+
+	y, m, d := t.Date()
+	weekday := int64(t.Weekday())
+
+	var spans []businessSpan
+	for _, interval := range c.intervals {
+		if interval.StartTime/minutesPerDay != weekday {
+			continue
+		}
+		startMin := interval.StartTime - weekday*minutesPerDay
+		endMin := interval.EndTime - weekday*minutesPerDay
+		spans = append(spans, businessSpan{
+			start: time.Date(y, m, d, 0, int(startMin), 0, 0, c.location),
+			end:   time.Date(y, m, d, 0, int(endMin), 0, 0, c.location),
+		})
+	}
+	return spans
+}
+
+func startOfDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
+
+// railsTimeZones maps Zendesk time zone names to IANA time zone names
+var railsTimeZones = map[string]string{
+	"International Date Line West": "Etc/GMT+12",
+	"Midway Island":                "Pacific/Midway",
+	"American Samoa":               "Pacific/Pago_Pago",
+	"Hawaii":                       "Pacific/Honolulu",
+	"Alaska":                       "America/Juneau",
+	"Pacific Time (US & Canada)":   "America/Los_Angeles",
+	"Tijuana":                      "America/Tijuana",
+	"Mountain Time (US & Canada)":  "America/Denver",
+	"Arizona":                      "America/Phoenix",
+	"Chihuahua":                    "America/Chihuahua",
+	"Mazatlan":                     "America/Mazatlan",
+	"Central Time (US & Canada)":   "America/Chicago",
+	"Saskatchewan":                 "America/Regina",
+	"Guadalajara":                  "America/Mexico_City",
+	"Mexico City":                  "America/Mexico_City",
+	"Monterrey":                    "America/Monterrey",
+	"Central America":              "America/Guatemala",
+	"Eastern Time (US & Canada)":   "America/New_York",
+	"Indiana (East)":               "America/Indiana/Indianapolis",
+	"Bogota":                       "America/Bogota",
+	"Lima":                         "America/Lima",
+	"Quito":                        "America/Lima",
+	"Atlantic Time (Canada)":       "America/Halifax",
+	"Caracas":                      "America/Caracas",
+	"La Paz":                       "America/La_Paz",
+	"Santiago":                     "America/Santiago",
+	"Newfoundland":                 "America/St_Johns",
+	"Brasilia":                     "America/Sao_Paulo",
+	"Buenos Aires":                 "America/Argentina/Buenos_Aires",
+	"Montevideo":                   "America/Montevideo",
+	"Georgetown":                   "America/Guyana",
+	"Puerto Rico":                  "America/Puerto_Rico",
+	"Greenland":                    "America/Godthab",
+	"Mid-Atlantic":                 "Atlantic/South_Georgia",
+	"Azores":                       "Atlantic/Azores",
+	"Cape Verde Is.":               "Atlantic/Cape_Verde",
+	"Dublin":                       "Europe/Dublin",
+	"Edinburgh":                    "Europe/London",
+	"Lisbon":                       "Europe/Lisbon",
+	"London":                       "Europe/London",
+	"Casablanca":                   "Africa/Casablanca",
+	"Monrovia":                     "Africa/Monrovia",
+	"UTC":                          "Etc/UTC",
+	"Belgrade":                     "Europe/Belgrade",
+	"Bratislava":                   "Europe/Bratislava",
+	"Budapest":                     "Europe/Budapest",
+	"Ljubljana":                    "Europe/Ljubljana",
+	"Prague":                       "Europe/Prague",
+	"Sarajevo":                     "Europe/Sarajevo",
+	"Skopje":                       "Europe/Skopje",
+	"Warsaw":                       "Europe/Warsaw",
+	"Zagreb":                       "Europe/Zagreb",
+	"Brussels":                     "Europe/Brussels",
+	"Copenhagen":                   "Europe/Copenhagen",
+	"Madrid":                       "Europe/Madrid",
+	"Paris":                        "Europe/Paris",
+	"Amsterdam":                    "Europe/Amsterdam",
+	"Berlin":                       "Europe/Berlin",
+	"Bern":                         "Europe/Zurich",
+	"Zurich":                       "Europe/Zurich",
+	"Rome":                         "Europe/Rome",
+	"Stockholm":                    "Europe/Stockholm",
+	"Vienna":                       "Europe/Vienna",
+	"West Central Africa":          "Africa/Algiers",
+	"Bucharest":                    "Europe/Bucharest",
+	"Cairo":                        "Africa/Cairo",
+	"Helsinki":                     "Europe/Helsinki",
+	"Kyiv":                         "Europe/Kiev",
+	"Riga":                         "Europe/Riga",
+	"Sofia":                        "Europe/Sofia",
+	"Tallinn":                      "Europe/Tallinn",
+	"Vilnius":                      "Europe/Vilnius",
+	"Athens":                       "Europe/Athens",
+	"Istanbul":                     "Europe/Istanbul",
+	"Minsk":                        "Europe/Minsk",
+	"Jerusalem":                    "Asia/Jerusalem",
+	"Harare":                       "Africa/Harare",
+	"Pretoria":                     "Africa/Johannesburg",
+	"Kaliningrad":                  "Europe/Kaliningrad",
+	"Moscow":                       "Europe/Moscow",
+	"St. Petersburg":               "Europe/Moscow",
+	"Volgograd":                    "Europe/Volgograd",
+	"Samara":                       "Europe/Samara",
+	"Kuwait":                       "Asia/Kuwait",
+	"Riyadh":                       "Asia/Riyadh",
+	"Nairobi":                      "Africa/Nairobi",
+	"Baghdad":                      "Asia/Baghdad",
+	"Tehran":                       "Asia/Tehran",
+	"Abu Dhabi":                    "Asia/Muscat",
+	"Muscat":                       "Asia/Muscat",
+	"Baku":                         "Asia/Baku",
+	"Tbilisi":                      "Asia/Tbilisi",
+	"Yerevan":                      "Asia/Yerevan",
+	"Kabul":                        "Asia/Kabul",
+	"Ekaterinburg":                 "Asia/Yekaterinburg",
+	"Islamabad":                    "Asia/Karachi",
+	"Karachi":                      "Asia/Karachi",
+	"Tashkent":                     "Asia/Tashkent",
+	"Chennai":                      "Asia/Kolkata",
+	"Kolkata":                      "Asia/Kolkata",
+	"Mumbai":                       "Asia/Kolkata",
+	"New Delhi":                    "Asia/Kolkata",
+	"Kathmandu":                    "Asia/Kathmandu",
+	"Astana":                       "Asia/Dhaka",
+	"Dhaka":                        "Asia/Dhaka",
+	"Sri Jayawardenepura":          "Asia/Colombo",
+	"Almaty":                       "Asia/Almaty",
+	"Novosibirsk":                  "Asia/Novosibirsk",
+	"Rangoon":                      "Asia/Rangoon",
+	"Bangkok":                      "Asia/Bangkok",
+	"Hanoi":                        "Asia/Bangkok",
+	"Jakarta":                      "Asia/Jakarta",
+	"Krasnoyarsk":                  "Asia/Krasnoyarsk",
+	"Beijing":                      "Asia/Shanghai",
+	"Chongqing":                    "Asia/Chongqing",
+	"Hong Kong":                    "Asia/Hong_Kong",
+	"Urumqi":                       "Asia/Urumqi",
+	"Kuala Lumpur":                 "Asia/Kuala_Lumpur",
+	"Singapore":                    "Asia/Singapore",
+	"Taipei":                       "Asia/Taipei",
+	"Perth":                        "Australia/Perth",
+	"Irkutsk":                      "Asia/Irkutsk",
+	"Ulaanbaatar":                  "Asia/Ulaanbaatar",
+	"Seoul":                        "Asia/Seoul",
+	"Osaka":                        "Asia/Tokyo",
+	"Sapporo":                      "Asia/Tokyo",
+	"Tokyo":                        "Asia/Tokyo",
+	"Yakutsk":                      "Asia/Yakutsk",
+	"Darwin":                       "Australia/Darwin",
+	"Adelaide":                     "Australia/Adelaide",
+	"Canberra":                     "Australia/Melbourne",
+	"Melbourne":                    "Australia/Melbourne",
+	"Sydney":                       "Australia/Sydney",
+	"Brisbane":                     "Australia/Brisbane",
+	"Hobart":                       "Australia/Hobart",
+	"Vladivostok":                  "Asia/Vladivostok",
+	"Guam":                         "Pacific/Guam",
+	"Port Moresby":                 "Pacific/Port_Moresby",
+	"Magadan":                      "Asia/Magadan",
+	"Srednekolymsk":                "Asia/Srednekolymsk",
+	"Solomon Is.":                  "Pacific/Guadalcanal",
+	"New Caledonia":                "Pacific/Noumea",
+	"Fiji":                         "Pacific/Fiji",
+	"Kamchatka":                    "Asia/Kamchatka",
+	"Marshall Is.":                 "Pacific/Majuro",
+	"Auckland":                     "Pacific/Auckland",
+	"Wellington":                   "Pacific/Auckland",
+	"Nuku'alofa":                   "Pacific/Tongatapu",
+	"Tokelau Is.":                  "Pacific/Fakaofo",
+	"Chatham Is.":                  "Pacific/Chatham",
+	"Samoa":                        "Pacific/Apia",
+}
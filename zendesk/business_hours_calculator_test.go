@@ -0,0 +1,187 @@
+package zendesk
+
+import (
+	"testing"
+	"time"
+)
+
+// weekdays 9:00-17:00
+var testSchedule = Schedule{
+	Name:     "East Coast",
+	TimeZone: "Eastern Time (US & Canada)",
+	Intervals: []ScheduleInterval{
+		{StartTime: 1980, EndTime: 2460},
+		{StartTime: 3420, EndTime: 3900},
+		{StartTime: 4860, EndTime: 5340},
+		{StartTime: 6300, EndTime: 6780},
+		{StartTime: 7740, EndTime: 8220},
+	},
+}
+
+func newTestCalculator(t *testing.T, holidays []Holiday) *BusinessHoursCalculator {
+	calc, err := NewBusinessHoursCalculator(testSchedule, holidays)
+	if err != nil {
+		t.Fatalf("Failed to create calculator: %s", err)
+	}
+	return calc
+}
+
+func TestBusinessMinutesBetween(t *testing.T) {
+	calc := newTestCalculator(t, nil)
+	loc := calc.Location()
+
+	cases := []struct {
+		start, end time.Time
+		expected   int64
+	}{
+		// Monday 10:00 - Monday 12:00
+		{time.Date(2023, 3, 6, 10, 0, 0, 0, loc), time.Date(2023, 3, 6, 12, 0, 0, 0, loc), 120},
+		// Friday 16:00 - Monday 10:00
+		{time.Date(2023, 3, 10, 16, 0, 0, 0, loc), time.Date(2023, 3, 13, 10, 0, 0, 0, loc), 120},
+		// Saturday - Sunday
+		{time.Date(2023, 3, 11, 10, 0, 0, 0, loc), time.Date(2023, 3, 12, 23, 0, 0, 0, loc), 0},
+		// a whole week
+		{time.Date(2023, 3, 5, 0, 0, 0, 0, loc), time.Date(2023, 3, 12, 0, 0, 0, 0, loc), 5 * 480},
+		// end before start
+		{time.Date(2023, 3, 6, 12, 0, 0, 0, loc), time.Date(2023, 3, 6, 10, 0, 0, 0, loc), 0},
+	}
+
+	for _, c := range cases {
+		if got := calc.BusinessMinutesBetween(c.start, c.end); got != c.expected {
+			t.Fatalf("expected %d business minutes between %s and %s, but got %d", c.expected, c.start, c.end, got)
+		}
+	}
+}
+
+func TestBusinessMinutesBetweenAcrossTimeZones(t *testing.T) {
+	calc := newTestCalculator(t, nil)
+
+	// Monday 14:00-16:00 UTC is 09:00-11:00 in New York (EST)
+	start := time.Date(2023, 3, 6, 14, 0, 0, 0, time.UTC)
+	end := time.Date(2023, 3, 6, 16, 0, 0, 0, time.UTC)
+	if got := calc.BusinessMinutesBetween(start, end); got != 120 {
+		t.Fatalf("expected 120 business minutes, but got %d", got)
+	}
+
+	// Monday 13:00-14:00 UTC is before business hours in New York
+	start = time.Date(2023, 3, 6, 13, 0, 0, 0, time.UTC)
+	end = time.Date(2023, 3, 6, 14, 0, 0, 0, time.UTC)
+	if got := calc.BusinessMinutesBetween(start, end); got != 0 {
+		t.Fatalf("expected 0 business minutes, but got %d", got)
+	}
+}
+
+func TestBusinessMinutesBetweenWithHolidays(t *testing.T) {
+	calc := newTestCalculator(t, []Holiday{
+		{Name: "Christmas", StartDate: "2023-12-25", EndDate: "2023-12-26"},
+	})
+	loc := calc.Location()
+
+	// Friday 12:00 - Wednesday 12:00 skipping the weekend and two holidays
+	start := time.Date(2023, 12, 22, 12, 0, 0, 0, loc)
+	end := time.Date(2023, 12, 27, 12, 0, 0, 0, loc)
+	if got := calc.BusinessMinutesBetween(start, end); got != 300+180 {
+		t.Fatalf("expected 480 business minutes, but got %d", got)
+	}
+}
+
+func TestAddBusinessMinutes(t *testing.T) {
+	calc := newTestCalculator(t, []Holiday{
+		{Name: "Christmas", StartDate: "2023-12-25", EndDate: "2023-12-25"},
+	})
+	loc := calc.Location()
+
+	cases := []struct {
+		start    time.Time
+		minutes  int64
+		expected time.Time
+	}{
+		// within the same day
+		{time.Date(2023, 3, 6, 10, 0, 0, 0, loc), 60, time.Date(2023, 3, 6, 11, 0, 0, 0, loc)},
+		// ends exactly at the end of business hours
+		{time.Date(2023, 3, 6, 16, 0, 0, 0, loc), 60, time.Date(2023, 3, 6, 17, 0, 0, 0, loc)},
+		// overflows to the next business day
+		{time.Date(2023, 3, 10, 16, 0, 0, 0, loc), 120, time.Date(2023, 3, 13, 10, 0, 0, 0, loc)},
+		// starts outside business hours
+		{time.Date(2023, 3, 11, 8, 0, 0, 0, loc), 30, time.Date(2023, 3, 13, 9, 30, 0, 0, loc)},
+		// skips a holiday
+		{time.Date(2023, 12, 22, 16, 0, 0, 0, loc), 120, time.Date(2023, 12, 26, 10, 0, 0, 0, loc)},
+	}
+
+	for _, c := range cases {
+		got, err := calc.AddBusinessMinutes(c.start, c.minutes)
+		if err != nil {
+			t.Fatalf("Failed to add business minutes: %s", err)
+		}
+		if !got.Equal(c.expected) {
+			t.Fatalf("expected deadline %s, but got %s", c.expected, got)
+		}
+	}
+}
+
+func TestAddBusinessMinutesAcrossDST(t *testing.T) {
+	calc := newTestCalculator(t, nil)
+	loc := calc.Location()
+
+	// DST starts on Sunday 2023-03-12 in New York; business hours stay 9:00-17:00 local time
+	got, err := calc.AddBusinessMinutes(time.Date(2023, 3, 10, 16, 0, 0, 0, loc), 120)
+	if err != nil {
+		t.Fatalf("Failed to add business minutes: %s", err)
+	}
+	expected := time.Date(2023, 3, 13, 10, 0, 0, 0, loc)
+	if !got.Equal(expected) || got.UTC().Hour() != 14 {
+		t.Fatalf("expected deadline %s, but got %s", expected, got)
+	}
+}
+
+func TestNextBusinessTime(t *testing.T) {
+	calc := newTestCalculator(t, nil)
+	loc := calc.Location()
+
+	inHours := time.Date(2023, 3, 6, 10, 0, 0, 0, loc)
+	got, err := calc.NextBusinessTime(inHours)
+	if err != nil {
+		t.Fatalf("Failed to get next business time: %s", err)
+	}
+	if !got.Equal(inHours) {
+		t.Fatalf("expected %s, but got %s", inHours, got)
+	}
+
+	got, err = calc.NextBusinessTime(time.Date(2023, 3, 6, 18, 0, 0, 0, loc))
+	if err != nil {
+		t.Fatalf("Failed to get next business time: %s", err)
+	}
+	if expected := time.Date(2023, 3, 7, 9, 0, 0, 0, loc); !got.Equal(expected) {
+		t.Fatalf("expected %s, but got %s", expected, got)
+	}
+
+	if calc.IsBusinessTime(time.Date(2023, 3, 11, 10, 0, 0, 0, loc)) {
+		t.Fatal("Saturday should not be business time")
+	}
+}
+
+func TestBusinessHoursCalculatorWithoutIntervals(t *testing.T) {
+	calc, err := NewBusinessHoursCalculator(Schedule{TimeZone: "UTC"}, nil)
+	if err != nil {
+		t.Fatalf("Failed to create calculator: %s", err)
+	}
+
+	if _, err := calc.AddBusinessMinutes(time.Now(), 10); err != ErrNoBusinessHours {
+		t.Fatalf("expected ErrNoBusinessHours, but got %v", err)
+	}
+}
+
+func TestNewBusinessHoursCalculatorInvalid(t *testing.T) {
+	if _, err := NewBusinessHoursCalculator(Schedule{TimeZone: "Nowhere/Unknown"}, nil); err == nil {
+		t.Fatal("expected an error for unknown time zone")
+	}
+
+	invalid := Schedule{Intervals: []ScheduleInterval{{StartTime: 600, EndTime: 500}}}
+	if _, err := NewBusinessHoursCalculator(invalid, nil); err == nil {
+		t.Fatal("expected an error for invalid interval")
+	}
+
+	if _, err := NewBusinessHoursCalculator(testSchedule, []Holiday{{StartDate: "25/12/2023"}}); err == nil {
+		t.Fatal("expected an error for invalid holiday date")
+	}
+}
@@ -0,0 +1,160 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetSchedules(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "schedules.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	schedules, err := client.GetSchedules(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get schedules: %s", err)
+	}
+
+	if len(schedules) != 1 {
+		t.Fatalf("expected length of schedules is 1, but got %d", len(schedules))
+	}
+
+	if len(schedules[0].Intervals) != 5 {
+		t.Fatalf("expected length of intervals is 5, but got %d", len(schedules[0].Intervals))
+	}
+}
+
+func TestGetSchedule(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "schedule.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	schedule, err := client.GetSchedule(ctx, 360000036552)
+	if err != nil {
+		t.Fatalf("Failed to get schedule: %s", err)
+	}
+
+	expectedID := int64(360000036552)
+	if schedule.ID != expectedID {
+		t.Fatalf("Returned schedule does not have the expected ID %d. Schedule ID is %d", expectedID, schedule.ID)
+	}
+}
+
+func TestCreateSchedule(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodPost, "schedule.json", http.StatusCreated)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.CreateSchedule(ctx, Schedule{Name: "East Coast", TimeZone: "Eastern Time (US & Canada)"})
+	if err != nil {
+		t.Fatalf("Failed to send request to create schedule: %s", err)
+	}
+}
+
+func TestUpdateSchedule(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodPut, "schedule.json", http.StatusOK)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.UpdateSchedule(ctx, 360000036552, Schedule{Name: "East Coast"})
+	if err != nil {
+		t.Fatalf("Failed to send request to update schedule: %s", err)
+	}
+}
+
+func TestUpdateScheduleIntervals(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodPut, "schedule_workweek.json", http.StatusOK)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	intervals, err := client.UpdateScheduleIntervals(ctx, 360000036552, []ScheduleInterval{
+		{StartTime: 1980, EndTime: 2460},
+		{StartTime: 3420, EndTime: 3900},
+	})
+	if err != nil {
+		t.Fatalf("Failed to send request to update schedule intervals: %s", err)
+	}
+
+	if len(intervals) != 2 {
+		t.Fatalf("expected length of intervals is 2, but got %d", len(intervals))
+	}
+}
+
+func TestDeleteSchedule(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+		w.Write(nil)
+	}))
+
+	c := newTestClient(mockAPI)
+	err := c.DeleteSchedule(ctx, 360000036552)
+	if err != nil {
+		t.Fatalf("Failed to delete schedule: %s", err)
+	}
+}
+
+func TestGetHolidays(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "holidays.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	holidays, err := client.GetHolidays(ctx, 360000036552)
+	if err != nil {
+		t.Fatalf("Failed to get holidays: %s", err)
+	}
+
+	if len(holidays) != 1 {
+		t.Fatalf("expected length of holidays is 1, but got %d", len(holidays))
+	}
+}
+
+func TestGetHoliday(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "holiday.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	holiday, err := client.GetHoliday(ctx, 360000036552, 360000100231)
+	if err != nil {
+		t.Fatalf("Failed to get holiday: %s", err)
+	}
+
+	if holiday.StartDate != "2019-12-25" {
+		t.Fatalf("expected holiday start date is 2019-12-25, but got %s", holiday.StartDate)
+	}
+}
+
+func TestCreateHoliday(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodPost, "holiday.json", http.StatusCreated)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.CreateHoliday(ctx, 360000036552, Holiday{Name: "Christmas", StartDate: "2019-12-25", EndDate: "2019-12-26"})
+	if err != nil {
+		t.Fatalf("Failed to send request to create holiday: %s", err)
+	}
+}
+
+func TestUpdateHoliday(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodPut, "holiday.json", http.StatusOK)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.UpdateHoliday(ctx, 360000036552, 360000100231, Holiday{Name: "Christmas"})
+	if err != nil {
+		t.Fatalf("Failed to send request to update holiday: %s", err)
+	}
+}
+
+func TestDeleteHoliday(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+		w.Write(nil)
+	}))
+
+	c := newTestClient(mockAPI)
+	err := c.DeleteHoliday(ctx, 360000036552, 360000100231)
+	if err != nil {
+		t.Fatalf("Failed to delete holiday: %s", err)
+	}
+}
@@ -0,0 +1,936 @@
+package zendesk
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// TicketStatus is the status of a ticket used in business rules
+type TicketStatus string
+
+// Ticket status values
+const (
+	StatusNew     TicketStatus = "new"
+	StatusOpen    TicketStatus = "open"
+	StatusPending TicketStatus = "pending"
+	StatusHold    TicketStatus = "hold"
+	StatusSolved  TicketStatus = "solved"
+	StatusClosed  TicketStatus = "closed"
+)
+
+// TicketPriority is the priority of a ticket used in business rules
+type TicketPriority string
+
+// Ticket priority values
+const (
+	PriorityLow    TicketPriority = "low"
+	PriorityNormal TicketPriority = "normal"
+	PriorityHigh   TicketPriority = "high"
+	PriorityUrgent TicketPriority = "urgent"
+)
+
+// TicketType is the type of a ticket used in business rules
+type TicketType string
+
+// Ticket type values
+const (
+	TicketTypeQuestion TicketType = "question"
+	TicketTypeIncident TicketType = "incident"
+	TicketTypeProblem  TicketType = "problem"
+	TicketTypeTask     TicketType = "task"
+)
+
+// HoursField is a time based condition field used in automations and views
+type HoursField string
+
+// Time based condition fields
+//
+// ref: https://developer.zendesk.com/documentation/ticketing/reference-guides/conditions-reference/
+const (
+	HoursSinceCreated          HoursField = "NEW"
+	HoursSinceOpen             HoursField = "OPEN"
+	HoursSincePending          HoursField = "PENDING"
+	HoursSinceSolved           HoursField = "SOLVED"
+	HoursSinceClosed           HoursField = "CLOSED"
+	HoursSinceAssigned         HoursField = "assigned_at"
+	HoursSinceUpdated          HoursField = "updated_at"
+	HoursSinceRequesterUpdated HoursField = "requester_updated_at"
+	HoursSinceAssigneeUpdated  HoursField = "assignee_updated_at"
+	HoursSinceDueDate          HoursField = "due_date"
+	HoursUntilDueDate          HoursField = "until_due_date"
+)
+
+// Condition operators
+//
+// ref: https://developer.zendesk.com/documentation/ticketing/reference-guides/conditions-reference/
+const (
+	OperatorIs                       = "is"
+	OperatorIsNot                    = "is_not"
+	OperatorLessThan                 = "less_than"
+	OperatorGreaterThan              = "greater_than"
+	OperatorChanged                  = "changed"
+	OperatorChangedTo                = "value"
+	OperatorChangedFrom              = "value_previous"
+	OperatorNotChanged               = "not_changed"
+	OperatorNotChangedTo             = "not_value"
+	OperatorNotChangedFrom           = "not_value_previous"
+	OperatorIncludes                 = "includes"
+	OperatorNotIncludes              = "not_includes"
+	OperatorPresent                  = "present"
+	OperatorNotPresent               = "not_present"
+	OperatorIsBusinessHours          = "is_business_hours"
+	OperatorLessThanBusinessHours    = "less_than_business_hours"
+	OperatorGreaterThanBusinessHours = "greater_than_business_hours"
+)
+
+// Update types of the update_type condition
+const (
+	UpdateTypeCreate = "Create"
+	UpdateTypeChange = "Change"
+)
+
+// Recipients of Act.NotifyUser
+const (
+	NotifyCurrentUser = "current_user"
+	NotifyRequester   = "requester_id"
+	NotifyAssignee    = "assignee_id"
+	NotifyAllAgents   = "all_agents"
+)
+
+// RuleKind is the kind of business rule a condition or an action is used in
+type RuleKind string
+
+// Business rule kinds
+const (
+	RuleKindTrigger    RuleKind = "trigger"
+	RuleKindAutomation RuleKind = "automation"
+	RuleKindView       RuleKind = "view"
+	RuleKindMacro      RuleKind = "macro"
+	RuleKindSLAPolicy  RuleKind = "sla_policy"
+)
+
+type (
+	// RuleCondition is a condition of a business rule built with Cond.
+	// It can be converted to the condition type of triggers, automations,
+	// views and SLA policies.
+	RuleCondition struct {
+		Field    string
+		Operator string
+		Value    string
+	}
+
+	// RuleAction is an action of a business rule built with Act.
+	// It can be converted to the action type of triggers, automations and macros.
+	RuleAction struct {
+		Field string
+		Value interface{}
+	}
+
+	// RuleValidationError is returned when business rule conditions or actions are invalid
+	RuleValidationError struct {
+		Kind   RuleKind
+		Errors []string
+	}
+)
+
+// Error joins the errors of all invalid conditions and actions
+func (e *RuleValidationError) Error() string {
+	return fmt.Sprintf("invalid %s: %s", e.Kind, strings.Join(e.Errors, "; "))
+}
+
+// Cond is the entry point to build conditions of business rules
+// such as Cond.Status().Is(StatusOpen).
+var Cond ConditionBuilder
+
+// Act is the entry point to build actions of business rules
+// such as Act.AddTags("vip").
+var Act ActionBuilder
+
+// ConditionBuilder builds conditions field by field.
+// Each field only provides the operators it supports.
+type ConditionBuilder struct{}
+
+// ValueCondition builds conditions comparing a field to a value
+type ValueCondition[T any] struct {
+	field string
+}
+
+// OrderedCondition builds conditions of fields whose values are ordered, such as status
+type OrderedCondition[T any] struct {
+	ValueCondition[T]
+}
+
+// IDCondition builds conditions of fields referring to another object by ID
+type IDCondition struct {
+	ValueCondition[int64]
+}
+
+// AssigneeCondition builds conditions of the assignee of a ticket
+type AssigneeCondition struct {
+	IDCondition
+}
+
+// CustomFieldCondition builds conditions of a custom ticket field
+type CustomFieldCondition struct {
+	OrderedCondition[string]
+}
+
+// TagsCondition builds conditions of the tags of a ticket
+type TagsCondition struct{}
+
+// TextCondition builds conditions of words in the subject, the description or a comment
+type TextCondition struct {
+	field string
+}
+
+// HoursCondition builds time based conditions of automations and views
+type HoursCondition struct {
+	field HoursField
+}
+
+// Status builds conditions of the ticket status
+func (ConditionBuilder) Status() OrderedCondition[TicketStatus] {
+	return OrderedCondition[TicketStatus]{ValueCondition[TicketStatus]{ConditionFieldText(ConditionFieldStatus)}}
+}
+
+// Priority builds conditions of the ticket priority
+func (ConditionBuilder) Priority() OrderedCondition[TicketPriority] {
+	return OrderedCondition[TicketPriority]{ValueCondition[TicketPriority]{ConditionFieldText(ConditionFieldPriority)}}
+}
+
+// Type builds conditions of the ticket type
+func (ConditionBuilder) Type() ValueCondition[TicketType] {
+	return ValueCondition[TicketType]{ConditionFieldText(ConditionFieldType)}
+}
+
+// GroupID builds conditions of the group of a ticket
+func (ConditionBuilder) GroupID() IDCondition {
+	return IDCondition{ValueCondition[int64]{ConditionFieldText(ConditionFieldGroupID)}}
+}
+
+// AssigneeID builds conditions of the assignee of a ticket
+func (ConditionBuilder) AssigneeID() AssigneeCondition {
+	return AssigneeCondition{IDCondition{ValueCondition[int64]{ConditionFieldText(ConditionFieldAssigneeID)}}}
+}
+
+// RequesterID builds conditions of the requester of a ticket
+func (ConditionBuilder) RequesterID() IDCondition {
+	return IDCondition{ValueCondition[int64]{ConditionFieldText(ConditionFieldRequesterID)}}
+}
+
+// OrganizationID builds conditions of the organization of a ticket
+func (ConditionBuilder) OrganizationID() IDCondition {
+	return IDCondition{ValueCondition[int64]{ConditionFieldText(ConditionFieldOrganizationID)}}
+}
+
+// TicketFormID builds conditions of the form of a ticket
+func (ConditionBuilder) TicketFormID() IDCondition {
+	return IDCondition{ValueCondition[int64]{ConditionFieldText(ConditionFieldTicketFormID)}}
+}
+
+// BrandID builds conditions of the brand of a ticket
+func (ConditionBuilder) BrandID() IDCondition {
+	return IDCondition{ValueCondition[int64]{ConditionFieldText(ConditionFieldBrandID)}}
+}
+
+// CustomStatusID builds conditions of the custom status of a ticket
+func (ConditionBuilder) CustomStatusID() IDCondition {
+	return IDCondition{ValueCondition[int64]{ConditionFieldText(ConditionFieldCustomStatusID)}}
+}
+
+// Via builds conditions of the channel a ticket was created through, such as ViaMail
+func (ConditionBuilder) Via() ValueCondition[int] {
+	return ValueCondition[int]{ConditionFieldText(ConditionFieldViaID)}
+}
+
+// CustomField builds conditions of the specified custom ticket field
+func (ConditionBuilder) CustomField(fieldID int64) CustomFieldCondition {
+	return CustomFieldCondition{OrderedCondition[string]{ValueCondition[string]{customFieldRuleField(fieldID)}}}
+}
+
+// Tags builds conditions of the tags of a ticket
+func (ConditionBuilder) Tags() TagsCondition {
+	return TagsCondition{}
+}
+
+// Subject builds conditions of words in the subject of a ticket
+func (ConditionBuilder) Subject() TextCondition {
+	return TextCondition{ConditionFieldText(ConditionFieldSubjectIncludesWord)}
+}
+
+// Description builds conditions of words in the description of a ticket
+func (ConditionBuilder) Description() TextCondition {
+	return TextCondition{ConditionFieldText(ConditionFieldDescriptionIncludesWord)}
+}
+
+// Comment builds conditions of words in the latest comment of a ticket. Triggers only.
+func (ConditionBuilder) Comment() TextCondition {
+	return TextCondition{ConditionFieldText(ConditionFieldCommentIncludesWord)}
+}
+
+// Hours builds time based conditions such as Cond.Hours(HoursSinceSolved).GreaterThan(96)
+func (ConditionBuilder) Hours(field HoursField) HoursCondition {
+	return HoursCondition{field}
+}
+
+// TicketIsCreated matches tickets being created. Triggers only.
+func (ConditionBuilder) TicketIsCreated() RuleCondition {
+	return RuleCondition{ConditionFieldText(ConditionFieldUpdateType), OperatorIs, UpdateTypeCreate}
+}
+
+// TicketIsUpdated matches tickets being updated. Triggers only.
+func (ConditionBuilder) TicketIsUpdated() RuleCondition {
+	return RuleCondition{ConditionFieldText(ConditionFieldUpdateType), OperatorIs, UpdateTypeChange}
+}
+
+// CommentIsPublic matches the visibility of the latest comment. Triggers only.
+func (ConditionBuilder) CommentIsPublic(public bool) RuleCondition {
+	return RuleCondition{ConditionFieldText(ConditionFieldCommentIsPublic), OperatorIs, strconv.FormatBool(public)}
+}
+
+// Is matches the field having the value
+func (c ValueCondition[T]) Is(value T) RuleCondition {
+	return c.condition(OperatorIs, value)
+}
+
+// IsNot matches the field not having the value
+func (c ValueCondition[T]) IsNot(value T) RuleCondition {
+	return c.condition(OperatorIsNot, value)
+}
+
+// Changed matches the field being changed by the update. Triggers only.
+func (c ValueCondition[T]) Changed() RuleCondition {
+	return RuleCondition{c.field, OperatorChanged, ""}
+}
+
+// NotChanged matches the field not being changed by the update. Triggers only.
+func (c ValueCondition[T]) NotChanged() RuleCondition {
+	return RuleCondition{c.field, OperatorNotChanged, ""}
+}
+
+// ChangedTo matches the field being changed to the value. Triggers only.
+func (c ValueCondition[T]) ChangedTo(value T) RuleCondition {
+	return c.condition(OperatorChangedTo, value)
+}
+
+// ChangedFrom matches the field being changed from the value. Triggers only.
+func (c ValueCondition[T]) ChangedFrom(value T) RuleCondition {
+	return c.condition(OperatorChangedFrom, value)
+}
+
+// NotChangedTo matches the field not being changed to the value. Triggers only.
+func (c ValueCondition[T]) NotChangedTo(value T) RuleCondition {
+	return c.condition(OperatorNotChangedTo, value)
+}
+
+// NotChangedFrom matches the field not being changed from the value. Triggers only.
+func (c ValueCondition[T]) NotChangedFrom(value T) RuleCondition {
+	return c.condition(OperatorNotChangedFrom, value)
+}
+
+func (c ValueCondition[T]) condition(operator string, value T) RuleCondition {
+	return RuleCondition{c.field, operator, fmt.Sprint(value)}
+}
+
+// LessThan matches the field being lower than the value, such as status less than solved
+func (c OrderedCondition[T]) LessThan(value T) RuleCondition {
+	return c.condition(OperatorLessThan, value)
+}
+
+// GreaterThan matches the field being higher than the value
+func (c OrderedCondition[T]) GreaterThan(value T) RuleCondition {
+	return c.condition(OperatorGreaterThan, value)
+}
+
+// IsNone matches the field being empty
+func (c IDCondition) IsNone() RuleCondition {
+	return RuleCondition{c.field, OperatorIs, ""}
+}
+
+// IsNotNone matches the field being set
+func (c IDCondition) IsNotNone() RuleCondition {
+	return RuleCondition{c.field, OperatorIsNot, ""}
+}
+
+// IsCurrentUser matches tickets assigned to the user who updates them
+func (c AssigneeCondition) IsCurrentUser() RuleCondition {
+	return RuleCondition{c.field, OperatorIs, "current_user"}
+}
+
+// IsNotCurrentUser matches tickets not assigned to the user who updates them
+func (c AssigneeCondition) IsNotCurrentUser() RuleCondition {
+	return RuleCondition{c.field, OperatorIsNot, "current_user"}
+}
+
+// Present matches the custom field having any value
+func (c CustomFieldCondition) Present() RuleCondition {
+	return RuleCondition{c.field, OperatorPresent, ""}
+}
+
+// NotPresent matches the custom field being empty
+func (c CustomFieldCondition) NotPresent() RuleCondition {
+	return RuleCondition{c.field, OperatorNotPresent, ""}
+}
+
+// Includes matches a multi-select custom field including the option
+func (c CustomFieldCondition) Includes(option string) RuleCondition {
+	return RuleCondition{c.field, OperatorIncludes, option}
+}
+
+// NotIncludes matches a multi-select custom field not including the option
+func (c CustomFieldCondition) NotIncludes(option string) RuleCondition {
+	return RuleCondition{c.field, OperatorNotIncludes, option}
+}
+
+// Includes matches tickets having at least one of the tags
+func (TagsCondition) Includes(tags ...string) RuleCondition {
+	return RuleCondition{ConditionFieldText(ConditionFieldCurrentTags), OperatorIncludes, strings.Join(tags, " ")}
+}
+
+// NotIncludes matches tickets having none of the tags
+func (TagsCondition) NotIncludes(tags ...string) RuleCondition {
+	return RuleCondition{ConditionFieldText(ConditionFieldCurrentTags), OperatorNotIncludes, strings.Join(tags, " ")}
+}
+
+// IncludesWords matches text containing at least one of the words
+func (c TextCondition) IncludesWords(words ...string) RuleCondition {
+	return RuleCondition{c.field, OperatorIncludes, strings.Join(words, " ")}
+}
+
+// NotIncludesWords matches text containing none of the words
+func (c TextCondition) NotIncludesWords(words ...string) RuleCondition {
+	return RuleCondition{c.field, OperatorNotIncludes, strings.Join(words, " ")}
+}
+
+// IncludesString matches text containing the string
+func (c TextCondition) IncludesString(s string) RuleCondition {
+	return RuleCondition{c.field, OperatorIs, s}
+}
+
+// NotIncludesString matches text not containing the string
+func (c TextCondition) NotIncludesString(s string) RuleCondition {
+	return RuleCondition{c.field, OperatorIsNot, s}
+}
+
+// Is matches the number of calendar hours being equal to hours
+func (c HoursCondition) Is(hours int) RuleCondition {
+	return RuleCondition{string(c.field), OperatorIs, strconv.Itoa(hours)}
+}
+
+// LessThan matches the number of calendar hours being less than hours
+func (c HoursCondition) LessThan(hours int) RuleCondition {
+	return RuleCondition{string(c.field), OperatorLessThan, strconv.Itoa(hours)}
+}
+
+// GreaterThan matches the number of calendar hours being greater than hours
+func (c HoursCondition) GreaterThan(hours int) RuleCondition {
+	return RuleCondition{string(c.field), OperatorGreaterThan, strconv.Itoa(hours)}
+}
+
+// IsBusinessHours matches the number of business hours being equal to hours
+func (c HoursCondition) IsBusinessHours(hours int) RuleCondition {
+	return RuleCondition{string(c.field), OperatorIsBusinessHours, strconv.Itoa(hours)}
+}
+
+// LessThanBusinessHours matches the number of business hours being less than hours
+func (c HoursCondition) LessThanBusinessHours(hours int) RuleCondition {
+	return RuleCondition{string(c.field), OperatorLessThanBusinessHours, strconv.Itoa(hours)}
+}
+
+// GreaterThanBusinessHours matches the number of business hours being greater than hours
+func (c HoursCondition) GreaterThanBusinessHours(hours int) RuleCondition {
+	return RuleCondition{string(c.field), OperatorGreaterThanBusinessHours, strconv.Itoa(hours)}
+}
+
+// ActionBuilder builds actions of business rules
+type ActionBuilder struct{}
+
+// Status sets the ticket status
+func (ActionBuilder) Status(status TicketStatus) RuleAction {
+	return RuleAction{ActionFieldText(ActionFieldStatus), string(status)}
+}
+
+// Priority sets the ticket priority
+func (ActionBuilder) Priority(priority TicketPriority) RuleAction {
+	return RuleAction{ActionFieldText(ActionFieldPriority), string(priority)}
+}
+
+// Type sets the ticket type
+func (ActionBuilder) Type(ticketType TicketType) RuleAction {
+	return RuleAction{ActionFieldText(ActionFieldType), string(ticketType)}
+}
+
+// GroupID assigns the ticket to the group
+func (ActionBuilder) GroupID(groupID int64) RuleAction {
+	return RuleAction{ActionFieldText(ActionFieldGroupID), strconv.FormatInt(groupID, 10)}
+}
+
+// AssigneeID assigns the ticket to the agent
+func (ActionBuilder) AssigneeID(userID int64) RuleAction {
+	return RuleAction{ActionFieldText(ActionFieldAssigneeID), strconv.FormatInt(userID, 10)}
+}
+
+// AssignToCurrentUser assigns the ticket to the user who updates it
+func (ActionBuilder) AssignToCurrentUser() RuleAction {
+	return RuleAction{ActionFieldText(ActionFieldAssigneeID), "current_user"}
+}
+
+// SetTags replaces the tags of the ticket
+func (ActionBuilder) SetTags(tags ...string) RuleAction {
+	return RuleAction{ActionFieldText(ActionFieldSetTags), strings.Join(tags, " ")}
+}
+
+// AddTags adds the tags to the ticket
+func (ActionBuilder) AddTags(tags ...string) RuleAction {
+	return RuleAction{ActionFieldText(ActionFieldCurrentTags), strings.Join(tags, " ")}
+}
+
+// RemoveTags removes the tags from the ticket
+func (ActionBuilder) RemoveTags(tags ...string) RuleAction {
+	return RuleAction{ActionFieldText(ActionFieldRemoveTags), strings.Join(tags, " ")}
+}
+
+// TicketFormID sets the ticket form
+func (ActionBuilder) TicketFormID(formID int64) RuleAction {
+	return RuleAction{ActionFieldText(ActionFieldTicketFormID), strconv.FormatInt(formID, 10)}
+}
+
+// BrandID sets the ticket brand
+func (ActionBuilder) BrandID(brandID int64) RuleAction {
+	return RuleAction{ActionFieldText(ActionFieldBrandID), strconv.FormatInt(brandID, 10)}
+}
+
+// CustomStatusID sets the custom status of the ticket
+func (ActionBuilder) CustomStatusID(statusID int64) RuleAction {
+	return RuleAction{ActionFieldText(ActionFieldCustomStatusID), strconv.FormatInt(statusID, 10)}
+}
+
+// CustomField sets the value of the custom ticket field
+func (ActionBuilder) CustomField(fieldID int64, value string) RuleAction {
+	return RuleAction{customFieldRuleField(fieldID), value}
+}
+
+// CC adds the user as a CC of the ticket
+func (ActionBuilder) CC(userID int64) RuleAction {
+	return RuleAction{ActionFieldText(ActionFieldCC), strconv.FormatInt(userID, 10)}
+}
+
+// LocaleID sets the locale of the requester
+func (ActionBuilder) LocaleID(localeID int) RuleAction {
+	return RuleAction{ActionFieldText(ActionFieldLocaleID), strconv.Itoa(localeID)}
+}
+
+// NotifyUser emails the recipient, such as NotifyRequester or a user ID. Triggers and automations only.
+func (ActionBuilder) NotifyUser(recipient string, subject string, body string) RuleAction {
+	return RuleAction{ActionFieldText(ActionFieldNotificationUser), []string{recipient, subject, body}}
+}
+
+// NotifyGroup emails the agents of the group. Triggers and automations only.
+func (ActionBuilder) NotifyGroup(groupID int64, subject string, body string) RuleAction {
+	return RuleAction{ActionFieldText(ActionFieldNotificationGroup), []string{strconv.FormatInt(groupID, 10), subject, body}}
+}
+
+// NotifyTarget sends the message to the target. Triggers and automations only.
+func (ActionBuilder) NotifyTarget(targetID int64, message string) RuleAction {
+	return RuleAction{ActionFieldText(ActionFieldNotificationTarget), []string{strconv.FormatInt(targetID, 10), message}}
+}
+
+// NotifyWebhook sends the body to the webhook. Triggers and automations only.
+func (ActionBuilder) NotifyWebhook(webhookID string, body string) RuleAction {
+	return RuleAction{ActionFieldText(ActionFieldNotificationWebhook), []string{webhookID, body}}
+}
+
+// Subject sets the ticket subject. Macros only.
+func (ActionBuilder) Subject(subject string) RuleAction {
+	return RuleAction{ActionFieldText(ActionFieldSubject), subject}
+}
+
+// Comment adds a comment to the ticket. Macros only.
+func (ActionBuilder) Comment(body string) RuleAction {
+	return RuleAction{ActionFieldText(ActionFieldCommentValue), body}
+}
+
+// CommentHTML adds a comment formatted in HTML to the ticket. Macros only.
+func (ActionBuilder) CommentHTML(body string) RuleAction {
+	return RuleAction{ActionFieldText(ActionFieldCommentValueHTML), body}
+}
+
+// CommentIsPublic sets the visibility of the comment added by the macro. Macros only.
+func (ActionBuilder) CommentIsPublic(public bool) RuleAction {
+	return RuleAction{ActionFieldText(ActionFieldCommentModeIsPublic), strconv.FormatBool(public)}
+}
+
+// Trigger converts the condition to a trigger condition.
+// Views and routing queues use trigger conditions too.
+func (c RuleCondition) Trigger() TriggerCondition {
+	return TriggerCondition{Field: c.Field, Operator: c.Operator, Value: c.Value}
+}
+
+// Automation converts the condition to an automation condition
+func (c RuleCondition) Automation() AutomationCondition {
+	return AutomationCondition{Field: c.Field, Operator: c.Operator, Value: c.Value}
+}
+
+// SLAPolicy converts the condition to an SLA policy filter
+func (c RuleCondition) SLAPolicy() SLAPolicyFilter {
+	return SLAPolicyFilter{Field: c.Field, Operator: c.Operator, Value: c.Value}
+}
+
+// Trigger converts the action to a trigger action
+func (a RuleAction) Trigger() TriggerAction {
+	return TriggerAction{Field: a.Field, Value: a.Value}
+}
+
+// Automation converts the action to an automation action
+func (a RuleAction) Automation() AutomationAction {
+	return AutomationAction{Field: a.Field, Value: a.Value}
+}
+
+// Macro converts the action to a macro action.
+// Macro actions only take a string value.
+func (a RuleAction) Macro() MacroAction {
+	value, ok := a.Value.(string)
+	if !ok {
+		value = fmt.Sprint(a.Value)
+	}
+	return MacroAction{Field: a.Field, Value: value}
+}
+
+// TriggerConditions converts the conditions to trigger conditions, which are used by views too
+func TriggerConditions(conditions ...RuleCondition) []TriggerCondition {
+	result := make([]TriggerCondition, len(conditions))
+	for i, c := range conditions {
+		result[i] = c.Trigger()
+	}
+	return result
+}
+
+// AutomationConditions converts the conditions to automation conditions
+func AutomationConditions(conditions ...RuleCondition) []AutomationCondition {
+	result := make([]AutomationCondition, len(conditions))
+	for i, c := range conditions {
+		result[i] = c.Automation()
+	}
+	return result
+}
+
+// SLAPolicyFilters converts the conditions to SLA policy filters
+func SLAPolicyFilters(conditions ...RuleCondition) []SLAPolicyFilter {
+	result := make([]SLAPolicyFilter, len(conditions))
+	for i, c := range conditions {
+		result[i] = c.SLAPolicy()
+	}
+	return result
+}
+
+// TriggerActions converts the actions to trigger actions
+func TriggerActions(actions ...RuleAction) []TriggerAction {
+	result := make([]TriggerAction, len(actions))
+	for i, a := range actions {
+		result[i] = a.Trigger()
+	}
+	return result
+}
+
+// AutomationActions converts the actions to automation actions
+func AutomationActions(actions ...RuleAction) []AutomationAction {
+	result := make([]AutomationAction, len(actions))
+	for i, a := range actions {
+		result[i] = a.Automation()
+	}
+	return result
+}
+
+// MacroActions converts the actions to macro actions
+func MacroActions(actions ...RuleAction) []MacroAction {
+	result := make([]MacroAction, len(actions))
+	for i, a := range actions {
+		result[i] = a.Macro()
+	}
+	return result
+}
+
+// ruleFieldSpec describes which operators and values a field supports
+// and which kinds of business rules it can be used in
+type ruleFieldSpec struct {
+	operators []string
+	values    []string
+	kinds     []RuleKind
+}
+
+var (
+	changeOperators = []string{
+		OperatorChanged, OperatorChangedTo, OperatorChangedFrom,
+		OperatorNotChanged, OperatorNotChangedTo, OperatorNotChangedFrom,
+	}
+	equalityOperators = []string{OperatorIs, OperatorIsNot}
+	orderedOperators  = []string{OperatorIs, OperatorIsNot, OperatorLessThan, OperatorGreaterThan}
+	hoursOperators    = []string{
+		OperatorIs, OperatorLessThan, OperatorGreaterThan,
+		OperatorIsBusinessHours, OperatorLessThanBusinessHours, OperatorGreaterThanBusinessHours,
+	}
+	customFieldOperators = []string{
+		OperatorIs, OperatorIsNot, OperatorLessThan, OperatorGreaterThan,
+		OperatorPresent, OperatorNotPresent, OperatorIncludes, OperatorNotIncludes,
+	}
+
+	conditionKinds       = []RuleKind{RuleKindTrigger, RuleKindAutomation, RuleKindView, RuleKindSLAPolicy}
+	nonSLAKinds          = []RuleKind{RuleKindTrigger, RuleKindAutomation, RuleKindView}
+	triggerKinds         = []RuleKind{RuleKindTrigger}
+	timeBasedKinds       = []RuleKind{RuleKindAutomation, RuleKindView}
+	ticketActionKinds    = []RuleKind{RuleKindTrigger, RuleKindAutomation, RuleKindMacro}
+	notificationKinds    = []RuleKind{RuleKindTrigger, RuleKindAutomation}
+	macroOnlyKinds       = []RuleKind{RuleKindMacro}
+	ticketStatusValues   = []string{string(StatusNew), string(StatusOpen), string(StatusPending), string(StatusHold), string(StatusSolved), string(StatusClosed)}
+	ticketPriorityValues = []string{"", string(PriorityLow), string(PriorityNormal), string(PriorityHigh), string(PriorityUrgent)}
+	ticketTypeValues     = []string{"", string(TicketTypeQuestion), string(TicketTypeIncident), string(TicketTypeProblem), string(TicketTypeTask)}
+)
+
+var conditionFieldSpecs = map[string]ruleFieldSpec{
+	ConditionFieldText(ConditionFieldStatus):                  {operators: orderedOperators, values: ticketStatusValues, kinds: nonSLAKinds},
+	ConditionFieldText(ConditionFieldPriority):                {operators: orderedOperators, values: ticketPriorityValues, kinds: conditionKinds},
+	ConditionFieldText(ConditionFieldType):                    {operators: equalityOperators, values: ticketTypeValues, kinds: conditionKinds},
+	ConditionFieldText(ConditionFieldGroupID):                 {operators: equalityOperators, kinds: conditionKinds},
+	ConditionFieldText(ConditionFieldAssigneeID):              {operators: equalityOperators, kinds: conditionKinds},
+	ConditionFieldText(ConditionFieldRequesterID):             {operators: equalityOperators, kinds: conditionKinds},
+	ConditionFieldText(ConditionFieldOrganizationID):          {operators: equalityOperators, kinds: conditionKinds},
+	ConditionFieldText(ConditionFieldTicketFormID):            {operators: equalityOperators, kinds: conditionKinds},
+	ConditionFieldText(ConditionFieldBrandID):                 {operators: equalityOperators, kinds: conditionKinds},
+	ConditionFieldText(ConditionFieldCustomStatusID):          {operators: equalityOperators, kinds: nonSLAKinds},
+	ConditionFieldText(ConditionFieldViaID):                   {operators: equalityOperators, kinds: conditionKinds},
+	ConditionFieldText(ConditionFieldCurrentTags):             {operators: []string{OperatorIncludes, OperatorNotIncludes}, kinds: conditionKinds},
+	ConditionFieldText(ConditionFieldSubjectIncludesWord):     {operators: []string{OperatorIncludes, OperatorNotIncludes, OperatorIs, OperatorIsNot}, kinds: nonSLAKinds},
+	ConditionFieldText(ConditionFieldDescriptionIncludesWord): {operators: []string{OperatorIncludes, OperatorNotIncludes, OperatorIs, OperatorIsNot}, kinds: nonSLAKinds},
+	ConditionFieldText(ConditionFieldCommentIncludesWord):     {operators: []string{OperatorIncludes, OperatorNotIncludes, OperatorIs, OperatorIsNot}, kinds: triggerKinds},
+	ConditionFieldText(ConditionFieldUpdateType):              {operators: []string{OperatorIs}, values: []string{UpdateTypeCreate, UpdateTypeChange}, kinds: triggerKinds},
+	ConditionFieldText(ConditionFieldCommentIsPublic):         {operators: []string{OperatorIs}, values: []string{"true", "false", "not_relevant", "requester_can_see_comment"}, kinds: triggerKinds},
+}
+
+var actionFieldSpecs = map[string]ruleFieldSpec{
+	ActionFieldText(ActionFieldStatus):              {values: ticketStatusValues, kinds: ticketActionKinds},
+	ActionFieldText(ActionFieldPriority):            {values: ticketPriorityValues, kinds: ticketActionKinds},
+	ActionFieldText(ActionFieldType):                {values: ticketTypeValues, kinds: ticketActionKinds},
+	ActionFieldText(ActionFieldGroupID):             {kinds: ticketActionKinds},
+	ActionFieldText(ActionFieldAssigneeID):          {kinds: ticketActionKinds},
+	ActionFieldText(ActionFieldSetTags):             {kinds: ticketActionKinds},
+	ActionFieldText(ActionFieldCurrentTags):         {kinds: ticketActionKinds},
+	ActionFieldText(ActionFieldRemoveTags):          {kinds: ticketActionKinds},
+	ActionFieldText(ActionFieldTicketFormID):        {kinds: ticketActionKinds},
+	ActionFieldText(ActionFieldBrandID):             {kinds: ticketActionKinds},
+	ActionFieldText(ActionFieldCustomStatusID):      {kinds: ticketActionKinds},
+	ActionFieldText(ActionFieldCC):                  {kinds: ticketActionKinds},
+	ActionFieldText(ActionFieldLocaleID):            {kinds: notificationKinds},
+	ActionFieldText(ActionFieldSatisfactionScore):   {kinds: notificationKinds},
+	ActionFieldText(ActionFieldNotificationUser):    {kinds: notificationKinds},
+	ActionFieldText(ActionFieldNotificationGroup):   {kinds: notificationKinds},
+	ActionFieldText(ActionFieldNotificationTarget):  {kinds: notificationKinds},
+	ActionFieldText(ActionFieldNotificationWebhook): {kinds: notificationKinds},
+	ActionFieldText(ActionFieldTweetRequester):      {kinds: notificationKinds},
+	ActionFieldText(ActionFieldSubject):             {kinds: macroOnlyKinds},
+	ActionFieldText(ActionFieldCommentValue):        {kinds: macroOnlyKinds},
+	ActionFieldText(ActionFieldCommentValueHTML):    {kinds: macroOnlyKinds},
+	ActionFieldText(ActionFieldCommentModeIsPublic): {kinds: macroOnlyKinds},
+}
+
+// Validate reports whether the condition can be used in the kind of business rule.
+// Conditions of unknown fields are accepted, since not every field is described.
+func (c RuleCondition) Validate(kind RuleKind) error {
+	if kind == RuleKindMacro {
+		return fmt.Errorf("macros don't have conditions")
+	}
+
+	spec, ok := conditionFieldSpecs[c.Field]
+	switch {
+	case ok:
+	case strings.HasPrefix(c.Field, "custom_fields_"):
+		spec = ruleFieldSpec{operators: customFieldOperators, kinds: conditionKinds}
+	case isHoursField(c.Field):
+		spec = ruleFieldSpec{operators: hoursOperators, kinds: timeBasedKinds}
+	default:
+		return nil
+	}
+
+	if !containsRuleKind(spec.kinds, kind) {
+		return fmt.Errorf("field %q can't be used in %s conditions", c.Field, kind)
+	}
+
+	if containsString(changeOperators, c.Operator) {
+		if kind != RuleKindTrigger || isHoursField(c.Field) {
+			return fmt.Errorf("operator %q of field %q can only be used in triggers", c.Operator, c.Field)
+		}
+		if c.Operator == OperatorChanged || c.Operator == OperatorNotChanged {
+			return nil
+		}
+	} else if !containsString(spec.operators, c.Operator) {
+		return fmt.Errorf("operator %q is not supported by field %q", c.Operator, c.Field)
+	}
+
+	if spec.values != nil && !containsString(spec.values, c.Value) {
+		return fmt.Errorf("value %q is not valid for field %q", c.Value, c.Field)
+	}
+	if isHoursField(c.Field) {
+		if _, err := strconv.Atoi(c.Value); err != nil {
+			return fmt.Errorf("value %q of field %q must be a number of hours", c.Value, c.Field)
+		}
+	}
+	return nil
+}
+
+// Validate reports whether the action can be used in the kind of business rule.
+// Actions of unknown fields are accepted, since not every field is described.
+func (a RuleAction) Validate(kind RuleKind) error {
+	if kind == RuleKindView || kind == RuleKindSLAPolicy {
+		return fmt.Errorf("%ss don't have actions", kind)
+	}
+
+	spec, ok := actionFieldSpecs[a.Field]
+	switch {
+	case ok:
+	case strings.HasPrefix(a.Field, "custom_fields_"):
+		spec = ruleFieldSpec{kinds: ticketActionKinds}
+	default:
+		return nil
+	}
+
+	if !containsRuleKind(spec.kinds, kind) {
+		return fmt.Errorf("field %q can't be used in %s actions", a.Field, kind)
+	}
+
+	if spec.values != nil {
+		value, _ := a.Value.(string)
+		if !containsString(spec.values, value) {
+			return fmt.Errorf("value %v is not valid for field %q", a.Value, a.Field)
+		}
+	}
+	return nil
+}
+
+// ValidateTrigger validates the conditions and the actions of the trigger
+func ValidateTrigger(trigger Trigger) error {
+	v := ruleValidator{kind: RuleKindTrigger}
+	for _, conditions := range [][]TriggerCondition{trigger.Conditions.All, trigger.Conditions.Any} {
+		for _, c := range conditions {
+			v.condition(RuleCondition{c.Field, c.Operator, ruleValueString(c.Value)})
+		}
+	}
+	for _, a := range trigger.Actions {
+		v.action(RuleAction{a.Field, a.Value})
+	}
+	return v.err()
+}
+
+// ValidateAutomation validates the conditions and the actions of the automation
+func ValidateAutomation(automation Automation) error {
+	v := ruleValidator{kind: RuleKindAutomation}
+	for _, conditions := range [][]AutomationCondition{automation.Conditions.All, automation.Conditions.Any} {
+		for _, c := range conditions {
+			v.condition(RuleCondition{c.Field, c.Operator, c.Value})
+		}
+	}
+	for _, a := range automation.Actions {
+		v.action(RuleAction{a.Field, a.Value})
+	}
+	return v.err()
+}
+
+// ValidateView validates the conditions of the view
+func ValidateView(view View) error {
+	v := ruleValidator{kind: RuleKindView}
+	for _, conditions := range [][]TriggerCondition{view.Conditions.All, view.Conditions.Any} {
+		for _, c := range conditions {
+			v.condition(RuleCondition{c.Field, c.Operator, ruleValueString(c.Value)})
+		}
+	}
+	return v.err()
+}
+
+// ValidateMacro validates the actions of the macro
+func ValidateMacro(macro Macro) error {
+	v := ruleValidator{kind: RuleKindMacro}
+	for _, a := range macro.Actions {
+		v.action(RuleAction{a.Field, a.Value})
+	}
+	return v.err()
+}
+
+// ValidateSLAPolicy validates the filter of the SLA policy
+func ValidateSLAPolicy(policy SLAPolicy) error {
+	v := ruleValidator{kind: RuleKindSLAPolicy}
+	for _, conditions := range [][]SLAPolicyFilter{policy.Filter.All, policy.Filter.Any} {
+		for _, c := range conditions {
+			v.condition(RuleCondition{c.Field, c.Operator, c.Value})
+		}
+	}
+	return v.err()
+}
+
+// ruleValidator collects the errors of conditions and actions of a business rule
+type ruleValidator struct {
+	kind   RuleKind
+	errors []string
+}
+
+func (v *ruleValidator) condition(c RuleCondition) {
+	if err := c.Validate(v.kind); err != nil {
+		v.errors = append(v.errors, err.Error())
+	}
+}
+
+func (v *ruleValidator) action(a RuleAction) {
+	if err := a.Validate(v.kind); err != nil {
+		v.errors = append(v.errors, err.Error())
+	}
+}
+
+func (v *ruleValidator) err() error {
+	if len(v.errors) == 0 {
+		return nil
+	}
+	return &RuleValidationError{Kind: v.kind, Errors: v.errors}
+}
+
+func customFieldRuleField(fieldID int64) string {
+	return "custom_fields_" + strconv.FormatInt(fieldID, 10)
+}
+
+func isHoursField(field string) bool {
+	switch HoursField(field) {
+	case HoursSinceCreated, HoursSinceOpen, HoursSincePending, HoursSinceSolved, HoursSinceClosed,
+		HoursSinceAssigned, HoursSinceUpdated, HoursSinceRequesterUpdated, HoursSinceAssigneeUpdated,
+		HoursSinceDueDate, HoursUntilDueDate:
+		return true
+	}
+	return false
+}
+
+// ruleValueString returns the string form of a condition value decoded from JSON
+func ruleValueString(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	return fmt.Sprint(value)
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func containsRuleKind(list []RuleKind, kind RuleKind) bool {
+	for _, v := range list {
+		if v == kind {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,738 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type (
+	// TicketUpdate is a ticket update evaluated by EvaluateTriggers
+	TicketUpdate struct {
+		// Ticket is the ticket after the update, before any trigger fires
+		Ticket Ticket
+
+		// Previous is the ticket before the update. It is nil when the ticket is being created.
+		Previous *Ticket
+
+		// Comment is the comment added by the update, if any
+		Comment *TicketComment
+
+		// CurrentUserID and CurrentUserRole describe the user who updates the ticket.
+		// CurrentUserRole is "end_user", "agent" or "admin".
+		CurrentUserID   int64
+		CurrentUserRole string
+
+		// ViaID is the channel the ticket was created through and CurrentViaID is
+		// the channel of the update, such as ViaMail. Note that ViaWebForm is 0.
+		ViaID        int
+		CurrentViaID int
+	}
+
+	// AutomationTicket is a ticket evaluated by EvaluateAutomations
+	AutomationTicket struct {
+		Ticket Ticket
+
+		// Timestamps holds the time of events used by time based conditions, such as
+		// the time the ticket was solved for HoursSinceSolved. HoursSinceCreated,
+		// HoursSinceUpdated, HoursSinceDueDate and HoursUntilDueDate fall back to the ticket.
+		Timestamps map[HoursField]time.Time
+	}
+
+	// TriggerEvaluation is the result of EvaluateTriggers
+	TriggerEvaluation struct {
+		// Ticket is the ticket after all fired triggers are applied
+		Ticket Ticket
+
+		// Fired is the list of fired triggers in the order they fired
+		Fired []Trigger
+
+		// Cycles is the number of times the triggers were checked from the start
+		Cycles int
+
+		// Notifications is the list of actions which don't change the ticket,
+		// such as notification_user, in the order they were performed
+		Notifications []RuleAction
+
+		// Unsupported is the list of conditions and actions which couldn't be evaluated
+		Unsupported []UnsupportedRuleItem
+	}
+
+	// AutomationEvaluation is the result of EvaluateAutomations
+	AutomationEvaluation struct {
+		Ticket        Ticket
+		Fired         []Automation
+		Notifications []RuleAction
+		Unsupported   []UnsupportedRuleItem
+	}
+
+	// UnsupportedRuleItem is a condition or an action the evaluator doesn't support.
+	// A rule is never fired when the result of its conditions depends on an unsupported one.
+	UnsupportedRuleItem struct {
+		RuleID    int64
+		RuleTitle string
+		Field     string
+
+		// Operator is empty for actions
+		Operator string
+	}
+)
+
+// ruleMatch is the result of a condition, which is unknown if the condition is not supported
+type ruleMatch int
+
+const (
+	ruleMatchFalse ruleMatch = iota
+	ruleMatchTrue
+	ruleMatchUnknown
+)
+
+// EvaluateTriggers simulates the triggers run by Zendesk on a ticket update.
+// Active triggers are checked in Position order and the actions of a trigger whose
+// conditions match are applied to the ticket. Whenever a trigger fires, the triggers
+// are checked again from the start, and each trigger fires at most once.
+//
+// ref: https://support.zendesk.com/hc/en-us/articles/4408822236058
+func EvaluateTriggers(triggers []Trigger, update TicketUpdate) TriggerEvaluation {
+	sorted := make([]Trigger, 0, len(triggers))
+	for _, trigger := range triggers {
+		if trigger.Active {
+			sorted = append(sorted, trigger)
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Position < sorted[j].Position
+	})
+
+	state := &ruleState{
+		ticket:   cloneTicket(update.Ticket),
+		previous: update.Previous,
+		update:   &update,
+		userID:   update.CurrentUserID,
+	}
+	report := &ruleReport{}
+	result := TriggerEvaluation{}
+	fired := make(map[int]bool, len(sorted))
+
+	for {
+		result.Cycles++
+		firedInCycle := false
+		for i, trigger := range sorted {
+			if fired[i] {
+				continue
+			}
+
+			var all, any []RuleCondition
+			for _, c := range trigger.Conditions.All {
+				all = append(all, RuleCondition{c.Field, c.Operator, ruleValueString(c.Value)})
+			}
+			for _, c := range trigger.Conditions.Any {
+				any = append(any, RuleCondition{c.Field, c.Operator, ruleValueString(c.Value)})
+			}
+			if !state.matchRule(trigger.ID, trigger.Title, all, any, report) {
+				continue
+			}
+
+			for _, a := range trigger.Actions {
+				state.applyAction(trigger.ID, trigger.Title, RuleAction{a.Field, a.Value}, report)
+			}
+			fired[i] = true
+			result.Fired = append(result.Fired, trigger)
+			firedInCycle = true
+			break
+		}
+
+		if !firedInCycle {
+			break
+		}
+	}
+
+	result.Ticket = state.ticket
+	result.Notifications = state.notifications
+	result.Unsupported = report.items
+	return result
+}
+
+// EvaluateAutomations simulates an hourly run of automations on a ticket at now.
+// Active automations are checked once in Position order, and each automation
+// sees the changes made by the automations fired before it.
+//
+// ref: https://support.zendesk.com/hc/en-us/articles/4408832701850
+func EvaluateAutomations(automations []Automation, ticket AutomationTicket, now time.Time) AutomationEvaluation {
+	sorted := make([]Automation, 0, len(automations))
+	for _, automation := range automations {
+		if automation.Active {
+			sorted = append(sorted, automation)
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Position < sorted[j].Position
+	})
+
+	state := &ruleState{
+		ticket:     cloneTicket(ticket.Ticket),
+		timestamps: ticket.Timestamps,
+		now:        now,
+	}
+	report := &ruleReport{}
+	result := AutomationEvaluation{}
+
+	for _, automation := range sorted {
+		var all, any []RuleCondition
+		for _, c := range automation.Conditions.All {
+			all = append(all, RuleCondition{c.Field, c.Operator, c.Value})
+		}
+		for _, c := range automation.Conditions.Any {
+			any = append(any, RuleCondition{c.Field, c.Operator, c.Value})
+		}
+		if !state.matchRule(automation.ID, automation.Title, all, any, report) {
+			continue
+		}
+
+		for _, a := range automation.Actions {
+			state.applyAction(automation.ID, automation.Title, RuleAction{a.Field, a.Value}, report)
+		}
+		result.Fired = append(result.Fired, automation)
+	}
+
+	result.Ticket = state.ticket
+	result.Notifications = state.notifications
+	result.Unsupported = report.items
+	return result
+}
+
+// ruleState is the ticket being evaluated
+type ruleState struct {
+	ticket   Ticket
+	previous *Ticket
+
+	// update is nil when automations are evaluated
+	update *TicketUpdate
+	userID int64
+
+	timestamps map[HoursField]time.Time
+	now        time.Time
+
+	notifications []RuleAction
+}
+
+// ruleReport collects unsupported conditions and actions without duplicates
+type ruleReport struct {
+	items []UnsupportedRuleItem
+	seen  map[UnsupportedRuleItem]bool
+}
+
+func (r *ruleReport) add(item UnsupportedRuleItem) {
+	if r.seen == nil {
+		r.seen = make(map[UnsupportedRuleItem]bool)
+	}
+	if r.seen[item] {
+		return
+	}
+	r.seen[item] = true
+	r.items = append(r.items, item)
+}
+
+// matchRule reports whether the rule fires. All conditions of all must match and,
+// unless any is empty, one of any must match. Unsupported conditions are reported
+// only when the result depends on them.
+func (s *ruleState) matchRule(id int64, title string, all, any []RuleCondition, report *ruleReport) bool {
+	var unknown []RuleCondition
+
+	allMatch := ruleMatchTrue
+	for _, c := range all {
+		switch s.match(c) {
+		case ruleMatchFalse:
+			return false
+		case ruleMatchUnknown:
+			allMatch = ruleMatchUnknown
+			unknown = append(unknown, c)
+		}
+	}
+
+	anyMatch := ruleMatchTrue
+	if len(any) > 0 {
+		anyMatch = ruleMatchFalse
+		var unknownAny []RuleCondition
+		for _, c := range any {
+			m := s.match(c)
+			if m == ruleMatchTrue {
+				anyMatch = ruleMatchTrue
+				unknownAny = nil
+				break
+			}
+			if m == ruleMatchUnknown {
+				anyMatch = ruleMatchUnknown
+				unknownAny = append(unknownAny, c)
+			}
+		}
+		if anyMatch == ruleMatchFalse {
+			return false
+		}
+		unknown = append(unknown, unknownAny...)
+	}
+
+	if allMatch == ruleMatchTrue && anyMatch == ruleMatchTrue {
+		return true
+	}
+	for _, c := range unknown {
+		report.add(UnsupportedRuleItem{RuleID: id, RuleTitle: title, Field: c.Field, Operator: c.Operator})
+	}
+	return false
+}
+
+// match evaluates a condition against the ticket
+func (s *ruleState) match(c RuleCondition) ruleMatch {
+	switch c.Field {
+	case ConditionFieldText(ConditionFieldCurrentTags):
+		return s.matchWords(c.Operator, strings.Join(s.ticket.Tags, " "), c.Value, false)
+	case ConditionFieldText(ConditionFieldSubjectIncludesWord):
+		return s.matchWords(c.Operator, s.ticket.Subject, c.Value, true)
+	case ConditionFieldText(ConditionFieldDescriptionIncludesWord):
+		return s.matchWords(c.Operator, s.ticket.Description, c.Value, true)
+	case ConditionFieldText(ConditionFieldCommentIncludesWord):
+		if s.update == nil {
+			return ruleMatchUnknown
+		}
+		body := ""
+		if s.update.Comment != nil {
+			body = s.update.Comment.Body
+		}
+		return s.matchWords(c.Operator, body, c.Value, true)
+	case ConditionFieldText(ConditionFieldUpdateType):
+		if s.update == nil || c.Operator != OperatorIs {
+			return ruleMatchUnknown
+		}
+		updateType := UpdateTypeChange
+		if s.update.Previous == nil {
+			updateType = UpdateTypeCreate
+		}
+		return ruleMatchOf(updateType == c.Value)
+	case ConditionFieldText(ConditionFieldCommentIsPublic):
+		return s.matchCommentIsPublic(c)
+	case ConditionFieldText(ConditionFieldTicketIsPublic):
+		if c.Operator != OperatorIs {
+			return ruleMatchUnknown
+		}
+		visibility := "private"
+		if s.ticket.IsPublic {
+			visibility = "public"
+		}
+		return ruleMatchOf(visibility == c.Value)
+	case ConditionFieldText(ConditionFieldRole):
+		if s.update == nil {
+			return ruleMatchUnknown
+		}
+		return matchEquality(c.Operator, s.update.CurrentUserRole, c.Value)
+	case ConditionFieldText(ConditionFieldViaID):
+		if s.update == nil {
+			return ruleMatchUnknown
+		}
+		return matchEquality(c.Operator, strconv.Itoa(s.update.ViaID), c.Value)
+	case ConditionFieldText(ConditionFieldCurrentViaID):
+		if s.update == nil {
+			return ruleMatchUnknown
+		}
+		return matchEquality(c.Operator, strconv.Itoa(s.update.CurrentViaID), c.Value)
+	}
+
+	if isHoursField(c.Field) {
+		return s.matchHours(c)
+	}
+
+	current, ok := ruleTicketValue(s.ticket, c.Field)
+	if !ok {
+		return ruleMatchUnknown
+	}
+	value := s.resolveValue(c.Field, c.Value)
+
+	switch c.Operator {
+	case OperatorIs, OperatorIsNot:
+		return matchEquality(c.Operator, current, value)
+	case OperatorLessThan, OperatorGreaterThan:
+		cmp, ok := compareRuleValues(c.Field, current, value)
+		if !ok {
+			return ruleMatchUnknown
+		}
+		if c.Operator == OperatorLessThan {
+			return ruleMatchOf(cmp < 0)
+		}
+		return ruleMatchOf(cmp > 0)
+	case OperatorPresent:
+		return ruleMatchOf(current != "")
+	case OperatorNotPresent:
+		return ruleMatchOf(current == "")
+	case OperatorIncludes, OperatorNotIncludes:
+		return s.matchWords(c.Operator, current, value, false)
+	}
+
+	if s.update == nil {
+		return ruleMatchUnknown
+	}
+	previous := ""
+	if s.previous != nil {
+		previous, _ = ruleTicketValue(*s.previous, c.Field)
+	}
+	changed := previous != current
+
+	switch c.Operator {
+	case OperatorChanged:
+		return ruleMatchOf(changed)
+	case OperatorNotChanged:
+		return ruleMatchOf(!changed)
+	case OperatorChangedTo:
+		return ruleMatchOf(changed && current == value)
+	case OperatorChangedFrom:
+		return ruleMatchOf(changed && previous == value)
+	case OperatorNotChangedTo:
+		return ruleMatchOf(!(changed && current == value))
+	case OperatorNotChangedFrom:
+		return ruleMatchOf(!(changed && previous == value))
+	}
+	return ruleMatchUnknown
+}
+
+// matchWords evaluates includes and not_includes against space separated words,
+// and is and is_not as containing the string when text is true
+func (s *ruleState) matchWords(operator, subject, value string, text bool) ruleMatch {
+	if text {
+		subject = strings.ToLower(subject)
+		value = strings.ToLower(value)
+	}
+
+	switch operator {
+	case OperatorIncludes, OperatorNotIncludes:
+		words := make(map[string]bool)
+		for _, w := range strings.FieldsFunc(subject, isRuleWordSeparator(text)) {
+			words[w] = true
+		}
+		found := false
+		for _, w := range strings.Fields(value) {
+			if words[w] {
+				found = true
+				break
+			}
+		}
+		if operator == OperatorIncludes {
+			return ruleMatchOf(found)
+		}
+		return ruleMatchOf(!found)
+	case OperatorIs, OperatorIsNot:
+		if !text {
+			return ruleMatchUnknown
+		}
+		contains := strings.Contains(subject, value)
+		if operator == OperatorIs {
+			return ruleMatchOf(contains)
+		}
+		return ruleMatchOf(!contains)
+	}
+	return ruleMatchUnknown
+}
+
+func (s *ruleState) matchCommentIsPublic(c RuleCondition) ruleMatch {
+	if s.update == nil || c.Operator != OperatorIs {
+		return ruleMatchUnknown
+	}
+
+	comment := s.update.Comment
+	public := comment != nil && (comment.Public == nil || *comment.Public)
+	switch c.Value {
+	case "not_relevant":
+		return ruleMatchTrue
+	case "true", "requester_can_see_comment":
+		return ruleMatchOf(public)
+	case "false":
+		return ruleMatchOf(comment != nil && !public)
+	}
+	return ruleMatchUnknown
+}
+
+// matchHours evaluates time based conditions in calendar hours
+func (s *ruleState) matchHours(c RuleCondition) ruleMatch {
+	if s.now.IsZero() {
+		return ruleMatchUnknown
+	}
+
+	hours, err := strconv.Atoi(c.Value)
+	if err != nil {
+		return ruleMatchUnknown
+	}
+
+	field := HoursField(c.Field)
+	t, ok := s.timestamps[field]
+	if !ok {
+		var ts *time.Time
+		switch field {
+		case HoursSinceCreated:
+			ts = s.ticket.CreatedAt
+		case HoursSinceUpdated:
+			ts = s.ticket.UpdatedAt
+		case HoursSinceDueDate, HoursUntilDueDate:
+			ts = s.ticket.DueAt
+		}
+		if ts == nil {
+			return ruleMatchUnknown
+		}
+		t = *ts
+	}
+
+	elapsed := s.now.Sub(t)
+	if field == HoursUntilDueDate {
+		elapsed = -elapsed
+	}
+	actual := int(math.Floor(elapsed.Hours()))
+
+	switch c.Operator {
+	case OperatorIs:
+		return ruleMatchOf(actual == hours)
+	case OperatorLessThan:
+		return ruleMatchOf(actual < hours)
+	case OperatorGreaterThan:
+		return ruleMatchOf(actual > hours)
+	}
+	// business hours depend on the schedule of the account
+	return ruleMatchUnknown
+}
+
+// resolveValue replaces placeholders such as current_user with the ID they refer to
+func (s *ruleState) resolveValue(field, value string) string {
+	if field != ConditionFieldText(ConditionFieldAssigneeID) && field != ConditionFieldText(ConditionFieldRequesterID) {
+		return value
+	}
+
+	switch value {
+	case "current_user":
+		if s.update != nil {
+			return ruleIDString(s.userID)
+		}
+	case "requester_id":
+		return ruleIDString(s.ticket.RequesterID)
+	case "assignee_id":
+		return ruleIDString(s.ticket.AssigneeID)
+	}
+	return value
+}
+
+// applyAction applies an action to the ticket
+func (s *ruleState) applyAction(id int64, title string, a RuleAction, report *ruleReport) {
+	value := ruleValueString(a.Value)
+
+	switch a.Field {
+	case ActionFieldText(ActionFieldStatus):
+		s.ticket.Status = value
+	case ActionFieldText(ActionFieldPriority):
+		s.ticket.Priority = value
+	case ActionFieldText(ActionFieldType):
+		s.ticket.Type = value
+	case ActionFieldText(ActionFieldGroupID):
+		s.ticket.GroupID = json.Number(value)
+	case ActionFieldText(ActionFieldAssigneeID):
+		if value == "current_user" {
+			s.ticket.AssigneeID = s.userID
+		} else if !parseRuleID(value, &s.ticket.AssigneeID) {
+			report.add(UnsupportedRuleItem{RuleID: id, RuleTitle: title, Field: a.Field})
+		}
+	case ActionFieldText(ActionFieldTicketFormID):
+		if !parseRuleID(value, &s.ticket.TicketFormID) {
+			report.add(UnsupportedRuleItem{RuleID: id, RuleTitle: title, Field: a.Field})
+		}
+	case ActionFieldText(ActionFieldBrandID):
+		if !parseRuleID(value, &s.ticket.BrandID) {
+			report.add(UnsupportedRuleItem{RuleID: id, RuleTitle: title, Field: a.Field})
+		}
+	case ActionFieldText(ActionFieldCustomStatusID):
+		if !parseRuleID(value, &s.ticket.CustomStatusID) {
+			report.add(UnsupportedRuleItem{RuleID: id, RuleTitle: title, Field: a.Field})
+		}
+	case ActionFieldText(ActionFieldSetTags):
+		s.ticket.Tags = strings.Fields(value)
+	case ActionFieldText(ActionFieldCurrentTags):
+		for _, tag := range strings.Fields(value) {
+			if !containsString(s.ticket.Tags, tag) {
+				s.ticket.Tags = append(s.ticket.Tags, tag)
+			}
+		}
+	case ActionFieldText(ActionFieldRemoveTags):
+		remove := strings.Fields(value)
+		tags := s.ticket.Tags[:0]
+		for _, tag := range s.ticket.Tags {
+			if !containsString(remove, tag) {
+				tags = append(tags, tag)
+			}
+		}
+		s.ticket.Tags = tags
+	case ActionFieldText(ActionFieldNotificationUser), ActionFieldText(ActionFieldNotificationGroup),
+		ActionFieldText(ActionFieldNotificationTarget), ActionFieldText(ActionFieldNotificationWebhook),
+		ActionFieldText(ActionFieldCC), ActionFieldText(ActionFieldTweetRequester), ActionFieldText(ActionFieldSatisfactionScore):
+		s.notifications = append(s.notifications, a)
+	default:
+		fieldID, ok := customFieldRuleID(a.Field)
+		if !ok {
+			report.add(UnsupportedRuleItem{RuleID: id, RuleTitle: title, Field: a.Field})
+			return
+		}
+		s.setCustomField(fieldID, a.Value)
+	}
+}
+
+func (s *ruleState) setCustomField(fieldID int64, value interface{}) {
+	for i, cf := range s.ticket.CustomFields {
+		if cf.ID == fieldID {
+			s.ticket.CustomFields[i].Value = value
+			return
+		}
+	}
+	s.ticket.CustomFields = append(s.ticket.CustomFields, CustomField{ID: fieldID, Value: value})
+}
+
+// ruleTicketValue returns the value of a ticket field as a string
+func ruleTicketValue(ticket Ticket, field string) (string, bool) {
+	switch field {
+	case ConditionFieldText(ConditionFieldStatus):
+		return ticket.Status, true
+	case ConditionFieldText(ConditionFieldPriority):
+		return ticket.Priority, true
+	case ConditionFieldText(ConditionFieldType):
+		return ticket.Type, true
+	case ConditionFieldText(ConditionFieldGroupID):
+		return ticket.GroupID.String(), true
+	case ConditionFieldText(ConditionFieldAssigneeID):
+		return ruleIDString(ticket.AssigneeID), true
+	case ConditionFieldText(ConditionFieldRequesterID):
+		return ruleIDString(ticket.RequesterID), true
+	case ConditionFieldText(ConditionFieldOrganizationID):
+		return ruleIDString(ticket.OrganizationID), true
+	case ConditionFieldText(ConditionFieldTicketFormID):
+		return ruleIDString(ticket.TicketFormID), true
+	case ConditionFieldText(ConditionFieldBrandID):
+		return ruleIDString(ticket.BrandID), true
+	case ConditionFieldText(ConditionFieldCustomStatusID):
+		return ruleIDString(ticket.CustomStatusID), true
+	}
+
+	fieldID, ok := customFieldRuleID(field)
+	if !ok {
+		return "", false
+	}
+	for _, cf := range ticket.CustomFields {
+		if cf.ID != fieldID {
+			continue
+		}
+		// the options of multi-select fields are matched as space separated words
+		if values, ok := ruleActionStrings(cf.Value); ok {
+			return strings.Join(values, " "), true
+		}
+		return ruleValueString(cf.Value), true
+	}
+	return "", true
+}
+
+// compareRuleValues compares ordered values such as status, priority and numeric custom fields
+func compareRuleValues(field, a, b string) (int, bool) {
+	var order []string
+	switch field {
+	case ConditionFieldText(ConditionFieldStatus):
+		order = ticketStatusValues
+	case ConditionFieldText(ConditionFieldPriority):
+		order = ticketPriorityValues
+	}
+
+	if order != nil {
+		i, j := indexOfString(order, a), indexOfString(order, b)
+		if i < 0 || j < 0 {
+			return 0, false
+		}
+		return i - j, true
+	}
+
+	x, err := strconv.ParseFloat(a, 64)
+	if err != nil {
+		return 0, false
+	}
+	y, err := strconv.ParseFloat(b, 64)
+	if err != nil {
+		return 0, false
+	}
+	switch {
+	case x < y:
+		return -1, true
+	case x > y:
+		return 1, true
+	}
+	return 0, true
+}
+
+func matchEquality(operator, current, value string) ruleMatch {
+	switch operator {
+	case OperatorIs:
+		return ruleMatchOf(current == value)
+	case OperatorIsNot:
+		return ruleMatchOf(current != value)
+	}
+	return ruleMatchUnknown
+}
+
+func ruleMatchOf(b bool) ruleMatch {
+	if b {
+		return ruleMatchTrue
+	}
+	return ruleMatchFalse
+}
+
+func isRuleWordSeparator(text bool) func(rune) bool {
+	return func(r rune) bool {
+		if !text {
+			return r == ' '
+		}
+		return !(r == '_' || r == '-' || r == '\'' || 'a' <= r && r <= 'z' || '0' <= r && r <= '9' || r > 127)
+	}
+}
+
+func customFieldRuleID(field string) (int64, bool) {
+	s := strings.TrimPrefix(field, "custom_fields_")
+	if s == field {
+		return 0, false
+	}
+	id, err := strconv.ParseInt(s, 10, 64)
+	return id, err == nil
+}
+
+func ruleIDString(id int64) string {
+	if id == 0 {
+		return ""
+	}
+	return strconv.FormatInt(id, 10)
+}
+
+func parseRuleID(value string, id *int64) bool {
+	if value == "" {
+		*id = 0
+		return true
+	}
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return false
+	}
+	*id = n
+	return true
+}
+
+func indexOfString(list []string, s string) int {
+	for i, v := range list {
+		if v == s {
+			return i
+		}
+	}
+	return -1
+}
+
+// cloneTicket copies the slices of a ticket changed by actions
+func cloneTicket(ticket Ticket) Ticket {
+	ticket.Tags = append([]string(nil), ticket.Tags...)
+	ticket.CustomFields = append([]CustomField(nil), ticket.CustomFields...)
+	return ticket
+}
@@ -0,0 +1,221 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func readTriggersFixture(t *testing.T) []Trigger {
+	var result struct {
+		Triggers []Trigger `json:"triggers"`
+	}
+	if err := json.Unmarshal(readFixture(filepath.Join(http.MethodGet, "triggers.json")), &result); err != nil {
+		t.Fatalf("Failed to unmarshal triggers fixture: %s", err)
+	}
+	return result.Triggers
+}
+
+func newEvaluatorTrigger(id int64, position int64, conditions []RuleCondition, actions ...RuleAction) Trigger {
+	trigger := Trigger{ID: id, Title: "trigger", Active: true, Position: position}
+	trigger.Conditions.All = TriggerConditions(conditions...)
+	trigger.Actions = TriggerActions(actions...)
+	return trigger
+}
+
+func firedTriggerIDs(triggers []Trigger) []int64 {
+	ids := make([]int64, len(triggers))
+	for i, trigger := range triggers {
+		ids[i] = trigger.ID
+	}
+	return ids
+}
+
+func TestEvaluateTriggersOnCreate(t *testing.T) {
+	update := TicketUpdate{
+		Ticket: Ticket{
+			Status:      "new",
+			RequesterID: 1,
+			AssigneeID:  2,
+			GroupID:     "10",
+			IsPublic:    true,
+		},
+		Comment:         &TicketComment{Body: "help"},
+		CurrentUserID:   1,
+		CurrentUserRole: "end_user",
+	}
+
+	result := EvaluateTriggers(readTriggersFixture(t), update)
+
+	expected := []int64{360056295714, 360056295774, 360056295834}
+	if ids := firedTriggerIDs(result.Fired); !reflect.DeepEqual(ids, expected) {
+		t.Fatalf("expected fired triggers are %v, but got %v", expected, ids)
+	}
+	if result.Cycles != 4 {
+		t.Fatalf("expected cycles is 4, but got %d", result.Cycles)
+	}
+	if len(result.Notifications) != 3 {
+		t.Fatalf("expected length of notifications is 3, but got %d", len(result.Notifications))
+	}
+	if len(result.Unsupported) != 0 {
+		t.Fatalf("expected no unsupported items, but got %+v", result.Unsupported)
+	}
+}
+
+func TestEvaluateTriggersOnChange(t *testing.T) {
+	previous := Ticket{Status: "solved", RequesterID: 1, AssigneeID: 2, GroupID: "10", IsPublic: true}
+	current := previous
+	current.Status = "open"
+
+	update := TicketUpdate{
+		Ticket:          current,
+		Previous:        &previous,
+		Comment:         &TicketComment{Body: "it broke again"},
+		CurrentUserID:   1,
+		CurrentUserRole: "end_user",
+	}
+
+	result := EvaluateTriggers(readTriggersFixture(t), update)
+
+	expected := []int64{360056295794}
+	if ids := firedTriggerIDs(result.Fired); !reflect.DeepEqual(ids, expected) {
+		t.Fatalf("expected fired triggers are %v, but got %v", expected, ids)
+	}
+}
+
+func TestEvaluateTriggersCascade(t *testing.T) {
+	triggers := []Trigger{
+		newEvaluatorTrigger(2, 1,
+			[]RuleCondition{Cond.Type().Is(TicketTypeIncident)},
+			Act.AddTags("vip")),
+		newEvaluatorTrigger(1, 0,
+			[]RuleCondition{Cond.Tags().Includes("vip")},
+			Act.Priority(PriorityUrgent), Act.GroupID(20)),
+	}
+	update := TicketUpdate{
+		Ticket:   Ticket{Type: "incident", Tags: []string{"printer"}},
+		Previous: &Ticket{Tags: []string{"printer"}},
+	}
+
+	result := EvaluateTriggers(triggers, update)
+
+	if ids := firedTriggerIDs(result.Fired); !reflect.DeepEqual(ids, []int64{2, 1}) {
+		t.Fatalf("expected fired triggers are [2 1], but got %v", ids)
+	}
+	if result.Cycles != 3 {
+		t.Fatalf("expected cycles is 3, but got %d", result.Cycles)
+	}
+	if result.Ticket.Priority != "urgent" || result.Ticket.GroupID != "20" {
+		t.Fatalf("unexpected ticket %+v", result.Ticket)
+	}
+	if !reflect.DeepEqual(result.Ticket.Tags, []string{"printer", "vip"}) {
+		t.Fatalf("expected tags are [printer vip], but got %v", result.Ticket.Tags)
+	}
+	if !reflect.DeepEqual(update.Ticket.Tags, []string{"printer"}) {
+		t.Fatalf("input ticket has been modified: %v", update.Ticket.Tags)
+	}
+}
+
+func TestEvaluateTriggersMultiSelectField(t *testing.T) {
+	var result struct {
+		Ticket Ticket `json:"ticket"`
+	}
+	if err := json.Unmarshal(readFixture(filepath.Join(http.MethodGet, "ticket_custom_field.json")), &result); err != nil {
+		t.Fatalf("Failed to unmarshal ticket fixture: %s", err)
+	}
+
+	// the action is decoded from JSON, so it sets the multi-select field to []interface{}
+	var setter Trigger
+	err := json.Unmarshal([]byte(`{
+		"id": 1, "position": 0, "active": true,
+		"conditions": {"all": [{"field": "custom_fields_360005657121", "operator": "includes", "value": "values"}]},
+		"actions": [{"field": "custom_fields_360005657124", "value": ["printer", "scanner"]}]
+	}`), &setter)
+	if err != nil {
+		t.Fatalf("Failed to unmarshal trigger: %s", err)
+	}
+
+	triggers := []Trigger{
+		setter,
+		newEvaluatorTrigger(2, 1, []RuleCondition{Cond.CustomField(360005657124).Includes("scanner")}),
+		newEvaluatorTrigger(3, 2, []RuleCondition{Cond.CustomField(360005657124).NotIncludes("printer")}),
+		newEvaluatorTrigger(4, 3, []RuleCondition{Cond.CustomField(360005657121).NotIncludes("list")}),
+	}
+
+	evaluated := EvaluateTriggers(triggers, TicketUpdate{Ticket: result.Ticket})
+
+	if ids := firedTriggerIDs(evaluated.Fired); !reflect.DeepEqual(ids, []int64{1, 2}) {
+		t.Fatalf("expected fired triggers are [1 2], but got %v", ids)
+	}
+}
+
+func TestEvaluateTriggersUnsupported(t *testing.T) {
+	triggers := []Trigger{
+		newEvaluatorTrigger(1, 0,
+			[]RuleCondition{Cond.TicketIsCreated(), {Field: "recipient", Operator: "is", Value: "support@example.com"}},
+			Act.Status(StatusOpen)),
+		newEvaluatorTrigger(2, 1,
+			[]RuleCondition{Cond.TicketIsUpdated(), {Field: "recipient", Operator: "is", Value: "support@example.com"}},
+			Act.Status(StatusOpen)),
+		newEvaluatorTrigger(3, 2,
+			[]RuleCondition{Cond.Comment().IncludesString("Refund")},
+			Act.LocaleID(1), Act.CustomField(360001, "billing")),
+	}
+	update := TicketUpdate{
+		Ticket:  Ticket{Status: "new"},
+		Comment: &TicketComment{Body: "I want a refund"},
+	}
+
+	result := EvaluateTriggers(triggers, update)
+
+	if ids := firedTriggerIDs(result.Fired); !reflect.DeepEqual(ids, []int64{3}) {
+		t.Fatalf("expected fired triggers are [3], but got %v", ids)
+	}
+
+	expected := []UnsupportedRuleItem{
+		{RuleID: 1, RuleTitle: "trigger", Field: "recipient", Operator: "is"},
+		{RuleID: 3, RuleTitle: "trigger", Field: "locale_id"},
+	}
+	if !reflect.DeepEqual(result.Unsupported, expected) {
+		t.Fatalf("expected unsupported items are %+v, but got %+v", expected, result.Unsupported)
+	}
+
+	fields := result.Ticket.CustomFields
+	if len(fields) != 1 || fields[0].ID != 360001 || fields[0].Value != "billing" {
+		t.Fatalf("unexpected custom fields %+v", fields)
+	}
+}
+
+func TestEvaluateAutomations(t *testing.T) {
+	var result struct {
+		Automations []Automation `json:"automations"`
+	}
+	if err := json.Unmarshal(readFixture(filepath.Join(http.MethodGet, "automations.json")), &result); err != nil {
+		t.Fatalf("Failed to unmarshal automations fixture: %s", err)
+	}
+
+	now := time.Date(2023, 1, 10, 0, 0, 0, 0, time.UTC)
+	ticket := AutomationTicket{
+		Ticket: Ticket{Status: "solved", IsPublic: true},
+		Timestamps: map[HoursField]time.Time{
+			HoursSinceSolved: now.Add(-100 * time.Hour),
+		},
+	}
+
+	evaluation := EvaluateAutomations(result.Automations, ticket, now)
+	if len(evaluation.Fired) != 1 || evaluation.Fired[0].ID != 360017421099 {
+		t.Fatalf("unexpected fired automations %+v", evaluation.Fired)
+	}
+	if evaluation.Ticket.Status != "closed" {
+		t.Fatalf("expected status is closed, but got %s", evaluation.Ticket.Status)
+	}
+
+	ticket.Timestamps[HoursSinceSolved] = now.Add(-90 * time.Hour)
+	evaluation = EvaluateAutomations(result.Automations, ticket, now)
+	if len(evaluation.Fired) != 0 {
+		t.Fatalf("expected no fired automations, but got %+v", evaluation.Fired)
+	}
+}
@@ -0,0 +1,150 @@
+package zendesk
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestConditionBuilder(t *testing.T) {
+	cases := []struct {
+		got      RuleCondition
+		expected RuleCondition
+	}{
+		{Cond.Status().Is(StatusOpen), RuleCondition{"status", "is", "open"}},
+		{Cond.Status().LessThan(StatusSolved), RuleCondition{"status", "less_than", "solved"}},
+		{Cond.Priority().ChangedTo(PriorityUrgent), RuleCondition{"priority", "value", "urgent"}},
+		{Cond.Type().IsNot(TicketTypeTask), RuleCondition{"type", "is_not", "task"}},
+		{Cond.GroupID().Is(123), RuleCondition{"group_id", "is", "123"}},
+		{Cond.GroupID().IsNone(), RuleCondition{"group_id", "is", ""}},
+		{Cond.AssigneeID().IsCurrentUser(), RuleCondition{"assignee_id", "is", "current_user"}},
+		{Cond.Via().Is(ViaMail), RuleCondition{"via_id", "is", "4"}},
+		{Cond.CustomField(360001).Present(), RuleCondition{"custom_fields_360001", "present", ""}},
+		{Cond.CustomField(360001).GreaterThan("10"), RuleCondition{"custom_fields_360001", "greater_than", "10"}},
+		{Cond.Tags().Includes("vip", "gold"), RuleCondition{"current_tags", "includes", "vip gold"}},
+		{Cond.Comment().IncludesWords("refund"), RuleCondition{"comment_includes_word", "includes", "refund"}},
+		{Cond.Hours(HoursSinceSolved).GreaterThan(96), RuleCondition{"SOLVED", "greater_than", "96"}},
+		{Cond.Hours(HoursSincePending).IsBusinessHours(24), RuleCondition{"PENDING", "is_business_hours", "24"}},
+		{Cond.TicketIsCreated(), RuleCondition{"update_type", "is", "Create"}},
+		{Cond.CommentIsPublic(true), RuleCondition{"comment_is_public", "is", "true"}},
+	}
+
+	for _, c := range cases {
+		if c.got != c.expected {
+			t.Errorf("expected %+v, but got %+v", c.expected, c.got)
+		}
+	}
+}
+
+func TestActionBuilder(t *testing.T) {
+	cases := []struct {
+		got      RuleAction
+		expected RuleAction
+	}{
+		{Act.Status(StatusSolved), RuleAction{"status", "solved"}},
+		{Act.AddTags("vip"), RuleAction{"current_tags", "vip"}},
+		{Act.SetTags("a", "b"), RuleAction{"set_tags", "a b"}},
+		{Act.GroupID(123), RuleAction{"group_id", "123"}},
+		{Act.CustomField(360001, "yes"), RuleAction{"custom_fields_360001", "yes"}},
+		{Act.NotifyUser(NotifyRequester, "Hi", "Body"), RuleAction{"notification_user", []string{"requester_id", "Hi", "Body"}}},
+		{Act.NotifyWebhook("01GB", "{}"), RuleAction{"notification_webhook", []string{"01GB", "{}"}}},
+		{Act.CommentIsPublic(false), RuleAction{"comment_mode_is_public", "false"}},
+	}
+
+	for _, c := range cases {
+		if !reflect.DeepEqual(c.got, c.expected) {
+			t.Errorf("expected %+v, but got %+v", c.expected, c.got)
+		}
+	}
+}
+
+func TestRuleConversions(t *testing.T) {
+	trigger := Trigger{Title: "Escalate VIP"}
+	trigger.Conditions.All = TriggerConditions(Cond.TicketIsCreated(), Cond.Tags().Includes("vip"))
+	trigger.Actions = TriggerActions(Act.Priority(PriorityUrgent), Act.AddTags("escalated"))
+
+	if trigger.Conditions.All[1] != (TriggerCondition{Field: "current_tags", Operator: "includes", Value: "vip"}) {
+		t.Fatalf("unexpected trigger condition: %+v", trigger.Conditions.All[1])
+	}
+	if err := ValidateTrigger(trigger); err != nil {
+		t.Fatalf("expected valid trigger, but got %s", err)
+	}
+
+	automation := Automation{Title: "Close solved"}
+	automation.Conditions.All = AutomationConditions(Cond.Status().Is(StatusSolved), Cond.Hours(HoursSinceSolved).GreaterThan(96))
+	automation.Actions = AutomationActions(Act.Status(StatusClosed))
+	if err := ValidateAutomation(automation); err != nil {
+		t.Fatalf("expected valid automation, but got %s", err)
+	}
+
+	macro := Macro{Title: "Reply", Actions: MacroActions(Act.Comment("Thanks"), Act.SetTags("a", "b"))}
+	if macro.Actions[1] != (MacroAction{Field: "set_tags", Value: "a b"}) {
+		t.Fatalf("unexpected macro action: %+v", macro.Actions[1])
+	}
+	if err := ValidateMacro(macro); err != nil {
+		t.Fatalf("expected valid macro, but got %s", err)
+	}
+
+	policy := SLAPolicy{Title: "Urgent"}
+	policy.Filter.All = SLAPolicyFilters(Cond.Priority().Is(PriorityUrgent))
+	if err := ValidateSLAPolicy(policy); err != nil {
+		t.Fatalf("expected valid SLA policy, but got %s", err)
+	}
+
+	view := View{Conditions: ViewConditions{All: TriggerConditions(Cond.Status().LessThan(StatusSolved), Cond.AssigneeID().IsCurrentUser())}}
+	if err := ValidateView(view); err != nil {
+		t.Fatalf("expected valid view, but got %s", err)
+	}
+}
+
+func TestRuleValidation(t *testing.T) {
+	cases := []struct {
+		name  string
+		err   error
+		valid bool
+	}{
+		{"unsupported operator", RuleCondition{"current_tags", "is", "vip"}.Validate(RuleKindTrigger), false},
+		{"invalid status", RuleCondition{"status", "is", "archived"}.Validate(RuleKindTrigger), false},
+		{"change operator in view", Cond.Status().Changed().Validate(RuleKindView), false},
+		{"change operator in trigger", Cond.Status().Changed().Validate(RuleKindTrigger), true},
+		{"update type in automation", Cond.TicketIsUpdated().Validate(RuleKindAutomation), false},
+		{"hours in trigger", Cond.Hours(HoursSinceOpen).Is(1).Validate(RuleKindTrigger), false},
+		{"hours not a number", RuleCondition{"OPEN", "is", "soon"}.Validate(RuleKindAutomation), false},
+		{"macro condition", Cond.Status().Is(StatusOpen).Validate(RuleKindMacro), false},
+		{"unknown field", RuleCondition{"satisfaction_score", "is", "good"}.Validate(RuleKindTrigger), true},
+		{"comment in trigger action", Act.Comment("hi").Validate(RuleKindTrigger), false},
+		{"notification in macro", Act.NotifyUser(NotifyAssignee, "s", "b").Validate(RuleKindMacro), false},
+		{"view action", Act.Status(StatusOpen).Validate(RuleKindView), false},
+		{"invalid priority action", RuleAction{"priority", "critical"}.Validate(RuleKindMacro), false},
+	}
+
+	for _, c := range cases {
+		if (c.err == nil) != c.valid {
+			t.Errorf("%s: expected valid to be %v, but got error %v", c.name, c.valid, c.err)
+		}
+	}
+}
+
+func TestValidateTriggerCollectsErrors(t *testing.T) {
+	trigger := Trigger{Title: "Broken"}
+	trigger.Conditions.All = []TriggerCondition{
+		{Field: "status", Operator: "includes", Value: "open"},
+		{Field: "group_id", Operator: "is", Value: float64(123)},
+	}
+	trigger.Conditions.Any = []TriggerCondition{{Field: "NEW", Operator: "greater_than", Value: "2"}}
+	trigger.Actions = []TriggerAction{{Field: "comment_value", Value: "hi"}}
+
+	err := ValidateTrigger(trigger)
+
+	var verr *RuleValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("expected a RuleValidationError, but got %v", err)
+	}
+	if verr.Kind != RuleKindTrigger || len(verr.Errors) != 3 {
+		t.Fatalf("unexpected errors: %v", verr.Errors)
+	}
+	if !strings.Contains(err.Error(), `"comment_value"`) {
+		t.Fatalf("expected the error to mention comment_value, but got %s", err)
+	}
+}
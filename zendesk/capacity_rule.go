@@ -0,0 +1,126 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+type (
+	// CapacityRule is struct for omnichannel routing capacity rule payload.
+	// A capacity rule limits the number of work items assigned to its agents per channel.
+	// https://developer.zendesk.com/api-reference/agent-availability/capacity-rules/
+	CapacityRule struct {
+		ID          string            `json:"id,omitempty"`
+		URL         string            `json:"url,omitempty"`
+		Name        string            `json:"name"`
+		Description string            `json:"description,omitempty"`
+		Default     bool              `json:"default,omitempty"`
+		Capacities  []ChannelCapacity `json:"capacities"`
+		AgentCount  int64             `json:"agent_count,omitempty"`
+		AgentIDs    []int64           `json:"agent_ids,omitempty"`
+		CreatedAt   time.Time         `json:"created_at,omitempty"`
+		UpdatedAt   time.Time         `json:"updated_at,omitempty"`
+	}
+
+	// ChannelCapacity is the maximum number of work items of a channel
+	ChannelCapacity struct {
+		Channel string `json:"channel"`
+		Max     int64  `json:"max"`
+	}
+
+	// CapacityRuleAPI is an interface containing capacity rule related methods
+	CapacityRuleAPI interface {
+		GetCapacityRules(ctx context.Context) ([]CapacityRule, error)
+		GetCapacityRule(ctx context.Context, ruleID string) (CapacityRule, error)
+		CreateCapacityRule(ctx context.Context, rule CapacityRule) (CapacityRule, error)
+		UpdateCapacityRule(ctx context.Context, ruleID string, rule CapacityRule) (CapacityRule, error)
+		DeleteCapacityRule(ctx context.Context, ruleID string) error
+	}
+)
+
+// GetCapacityRules fetches all capacity rules
+// ref: https://developer.zendesk.com/api-reference/agent-availability/capacity-rules/#list-capacity-rules
+func (z *Client) GetCapacityRules(ctx context.Context) ([]CapacityRule, error) {
+	var result struct {
+		CapacityRules []CapacityRule `json:"capacity_rules"`
+	}
+
+	body, err := z.get(ctx, "/routing/capacity_rules.json")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, err
+	}
+	return result.CapacityRules, nil
+}
+
+// GetCapacityRule gets the specified capacity rule
+// ref: https://developer.zendesk.com/api-reference/agent-availability/capacity-rules/#show-capacity-rule
+func (z *Client) GetCapacityRule(ctx context.Context, ruleID string) (CapacityRule, error) {
+	var result struct {
+		CapacityRule CapacityRule `json:"capacity_rule"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/routing/capacity_rules/%s.json", ruleID))
+	if err != nil {
+		return CapacityRule{}, err
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return CapacityRule{}, err
+	}
+	return result.CapacityRule, nil
+}
+
+// CreateCapacityRule creates new capacity rule
+// ref: https://developer.zendesk.com/api-reference/agent-availability/capacity-rules/#create-capacity-rule
+func (z *Client) CreateCapacityRule(ctx context.Context, rule CapacityRule) (CapacityRule, error) {
+	var data, result struct {
+		CapacityRule CapacityRule `json:"capacity_rule"`
+	}
+	data.CapacityRule = rule
+
+	body, err := z.post(ctx, "/routing/capacity_rules.json", data)
+	if err != nil {
+		return CapacityRule{}, err
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return CapacityRule{}, err
+	}
+	return result.CapacityRule, nil
+}
+
+// UpdateCapacityRule updates the specified capacity rule
+// ref: https://developer.zendesk.com/api-reference/agent-availability/capacity-rules/#update-capacity-rule
+func (z *Client) UpdateCapacityRule(ctx context.Context, ruleID string, rule CapacityRule) (CapacityRule, error) {
+	var data, result struct {
+		CapacityRule CapacityRule `json:"capacity_rule"`
+	}
+	data.CapacityRule = rule
+
+	body, err := z.put(ctx, fmt.Sprintf("/routing/capacity_rules/%s.json", ruleID), data)
+	if err != nil {
+		return CapacityRule{}, err
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return CapacityRule{}, err
+	}
+	return result.CapacityRule, nil
+}
+
+// DeleteCapacityRule deletes the specified capacity rule
+// ref: https://developer.zendesk.com/api-reference/agent-availability/capacity-rules/#delete-capacity-rule
+func (z *Client) DeleteCapacityRule(ctx context.Context, ruleID string) error {
+	err := z.delete(ctx, fmt.Sprintf("/routing/capacity_rules/%s.json", ruleID))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetCapacityRules(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "capacity_rules.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	rules, err := client.GetCapacityRules(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get capacity rules: %s", err)
+	}
+
+	if len(rules) != 1 {
+		t.Fatalf("expected length of capacity rules is 1, but got %d", len(rules))
+	}
+
+	if len(rules[0].Capacities) != 2 {
+		t.Fatalf("expected length of capacities is 2, but got %d", len(rules[0].Capacities))
+	}
+}
+
+func TestGetCapacityRule(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "capacity_rule.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	rule, err := client.GetCapacityRule(ctx, "01HG80BPNSNHX2XSN9E9AM10BK")
+	if err != nil {
+		t.Fatalf("Failed to get capacity rule: %s", err)
+	}
+
+	if !rule.Default {
+		t.Fatal("expected capacity rule to be default")
+	}
+}
+
+func TestCreateCapacityRule(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodPost, "capacity_rule.json", http.StatusCreated)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.CreateCapacityRule(ctx, CapacityRule{
+		Name:       "Default",
+		Capacities: []ChannelCapacity{{Channel: AgentChannelMessaging, Max: 3}},
+	})
+	if err != nil {
+		t.Fatalf("Failed to send request to create capacity rule: %s", err)
+	}
+}
+
+func TestUpdateCapacityRule(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodPut, "capacity_rule.json", http.StatusOK)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.UpdateCapacityRule(ctx, "01HG80BPNSNHX2XSN9E9AM10BK", CapacityRule{Name: "Default"})
+	if err != nil {
+		t.Fatalf("Failed to send request to update capacity rule: %s", err)
+	}
+}
+
+func TestDeleteCapacityRule(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+		w.Write(nil)
+	}))
+
+	c := newTestClient(mockAPI)
+	err := c.DeleteCapacityRule(ctx, "01HG80BPNSNHX2XSN9E9AM10BK")
+	if err != nil {
+		t.Fatalf("Failed to delete capacity rule: %s", err)
+	}
+}
@@ -0,0 +1,120 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Category is struct for Help Center category payload
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/categories/
+type Category struct {
+	ID           int64      `json:"id,omitempty"`
+	URL          string     `json:"url,omitempty"`
+	HTMLURL      string     `json:"html_url,omitempty"`
+	Name         string     `json:"name"`
+	Description  string     `json:"description,omitempty"`
+	Locale       string     `json:"locale,omitempty"`
+	SourceLocale string     `json:"source_locale,omitempty"`
+	Position     int64      `json:"position,omitempty"`
+	Outdated     bool       `json:"outdated,omitempty"`
+	CreatedAt    *time.Time `json:"created_at,omitempty"`
+	UpdatedAt    *time.Time `json:"updated_at,omitempty"`
+}
+
+// CategoryAPI an interface containing all Help Center category related methods
+type CategoryAPI interface {
+	GetCategory(ctx context.Context, locale int, categoryID int64) (Category, error)
+	CreateCategory(ctx context.Context, locale int, category Category) (Category, error)
+	UpdateCategory(ctx context.Context, locale int, categoryID int64, category Category) (Category, error)
+	DeleteCategory(ctx context.Context, categoryID int64) error
+	GetCategoriesIterator(ctx context.Context, locale int, opts *PaginationOptions) *Iterator[Category]
+	GetCategoriesOBP(ctx context.Context, locale int, opts *OBPOptions) ([]Category, Page, error)
+	GetCategoriesCBP(ctx context.Context, locale int, opts *CBPOptions) ([]Category, CursorPaginationMeta, error)
+}
+
+// GetCategory gets the specified category in the locale, or in the default locale if it's zero
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/categories/#show-category
+func (z *Client) GetCategory(ctx context.Context, locale int, categoryID int64) (Category, error) {
+	var result struct {
+		Category Category `json:"category"`
+	}
+
+	path, err := helpCenterPath(locale, fmt.Sprintf("/categories/%d.json", categoryID))
+	if err != nil {
+		return Category{}, err
+	}
+
+	body, err := z.get(ctx, path)
+	if err != nil {
+		return Category{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Category{}, err
+	}
+	return result.Category, nil
+}
+
+// CreateCategory creates a category. The name and the description are those of the locale.
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/categories/#create-category
+func (z *Client) CreateCategory(ctx context.Context, locale int, category Category) (Category, error) {
+	var data, result struct {
+		Category Category `json:"category"`
+	}
+	data.Category = category
+
+	path, err := helpCenterPath(locale, "/categories.json")
+	if err != nil {
+		return Category{}, err
+	}
+
+	body, err := z.post(ctx, path, data)
+	if err != nil {
+		return Category{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Category{}, err
+	}
+	return result.Category, nil
+}
+
+// UpdateCategory updates the specified category
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/categories/#update-category
+func (z *Client) UpdateCategory(ctx context.Context, locale int, categoryID int64, category Category) (Category, error) {
+	var data, result struct {
+		Category Category `json:"category"`
+	}
+	data.Category = category
+
+	path, err := helpCenterPath(locale, fmt.Sprintf("/categories/%d.json", categoryID))
+	if err != nil {
+		return Category{}, err
+	}
+
+	body, err := z.put(ctx, path, data)
+	if err != nil {
+		return Category{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Category{}, err
+	}
+	return result.Category, nil
+}
+
+// DeleteCategory deletes the specified category with all its sections and articles
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/categories/#delete-category
+func (z *Client) DeleteCategory(ctx context.Context, categoryID int64) error {
+	return z.delete(ctx, fmt.Sprintf("/help_center/categories/%d.json", categoryID))
+}
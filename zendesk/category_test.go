@@ -0,0 +1,53 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+)
+
+func TestGetCategoriesIterator(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/help_center/de/categories.json" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write(readFixture(filepath.Join(http.MethodGet, "categories.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	opts := NewPaginationOptions()
+	it := client.GetCategoriesIterator(ctx, LocaleDE, opts)
+
+	count := 0
+	for it.HasMore() {
+		categories, err := it.GetNext()
+		if err != nil {
+			t.Fatalf("Failed to get categories: %s", err)
+		}
+		count += len(categories)
+	}
+	if count != 1 {
+		t.Fatalf("expected length of categories is 1, but got %d", count)
+	}
+}
+
+func TestCreateCategory(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodPost, "category.json", http.StatusCreated)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	category, err := client.CreateCategory(ctx, LocaleENUS, Category{
+		Name:        "Developers",
+		Description: "API and integrations",
+	})
+	if err != nil {
+		t.Fatalf("Failed to create category: %s", err)
+	}
+
+	if category.ID != 360000054322 {
+		t.Fatalf("expected id is 360000054322, but got %d", category.ID)
+	}
+}
@@ -83,6 +83,14 @@ const (
 	ConditionFieldDueDate
 	// ConditionFieldUntilDueDate until_due_date
 	ConditionFieldUntilDueDate
+	// ConditionFieldRole role
+	ConditionFieldRole
+	// ConditionFieldTicketFormID ticket_form_id
+	ConditionFieldTicketFormID
+	// ConditionFieldBrandID brand_id
+	ConditionFieldBrandID
+	// ConditionFieldCustomStatusID custom_status_id
+	ConditionFieldCustomStatusID
 )
 
 var conditionFieldText = map[int]string{
@@ -126,6 +134,10 @@ var conditionFieldText = map[int]string{
 	ConditionFieldAssigneeUpdatedAt:              "assignee_updated_at",
 	ConditionFieldDueDate:                        "due_date",
 	ConditionFieldUntilDueDate:                   "until_due_date",
+	ConditionFieldRole:                           "role",
+	ConditionFieldTicketFormID:                   "ticket_form_id",
+	ConditionFieldBrandID:                        "brand_id",
+	ConditionFieldCustomStatusID:                 "custom_status_id",
 }
 
 // ConditionFieldText takes field type and returns field name string
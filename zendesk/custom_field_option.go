@@ -1,5 +1,10 @@
 package zendesk
 
+import (
+	"context"
+	"encoding/json"
+)
+
 // CustomFieldOption is struct for value of `custom_field_options`
 type CustomFieldOption struct {
 	ID       int64  `json:"id,omitempty"`
@@ -10,7 +15,8 @@ type CustomFieldOption struct {
 	Value    string `json:"value"`
 }
 
-type relationshipFilterObject struct {
+// RelationshipFilterObject is a condition of `relationship_filter`
+type RelationshipFilterObject struct {
 	Field    string `json:"field"`
 	Operator string `json:"operator"`
 	Value    string `json:"value"`
@@ -18,6 +24,44 @@ type relationshipFilterObject struct {
 
 // RelationshipFilter is struct for value of `relationship_filter`
 type RelationshipFilter struct {
-	All []relationshipFilterObject `json:"all"`
-	Any []relationshipFilterObject `json:"any"`
+	All []RelationshipFilterObject `json:"all"`
+	Any []RelationshipFilterObject `json:"any"`
+}
+
+// getCustomFieldOption gets the option of a ticket, user or organization field
+func (z *Client) getCustomFieldOption(ctx context.Context, path string) (CustomFieldOption, error) {
+	var result struct {
+		CustomFieldOption CustomFieldOption `json:"custom_field_option"`
+	}
+
+	body, err := z.get(ctx, path)
+	if err != nil {
+		return CustomFieldOption{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return CustomFieldOption{}, err
+	}
+	return result.CustomFieldOption, nil
+}
+
+// createOrUpdateCustomFieldOption creates the option of a ticket, user or organization field,
+// or updates it when the ID of the option is set
+func (z *Client) createOrUpdateCustomFieldOption(ctx context.Context, path string, option CustomFieldOption) (CustomFieldOption, error) {
+	var data, result struct {
+		CustomFieldOption CustomFieldOption `json:"custom_field_option"`
+	}
+	data.CustomFieldOption = option
+
+	body, err := z.post(ctx, path, data)
+	if err != nil {
+		return CustomFieldOption{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return CustomFieldOption{}, err
+	}
+	return result.CustomFieldOption, nil
 }
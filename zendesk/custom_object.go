@@ -7,6 +7,32 @@ import (
 	"time"
 )
 
+// CustomObject is struct for custom object definition payload
+// https://developer.zendesk.com/api-reference/custom-objects/custom_objects/
+type CustomObject struct {
+	URL                string    `json:"url,omitempty"`
+	Key                string    `json:"key"`
+	Title              string    `json:"title"`
+	RawTitle           string    `json:"raw_title,omitempty"`
+	TitlePluralized    string    `json:"title_pluralized"`
+	RawTitlePluralized string    `json:"raw_title_pluralized,omitempty"`
+	Description        string    `json:"description,omitempty"`
+	RawDescription     string    `json:"raw_description,omitempty"`
+	IncludeInListView  bool      `json:"include_in_list_view,omitempty"`
+	AllowsPhotos       bool      `json:"allows_photos,omitempty"`
+	AllowsAttachments  bool      `json:"allows_attachments,omitempty"`
+	CreatedByUserID    string    `json:"created_by_user_id,omitempty"`
+	UpdatedByUserID    string    `json:"updated_by_user_id,omitempty"`
+	CreatedAt          time.Time `json:"created_at,omitempty"`
+	UpdatedAt          time.Time `json:"updated_at,omitempty"`
+}
+
+// CustomObjectLimit is the usage and the limit of custom objects or fields of the account
+type CustomObjectLimit struct {
+	Count int64 `json:"count"`
+	Limit int64 `json:"limit"`
+}
+
 type CustomObjectRecord struct {
 	Url                string                 `json:"url,omitempty"`
 	Name               string                 `json:"name,omitempty"`
@@ -22,6 +48,12 @@ type CustomObjectRecord struct {
 
 // CustomObjectAPI an interface containing all custom object related methods
 type CustomObjectAPI interface {
+	GetCustomObjects(ctx context.Context) ([]CustomObject, error)
+	GetCustomObject(ctx context.Context, customObjectKey string) (CustomObject, error)
+	CreateCustomObject(ctx context.Context, customObject CustomObject) (CustomObject, error)
+	UpdateCustomObject(ctx context.Context, customObjectKey string, customObject CustomObject) (CustomObject, error)
+	DeleteCustomObject(ctx context.Context, customObjectKey string) error
+	GetCustomObjectLimit(ctx context.Context) (CustomObjectLimit, error)
 	CreateCustomObjectRecord(
 		ctx context.Context, record CustomObjectRecord, customObjectKey string) (CustomObjectRecord, error)
 	AutocompleteSearchCustomObjectRecords(
@@ -40,6 +72,65 @@ type CustomObjectAPI interface {
 	UpdateCustomObjectRecord(
 		ctx context.Context, customObjectKey string, customObjectRecordID string, record CustomObjectRecord,
 	) (*CustomObjectRecord, error)
+	UpsertCustomObjectRecord(
+		ctx context.Context, customObjectKey string, externalID string, record CustomObjectRecord,
+	) (*CustomObjectRecord, error)
+	DeleteCustomObjectRecord(ctx context.Context, customObjectKey string, customObjectRecordID string) error
+	DeleteCustomObjectRecordByExternalID(ctx context.Context, customObjectKey string, externalID string) error
+	FilteredSearchCustomObjectRecords(
+		ctx context.Context, customObjectKey string, filter CustomObjectRecordFilter, opts *CBPOptions,
+	) ([]CustomObjectRecord, CursorPaginationMeta, error)
+	FilteredSearchCustomObjectRecordsIterator(
+		ctx context.Context, customObjectKey string, filter CustomObjectRecordFilter, opts *PaginationOptions,
+	) *Iterator[CustomObjectRecord]
+	CountCustomObjectRecords(ctx context.Context, customObjectKey string) (CustomObjectRecordCount, error)
+	GetCustomObjectRecordsCBP(
+		ctx context.Context, customObjectKey string, opts *CBPOptions,
+	) ([]CustomObjectRecord, CursorPaginationMeta, error)
+	GetCustomObjectRecordsIterator(
+		ctx context.Context, customObjectKey string, opts *PaginationOptions,
+	) *Iterator[CustomObjectRecord]
+	CreateManyCustomObjectRecords(
+		ctx context.Context, customObjectKey string, records []CustomObjectRecord,
+	) (JobStatus, error)
+	UpdateManyCustomObjectRecords(
+		ctx context.Context, customObjectKey string, records []CustomObjectRecord,
+	) (JobStatus, error)
+	CreateOrUpdateManyCustomObjectRecords(
+		ctx context.Context, customObjectKey string, records []CustomObjectRecord,
+	) (JobStatus, error)
+	DeleteManyCustomObjectRecords(
+		ctx context.Context, customObjectKey string, customObjectRecordIDs []string,
+	) (JobStatus, error)
+	DeleteManyCustomObjectRecordsByExternalID(
+		ctx context.Context, customObjectKey string, externalIDs []string,
+	) (JobStatus, error)
+}
+
+// Custom object record bulk job actions
+// https://developer.zendesk.com/api-reference/custom-objects/custom_object_records/#custom-object-record-bulk-jobs
+const (
+	CustomObjectRecordJobCreate             = "create"
+	CustomObjectRecordJobUpdate             = "update"
+	CustomObjectRecordJobCreateOrUpdate     = "create_or_update"
+	CustomObjectRecordJobDelete             = "delete"
+	CustomObjectRecordJobDeleteByExternalID = "delete_by_external_id"
+)
+
+// CustomObjectRecordFilter is the filter of a filtered search, such as
+//
+//	CustomObjectRecordFilter{
+//		"custom_object_fields.status": map[string]interface{}{"$eq": "in_use"},
+//	}
+//
+// https://developer.zendesk.com/api-reference/custom-objects/custom_object_records/#filtered-search-of-custom-object-records
+type CustomObjectRecordFilter map[string]interface{}
+
+// CustomObjectRecordCount is the number of records of a custom object.
+// The value is cached and refreshed periodically.
+type CustomObjectRecordCount struct {
+	Value       int64     `json:"value"`
+	RefreshedAt time.Time `json:"refreshed_at"`
 }
 
 // CustomObjectAutocompleteOptions custom object search options
@@ -48,6 +139,110 @@ type CustomObjectAutocompleteOptions struct {
 	Name string `url:"name"`
 }
 
+// GetCustomObjects lists the custom object definitions of the account
+// https://developer.zendesk.com/api-reference/custom-objects/custom_objects/#list-custom-objects
+func (z *Client) GetCustomObjects(ctx context.Context) ([]CustomObject, error) {
+	var result struct {
+		CustomObjects []CustomObject `json:"custom_objects"`
+	}
+
+	body, err := z.get(ctx, "/custom_objects")
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.CustomObjects, nil
+}
+
+// GetCustomObject returns the custom object definition of the specified key
+// https://developer.zendesk.com/api-reference/custom-objects/custom_objects/#show-custom-object
+func (z *Client) GetCustomObject(ctx context.Context, customObjectKey string) (CustomObject, error) {
+	var result struct {
+		CustomObject CustomObject `json:"custom_object"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/custom_objects/%s", customObjectKey))
+	if err != nil {
+		return CustomObject{}, err
+	}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return CustomObject{}, err
+	}
+	return result.CustomObject, nil
+}
+
+// CreateCustomObject creates a custom object definition.
+// Key cannot be changed once the custom object is created.
+// https://developer.zendesk.com/api-reference/custom-objects/custom_objects/#create-custom-object
+func (z *Client) CreateCustomObject(ctx context.Context, customObject CustomObject) (CustomObject, error) {
+	var data, result struct {
+		CustomObject CustomObject `json:"custom_object"`
+	}
+	data.CustomObject = customObject
+
+	body, err := z.post(ctx, "/custom_objects", data)
+	if err != nil {
+		return CustomObject{}, err
+	}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return CustomObject{}, err
+	}
+	return result.CustomObject, nil
+}
+
+// UpdateCustomObject updates the custom object definition of the specified key
+// https://developer.zendesk.com/api-reference/custom-objects/custom_objects/#update-custom-object
+func (z *Client) UpdateCustomObject(
+	ctx context.Context, customObjectKey string, customObject CustomObject,
+) (CustomObject, error) {
+	var data, result struct {
+		CustomObject CustomObject `json:"custom_object"`
+	}
+	data.CustomObject = customObject
+
+	body, err := z.patch(ctx, fmt.Sprintf("/custom_objects/%s", customObjectKey), data)
+	if err != nil {
+		return CustomObject{}, err
+	}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return CustomObject{}, err
+	}
+	return result.CustomObject, nil
+}
+
+// DeleteCustomObject deletes the custom object definition of the specified key.
+// The custom object must not have any records.
+// https://developer.zendesk.com/api-reference/custom-objects/custom_objects/#delete-custom-object
+func (z *Client) DeleteCustomObject(ctx context.Context, customObjectKey string) error {
+	err := z.delete(ctx, fmt.Sprintf("/custom_objects/%s", customObjectKey))
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetCustomObjectLimit returns the number of custom objects and the maximum allowed for the account
+// https://developer.zendesk.com/api-reference/custom-objects/custom_objects/#custom-objects-limit
+func (z *Client) GetCustomObjectLimit(ctx context.Context) (CustomObjectLimit, error) {
+	var result CustomObjectLimit
+
+	body, err := z.get(ctx, "/custom_objects/limits/object_limit")
+	if err != nil {
+		return CustomObjectLimit{}, err
+	}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return CustomObjectLimit{}, err
+	}
+	return result, nil
+}
+
 // CreateCustomObjectRecord CreateCustomObject create a custom object record
 func (z *Client) CreateCustomObjectRecord(
 	ctx context.Context, record CustomObjectRecord, customObjectKey string,
@@ -213,3 +408,260 @@ func (z *Client) UpdateCustomObjectRecord(
 	}
 	return &result.CustomObjectRecord, nil
 }
+
+// UpsertCustomObjectRecord creates a custom object record with the specified external id,
+// or updates the record if it already exists
+// https://developer.zendesk.com/api-reference/custom-objects/custom_object_records/#set-custom-object-record-by-external-id
+func (z *Client) UpsertCustomObjectRecord(
+	ctx context.Context, customObjectKey string, externalID string, record CustomObjectRecord,
+) (*CustomObjectRecord, error) {
+	var data, result struct {
+		CustomObjectRecord CustomObjectRecord `json:"custom_object_record"`
+	}
+	data.CustomObjectRecord = record
+
+	var opts struct {
+		ExternalID string `url:"external_id"`
+	}
+	opts.ExternalID = externalID
+
+	url, err := addOptions(fmt.Sprintf("/custom_objects/%s/records", customObjectKey), opts)
+	if err != nil {
+		return nil, err
+	}
+	body, err := z.patch(ctx, url, data)
+
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(body, &result)
+
+	if err != nil {
+		return nil, err
+	}
+	return &result.CustomObjectRecord, nil
+}
+
+// DeleteCustomObjectRecord deletes the custom object record of the specified id
+// https://developer.zendesk.com/api-reference/custom-objects/custom_object_records/#delete-custom-object-record
+func (z *Client) DeleteCustomObjectRecord(
+	ctx context.Context, customObjectKey string, customObjectRecordID string,
+) error {
+	err := z.delete(ctx, fmt.Sprintf("/custom_objects/%s/records/%s", customObjectKey, customObjectRecordID))
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// DeleteCustomObjectRecordByExternalID deletes the custom object record of the specified external id
+// https://developer.zendesk.com/api-reference/custom-objects/custom_object_records/#delete-custom-object-record-by-external-id
+func (z *Client) DeleteCustomObjectRecordByExternalID(
+	ctx context.Context, customObjectKey string, externalID string,
+) error {
+	var opts struct {
+		ExternalID string `url:"external_id"`
+	}
+	opts.ExternalID = externalID
+
+	url, err := addOptions(fmt.Sprintf("/custom_objects/%s/records", customObjectKey), opts)
+	if err != nil {
+		return err
+	}
+
+	err = z.delete(ctx, url)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// FilteredSearchCustomObjectRecords returns the custom object records matching the filter
+// https://developer.zendesk.com/api-reference/custom-objects/custom_object_records/#filtered-search-of-custom-object-records
+func (z *Client) FilteredSearchCustomObjectRecords(
+	ctx context.Context, customObjectKey string, filter CustomObjectRecordFilter, opts *CBPOptions,
+) ([]CustomObjectRecord, CursorPaginationMeta, error) {
+	var data struct {
+		Filter CustomObjectRecordFilter `json:"filter"`
+	}
+	data.Filter = filter
+
+	var result struct {
+		CustomObjectRecords []CustomObjectRecord `json:"custom_object_records"`
+		Meta                CursorPaginationMeta `json:"meta"`
+	}
+
+	url, err := addOptions(fmt.Sprintf("/custom_objects/%s/records/search", customObjectKey), customObjectRecordCursor(opts))
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+	body, err := z.post(ctx, url, data)
+
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+	return result.CustomObjectRecords, result.Meta, nil
+}
+
+// FilteredSearchCustomObjectRecordsIterator returns an iterator over the custom object records matching the filter.
+// The endpoint supports cursor based pagination only, so IsCBP is ignored.
+func (z *Client) FilteredSearchCustomObjectRecordsIterator(
+	ctx context.Context, customObjectKey string, filter CustomObjectRecordFilter, opts *PaginationOptions,
+) *Iterator[CustomObjectRecord] {
+	return &Iterator[CustomObjectRecord]{
+		CommonOptions: opts.CommonOptions,
+		pageSize:      opts.PageSize,
+		hasMore:       true,
+		isCBP:         true,
+		pageAfter:     "",
+		pageIndex:     1,
+		ctx:           ctx,
+		cbpFunc: func(ctx context.Context, opts *CBPOptions) ([]CustomObjectRecord, CursorPaginationMeta, error) {
+			return z.FilteredSearchCustomObjectRecords(ctx, customObjectKey, filter, opts)
+		},
+	}
+}
+
+// CountCustomObjectRecords returns the number of records of the specified custom object
+// https://developer.zendesk.com/api-reference/custom-objects/custom_object_records/#count-custom-object-records
+func (z *Client) CountCustomObjectRecords(
+	ctx context.Context, customObjectKey string,
+) (CustomObjectRecordCount, error) {
+	var result struct {
+		Count CustomObjectRecordCount `json:"count"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/custom_objects/%s/records/count", customObjectKey))
+	if err != nil {
+		return CustomObjectRecordCount{}, err
+	}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return CustomObjectRecordCount{}, err
+	}
+	return result.Count, nil
+}
+
+// GetCustomObjectRecordsCBP lists custom object records using cursor based pagination
+// https://developer.zendesk.com/api-reference/custom-objects/custom_object_records/#list-custom-object-records
+func (z *Client) GetCustomObjectRecordsCBP(
+	ctx context.Context, customObjectKey string, opts *CBPOptions,
+) ([]CustomObjectRecord, CursorPaginationMeta, error) {
+	var result struct {
+		CustomObjectRecords []CustomObjectRecord `json:"custom_object_records"`
+		Meta                CursorPaginationMeta `json:"meta"`
+	}
+
+	url, err := addOptions(fmt.Sprintf("/custom_objects/%s/records", customObjectKey), customObjectRecordCursor(opts))
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+	body, err := z.get(ctx, url)
+
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+	return result.CustomObjectRecords, result.Meta, nil
+}
+
+// GetCustomObjectRecordsIterator returns an iterator over the records of the specified custom object.
+// The endpoint supports cursor based pagination only, so IsCBP is ignored.
+func (z *Client) GetCustomObjectRecordsIterator(
+	ctx context.Context, customObjectKey string, opts *PaginationOptions,
+) *Iterator[CustomObjectRecord] {
+	return &Iterator[CustomObjectRecord]{
+		CommonOptions: opts.CommonOptions,
+		pageSize:      opts.PageSize,
+		hasMore:       true,
+		isCBP:         true,
+		pageAfter:     "",
+		pageIndex:     1,
+		ctx:           ctx,
+		cbpFunc: func(ctx context.Context, opts *CBPOptions) ([]CustomObjectRecord, CursorPaginationMeta, error) {
+			return z.GetCustomObjectRecordsCBP(ctx, customObjectKey, opts)
+		},
+	}
+}
+
+// CreateManyCustomObjectRecords enqueues a job to create up to 100 custom object records
+// https://developer.zendesk.com/api-reference/custom-objects/custom_object_records/#custom-object-record-bulk-jobs
+func (z *Client) CreateManyCustomObjectRecords(
+	ctx context.Context, customObjectKey string, records []CustomObjectRecord,
+) (JobStatus, error) {
+	return z.customObjectRecordsJob(ctx, customObjectKey, CustomObjectRecordJobCreate, records)
+}
+
+// UpdateManyCustomObjectRecords enqueues a job to update up to 100 custom object records.
+// Each record must have its ID set.
+// https://developer.zendesk.com/api-reference/custom-objects/custom_object_records/#custom-object-record-bulk-jobs
+func (z *Client) UpdateManyCustomObjectRecords(
+	ctx context.Context, customObjectKey string, records []CustomObjectRecord,
+) (JobStatus, error) {
+	return z.customObjectRecordsJob(ctx, customObjectKey, CustomObjectRecordJobUpdate, records)
+}
+
+// CreateOrUpdateManyCustomObjectRecords enqueues a job to upsert up to 100 custom object records.
+// Records are matched by their external id.
+// https://developer.zendesk.com/api-reference/custom-objects/custom_object_records/#custom-object-record-bulk-jobs
+func (z *Client) CreateOrUpdateManyCustomObjectRecords(
+	ctx context.Context, customObjectKey string, records []CustomObjectRecord,
+) (JobStatus, error) {
+	return z.customObjectRecordsJob(ctx, customObjectKey, CustomObjectRecordJobCreateOrUpdate, records)
+}
+
+// DeleteManyCustomObjectRecords enqueues a job to delete up to 100 custom object records
+// https://developer.zendesk.com/api-reference/custom-objects/custom_object_records/#custom-object-record-bulk-jobs
+func (z *Client) DeleteManyCustomObjectRecords(
+	ctx context.Context, customObjectKey string, customObjectRecordIDs []string,
+) (JobStatus, error) {
+	return z.customObjectRecordsJob(ctx, customObjectKey, CustomObjectRecordJobDelete, customObjectRecordIDs)
+}
+
+// DeleteManyCustomObjectRecordsByExternalID enqueues a job to delete up to 100 custom object records by external id
+// https://developer.zendesk.com/api-reference/custom-objects/custom_object_records/#custom-object-record-bulk-jobs
+func (z *Client) DeleteManyCustomObjectRecordsByExternalID(
+	ctx context.Context, customObjectKey string, externalIDs []string,
+) (JobStatus, error) {
+	return z.customObjectRecordsJob(ctx, customObjectKey, CustomObjectRecordJobDeleteByExternalID, externalIDs)
+}
+
+func (z *Client) customObjectRecordsJob(
+	ctx context.Context, customObjectKey string, action string, items interface{},
+) (JobStatus, error) {
+	var data struct {
+		Job struct {
+			Action string      `json:"action"`
+			Items  interface{} `json:"items"`
+		} `json:"job"`
+	}
+	data.Job.Action = action
+	data.Job.Items = items
+
+	body, err := z.post(ctx, fmt.Sprintf("/custom_objects/%s/jobs", customObjectKey), data)
+	if err != nil {
+		return JobStatus{}, err
+	}
+	return jobStatusResponse(body)
+}
+
+// customObjectRecordCursor keeps the cursor and the sort order of opts,
+// which are the only query parameters of cursor based record endpoints
+func customObjectRecordCursor(opts *CBPOptions) interface{} {
+	var query struct {
+		CursorPagination
+		Sort string `url:"sort,omitempty"`
+	}
+	if opts != nil {
+		query.CursorPagination = opts.CursorPagination
+		query.Sort = opts.Sort
+	}
+	return query
+}
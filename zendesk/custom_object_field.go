@@ -0,0 +1,220 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Custom object field types
+// https://developer.zendesk.com/api-reference/custom-objects/custom_object_fields/#json-format
+const (
+	CustomObjectFieldTypeText        = "text"
+	CustomObjectFieldTypeTextarea    = "textarea"
+	CustomObjectFieldTypeCheckbox    = "checkbox"
+	CustomObjectFieldTypeDate        = "date"
+	CustomObjectFieldTypeInteger     = "integer"
+	CustomObjectFieldTypeDecimal     = "decimal"
+	CustomObjectFieldTypeRegexp      = "regexp"
+	CustomObjectFieldTypeDropdown    = "dropdown"
+	CustomObjectFieldTypeMultiselect = "multiselect"
+	CustomObjectFieldTypeLookup      = "lookup"
+)
+
+// Relationship target types of lookup relationship fields
+const (
+	RelationshipTargetUser         = "zen:user"
+	RelationshipTargetOrganization = "zen:organization"
+	RelationshipTargetTicket       = "zen:ticket"
+)
+
+// CustomObjectRelationshipTarget returns the relationship target type
+// of a lookup relationship field which refers to the specified custom object
+func CustomObjectRelationshipTarget(customObjectKey string) string {
+	return "zen:custom_object:" + customObjectKey
+}
+
+// CustomObjectField is struct for custom object field payload.
+// Lookup relationship fields have the type "lookup" and set RelationshipTargetType,
+// dropdown and multiselect fields set CustomFieldOptions.
+// https://developer.zendesk.com/api-reference/custom-objects/custom_object_fields/
+type CustomObjectField struct {
+	ID                     int64               `json:"id,omitempty"`
+	URL                    string              `json:"url,omitempty"`
+	Key                    string              `json:"key"`
+	Type                   string              `json:"type"`
+	Title                  string              `json:"title"`
+	RawTitle               string              `json:"raw_title,omitempty"`
+	Description            string              `json:"description,omitempty"`
+	RawDescription         string              `json:"raw_description,omitempty"`
+	Position               int64               `json:"position,omitempty"`
+	Active                 bool                `json:"active,omitempty"`
+	System                 bool                `json:"system,omitempty"`
+	RegexpForValidation    string              `json:"regexp_for_validation,omitempty"`
+	Tag                    string              `json:"tag,omitempty"`
+	CustomFieldOptions     []CustomFieldOption `json:"custom_field_options,omitempty"`
+	RelationshipTargetType string              `json:"relationship_target_type,omitempty"`
+	RelationshipFilter     *RelationshipFilter `json:"relationship_filter,omitempty"`
+	CreatedAt              time.Time           `json:"created_at,omitempty"`
+	UpdatedAt              time.Time           `json:"updated_at,omitempty"`
+}
+
+// CustomObjectFieldListOptions custom object field list options
+type CustomObjectFieldListOptions struct {
+	// IncludeStandardFields also returns the standard fields such as name and external_id
+	IncludeStandardFields bool `url:"include_standard_fields,omitempty"`
+}
+
+// CustomObjectFieldAPI an interface containing all custom object field related methods
+type CustomObjectFieldAPI interface {
+	GetCustomObjectFields(
+		ctx context.Context, customObjectKey string, opts *CustomObjectFieldListOptions,
+	) ([]CustomObjectField, error)
+	GetCustomObjectField(
+		ctx context.Context, customObjectKey string, fieldKeyOrID string,
+	) (CustomObjectField, error)
+	CreateCustomObjectField(
+		ctx context.Context, customObjectKey string, field CustomObjectField,
+	) (CustomObjectField, error)
+	UpdateCustomObjectField(
+		ctx context.Context, customObjectKey string, fieldKeyOrID string, field CustomObjectField,
+	) (CustomObjectField, error)
+	DeleteCustomObjectField(ctx context.Context, customObjectKey string, fieldKeyOrID string) error
+	ReorderCustomObjectFields(ctx context.Context, customObjectKey string, fieldIDs []int64) error
+	GetCustomObjectFieldLimit(ctx context.Context, customObjectKey string) (CustomObjectLimit, error)
+}
+
+// GetCustomObjectFields lists the fields of the specified custom object
+// https://developer.zendesk.com/api-reference/custom-objects/custom_object_fields/#list-custom-object-fields
+func (z *Client) GetCustomObjectFields(
+	ctx context.Context, customObjectKey string, opts *CustomObjectFieldListOptions,
+) ([]CustomObjectField, error) {
+	var result struct {
+		CustomObjectFields []CustomObjectField `json:"custom_object_fields"`
+	}
+	tmp := opts
+	if tmp == nil {
+		tmp = &CustomObjectFieldListOptions{}
+	}
+
+	u, err := addOptions(fmt.Sprintf("/custom_objects/%s/fields", customObjectKey), tmp)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.CustomObjectFields, nil
+}
+
+// GetCustomObjectField returns the field of the specified custom object by its key or ID
+// https://developer.zendesk.com/api-reference/custom-objects/custom_object_fields/#show-custom-object-field
+func (z *Client) GetCustomObjectField(
+	ctx context.Context, customObjectKey string, fieldKeyOrID string,
+) (CustomObjectField, error) {
+	var result struct {
+		CustomObjectField CustomObjectField `json:"custom_object_field"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/custom_objects/%s/fields/%s", customObjectKey, fieldKeyOrID))
+	if err != nil {
+		return CustomObjectField{}, err
+	}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return CustomObjectField{}, err
+	}
+	return result.CustomObjectField, nil
+}
+
+// CreateCustomObjectField creates a field of the specified custom object
+// https://developer.zendesk.com/api-reference/custom-objects/custom_object_fields/#create-custom-object-field
+func (z *Client) CreateCustomObjectField(
+	ctx context.Context, customObjectKey string, field CustomObjectField,
+) (CustomObjectField, error) {
+	var data, result struct {
+		CustomObjectField CustomObjectField `json:"custom_object_field"`
+	}
+	data.CustomObjectField = field
+
+	body, err := z.post(ctx, fmt.Sprintf("/custom_objects/%s/fields", customObjectKey), data)
+	if err != nil {
+		return CustomObjectField{}, err
+	}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return CustomObjectField{}, err
+	}
+	return result.CustomObjectField, nil
+}
+
+// UpdateCustomObjectField updates the field of the specified custom object.
+// Options of dropdown and multiselect fields are replaced by CustomFieldOptions;
+// options without ID are created and existing options which are omitted are deleted.
+// https://developer.zendesk.com/api-reference/custom-objects/custom_object_fields/#update-custom-object-field
+func (z *Client) UpdateCustomObjectField(
+	ctx context.Context, customObjectKey string, fieldKeyOrID string, field CustomObjectField,
+) (CustomObjectField, error) {
+	var data, result struct {
+		CustomObjectField CustomObjectField `json:"custom_object_field"`
+	}
+	data.CustomObjectField = field
+
+	body, err := z.patch(ctx, fmt.Sprintf("/custom_objects/%s/fields/%s", customObjectKey, fieldKeyOrID), data)
+	if err != nil {
+		return CustomObjectField{}, err
+	}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return CustomObjectField{}, err
+	}
+	return result.CustomObjectField, nil
+}
+
+// DeleteCustomObjectField deletes the field of the specified custom object
+// https://developer.zendesk.com/api-reference/custom-objects/custom_object_fields/#delete-custom-object-field
+func (z *Client) DeleteCustomObjectField(ctx context.Context, customObjectKey string, fieldKeyOrID string) error {
+	err := z.delete(ctx, fmt.Sprintf("/custom_objects/%s/fields/%s", customObjectKey, fieldKeyOrID))
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// ReorderCustomObjectFields sets the order of the fields of the specified custom object
+// https://developer.zendesk.com/api-reference/custom-objects/custom_object_fields/#reorder-custom-fields-of-an-object
+func (z *Client) ReorderCustomObjectFields(ctx context.Context, customObjectKey string, fieldIDs []int64) error {
+	var data struct {
+		CustomObjectFieldIDs []int64 `json:"custom_object_field_ids"`
+	}
+	data.CustomObjectFieldIDs = fieldIDs
+
+	_, err := z.put(ctx, fmt.Sprintf("/custom_objects/%s/fields/reorder", customObjectKey), data)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetCustomObjectFieldLimit returns the number of fields of the specified custom object and the maximum allowed
+// https://developer.zendesk.com/api-reference/custom-objects/custom_object_fields/#custom-object-fields-limit
+func (z *Client) GetCustomObjectFieldLimit(ctx context.Context, customObjectKey string) (CustomObjectLimit, error) {
+	var result CustomObjectLimit
+
+	body, err := z.get(ctx, fmt.Sprintf("/custom_objects/%s/limits/field_limit", customObjectKey))
+	if err != nil {
+		return CustomObjectLimit{}, err
+	}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return CustomObjectLimit{}, err
+	}
+	return result, nil
+}
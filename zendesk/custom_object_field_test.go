@@ -0,0 +1,139 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestGetCustomObjectFields(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if v := r.URL.Query().Get("include_standard_fields"); v != "true" {
+			t.Errorf("unexpected include_standard_fields query: %s", v)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "custom_object_fields.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	fields, err := client.GetCustomObjectFields(ctx, "asset", &CustomObjectFieldListOptions{
+		IncludeStandardFields: true,
+	})
+	if err != nil {
+		t.Fatalf("Failed to get custom object fields: %s", err)
+	}
+
+	if len(fields) != 3 {
+		t.Fatalf("expected length of custom object fields is 3, but got %d", len(fields))
+	}
+	if len(fields[1].CustomFieldOptions) != 2 {
+		t.Fatalf("expected length of custom field options is 2, but got %d", len(fields[1].CustomFieldOptions))
+	}
+}
+
+func TestGetCustomObjectField(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "custom_object_field.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	field, err := client.GetCustomObjectField(ctx, "asset", "assignee")
+	if err != nil {
+		t.Fatalf("Failed to get custom object field: %s", err)
+	}
+
+	if field.RelationshipTargetType != RelationshipTargetUser {
+		t.Fatalf("expected relationship target type is %s, but got %s", RelationshipTargetUser, field.RelationshipTargetType)
+	}
+	if field.RelationshipFilter == nil || len(field.RelationshipFilter.All) != 1 {
+		t.Fatalf("expected relationship filter with 1 condition, but got %+v", field.RelationshipFilter)
+	}
+}
+
+func TestCreateCustomObjectField(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data struct {
+			CustomObjectField map[string]interface{} `json:"custom_object_field"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+			t.Errorf("Failed to decode request body: %s", err)
+		}
+		if data.CustomObjectField["relationship_target_type"] != "zen:custom_object:contract" {
+			t.Errorf("unexpected relationship target type: %v", data.CustomObjectField["relationship_target_type"])
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write(readFixture(filepath.Join(http.MethodPost, "custom_object_field.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.CreateCustomObjectField(ctx, "asset", CustomObjectField{
+		Key:                    "contract",
+		Type:                   CustomObjectFieldTypeLookup,
+		Title:                  "Contract",
+		RelationshipTargetType: CustomObjectRelationshipTarget("contract"),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create custom object field: %s", err)
+	}
+}
+
+func TestUpdateCustomObjectField(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodPatch, "custom_object_field.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.UpdateCustomObjectField(ctx, "asset", "assignee", CustomObjectField{Title: "Assignee"})
+	if err != nil {
+		t.Fatalf("Failed to update custom object field: %s", err)
+	}
+}
+
+func TestDeleteCustomObjectField(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+		w.Write(nil)
+	}))
+
+	c := newTestClient(mockAPI)
+	err := c.DeleteCustomObjectField(ctx, "asset", "assignee")
+	if err != nil {
+		t.Fatalf("Failed to delete custom object field: %s", err)
+	}
+}
+
+func TestReorderCustomObjectFields(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data struct {
+			CustomObjectFieldIDs []int64 `json:"custom_object_field_ids"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+			t.Errorf("Failed to decode request body: %s", err)
+		}
+		expected := []int64{4398096842883, 4398096842879}
+		if !reflect.DeepEqual(data.CustomObjectFieldIDs, expected) {
+			t.Errorf("expected field ids %v, but got %v", expected, data.CustomObjectFieldIDs)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	err := client.ReorderCustomObjectFields(ctx, "asset", []int64{4398096842883, 4398096842879})
+	if err != nil {
+		t.Fatalf("Failed to reorder custom object fields: %s", err)
+	}
+}
+
+func TestGetCustomObjectFieldLimit(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "custom_object_limit.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.GetCustomObjectFieldLimit(ctx, "asset")
+	if err != nil {
+		t.Fatalf("Failed to get custom object field limit: %s", err)
+	}
+}
@@ -0,0 +1,561 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// customObjectDateLayout is the format of date fields of custom object records
+const customObjectDateLayout = "2006-01-02"
+
+// Standard fields of custom object records. Struct fields tagged with these keys
+// are mapped to the attributes of CustomObjectRecord instead of CustomObjectFields.
+const (
+	customObjectStandardFieldID         = "id"
+	customObjectStandardFieldName       = "name"
+	customObjectStandardFieldExternalID = "external_id"
+)
+
+// CustomObjectDate is the value of a date field of a custom object record
+type CustomObjectDate struct {
+	time.Time
+}
+
+// NewCustomObjectDate returns the date of t in its location
+func NewCustomObjectDate(t time.Time) CustomObjectDate {
+	y, m, d := t.Date()
+	return CustomObjectDate{time.Date(y, m, d, 0, 0, 0, 0, time.UTC)}
+}
+
+// String returns the date in the format used by the API, such as "2023-05-13"
+func (d CustomObjectDate) String() string {
+	return d.Format(customObjectDateLayout)
+}
+
+// CustomObjectLookup is the value of a lookup relationship field, which is the ID
+// of the related user, organization, ticket or custom object record
+type CustomObjectLookup string
+
+// Int64 returns the numeric ID of a related user, organization or ticket
+func (l CustomObjectLookup) Int64() (int64, error) {
+	return strconv.ParseInt(string(l), 10, 64)
+}
+
+// CustomObjectFieldError is returned when a field of a custom object record
+// cannot be mapped or does not satisfy the schema of the custom object
+type CustomObjectFieldError struct {
+	Key string
+	Err error
+}
+
+func (e *CustomObjectFieldError) Error() string {
+	return fmt.Sprintf("custom object field %q: %s", e.Key, e.Err)
+}
+
+func (e *CustomObjectFieldError) Unwrap() error {
+	return e.Err
+}
+
+// CustomObjectRecordMapper converts custom object records to and from T.
+//
+// T must be a struct whose fields are tagged with the keys of the custom object fields:
+//
+//	type Asset struct {
+//		ID       string                      `zendesk:"id"`
+//		Name     string                      `zendesk:"name"`
+//		Serial   string                      `zendesk:"serial_number"`
+//		Retired  bool                        `zendesk:"retired"`
+//		Cost     float64                     `zendesk:"cost,omitempty"`
+//		Bought   zendesk.CustomObjectDate    `zendesk:"purchase_date"`
+//		Tags     []string                    `zendesk:"tags"`
+//		Assignee *zendesk.CustomObjectLookup `zendesk:"assignee"`
+//	}
+//
+// The keys "id", "name" and "external_id" refer to the standard fields of the record,
+// which must be mapped to strings.
+// Pointer fields are set to nil when the value is null, and a nil pointer clears the field
+// unless the tag has the omitempty option.
+type CustomObjectRecordMapper[T any] struct {
+	customObjectKey string
+	fields          []customObjectRecordField
+	schema          map[string]CustomObjectField
+}
+
+// customObjectRecordField is a struct field mapped to a custom object field
+type customObjectRecordField struct {
+	index     int
+	name      string
+	key       string
+	omitEmpty bool
+}
+
+// NewCustomObjectRecordMapper returns a mapper between T and the records of the specified custom object.
+// When fields is not empty, the struct tags of T are validated against the fields of the custom object,
+// and the values written by ToRecord are validated against their options and validation regexps.
+func NewCustomObjectRecordMapper[T any](customObjectKey string, fields []CustomObjectField) (*CustomObjectRecordMapper[T], error) {
+	var zero T
+	mapped, err := customObjectRecordFields(reflect.TypeOf(zero))
+	if err != nil {
+		return nil, err
+	}
+
+	t := reflect.TypeOf(zero)
+	for _, f := range mapped {
+		if !isCustomObjectStandardField(f.key) {
+			continue
+		}
+		ft := t.Field(f.index).Type
+		if ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+		if ft.Kind() != reflect.String {
+			return nil, &CustomObjectFieldError{
+				Key: f.key,
+				Err: fmt.Errorf("standard field cannot be mapped to %s of type %s", f.name, t.Field(f.index).Type),
+			}
+		}
+	}
+
+	m := &CustomObjectRecordMapper[T]{
+		customObjectKey: customObjectKey,
+		fields:          mapped,
+	}
+	if len(fields) == 0 {
+		return m, nil
+	}
+
+	m.schema = make(map[string]CustomObjectField, len(fields))
+	for _, field := range fields {
+		m.schema[field.Key] = field
+	}
+
+	for _, f := range mapped {
+		if isCustomObjectStandardField(f.key) {
+			continue
+		}
+		field, ok := m.schema[f.key]
+		if !ok {
+			return nil, &CustomObjectFieldError{Key: f.key, Err: fmt.Errorf("not defined in custom object %s", customObjectKey)}
+		}
+		if !compatibleCustomObjectFieldType(field.Type, t.Field(f.index).Type) {
+			return nil, &CustomObjectFieldError{
+				Key: f.key,
+				Err: fmt.Errorf("%s field cannot be mapped to %s of type %s", field.Type, f.name, t.Field(f.index).Type),
+			}
+		}
+	}
+	return m, nil
+}
+
+// FromRecord converts a custom object record to T
+func (m *CustomObjectRecordMapper[T]) FromRecord(record CustomObjectRecord) (T, error) {
+	var v T
+	rv := reflect.ValueOf(&v).Elem()
+	for _, f := range m.fields {
+		raw := customObjectRecordValue(record, f.key)
+		if err := decodeCustomObjectFieldValue(raw, rv.Field(f.index)); err != nil {
+			return v, &CustomObjectFieldError{Key: f.key, Err: err}
+		}
+	}
+	return v, nil
+}
+
+// FromRecords converts custom object records to T
+func (m *CustomObjectRecordMapper[T]) FromRecords(records []CustomObjectRecord) ([]T, error) {
+	values := make([]T, 0, len(records))
+	for _, record := range records {
+		v, err := m.FromRecord(record)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, v)
+	}
+	return values, nil
+}
+
+// ToRecord converts v to a custom object record which can be passed to
+// CreateCustomObjectRecord, UpdateCustomObjectRecord or the bulk job methods
+func (m *CustomObjectRecordMapper[T]) ToRecord(v T) (CustomObjectRecord, error) {
+	record := CustomObjectRecord{
+		CustomObjectKey:    m.customObjectKey,
+		CustomObjectFields: map[string]interface{}{},
+	}
+
+	rv := reflect.ValueOf(v)
+	for _, f := range m.fields {
+		fv := rv.Field(f.index)
+		if f.omitEmpty && fv.IsZero() {
+			continue
+		}
+
+		value, err := encodeCustomObjectFieldValue(fv)
+		if err != nil {
+			return CustomObjectRecord{}, &CustomObjectFieldError{Key: f.key, Err: err}
+		}
+		if field, ok := m.schema[f.key]; ok {
+			if err := validateCustomObjectFieldValue(field, value); err != nil {
+				return CustomObjectRecord{}, &CustomObjectFieldError{Key: f.key, Err: err}
+			}
+		}
+
+		if !isCustomObjectStandardField(f.key) {
+			record.CustomObjectFields[f.key] = value
+			continue
+		}
+
+		// NewCustomObjectRecordMapper only accepts strings for standard fields,
+		// so the value is either a string or nil for a nil pointer
+		str, _ := value.(string)
+		switch f.key {
+		case customObjectStandardFieldID:
+			record.ID = str
+		case customObjectStandardFieldName:
+			record.Name = str
+		case customObjectStandardFieldExternalID:
+			record.ExternalID = str
+		}
+	}
+	return record, nil
+}
+
+// ToRecords converts values to custom object records
+func (m *CustomObjectRecordMapper[T]) ToRecords(values []T) ([]CustomObjectRecord, error) {
+	records := make([]CustomObjectRecord, 0, len(values))
+	for _, v := range values {
+		record, err := m.ToRecord(v)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// customObjectRecordFields returns the fields of t tagged with `zendesk`
+func customObjectRecordFields(t reflect.Type) ([]customObjectRecordField, error) {
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("custom object record mapper requires a struct type, but got %v", t)
+	}
+
+	var fields []customObjectRecordField
+	seen := map[string]string{}
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		tag, ok := sf.Tag.Lookup("zendesk")
+		if !ok || tag == "-" || !sf.IsExported() {
+			continue
+		}
+
+		parts := strings.Split(tag, ",")
+		key := parts[0]
+		if key == "" {
+			return nil, fmt.Errorf("field %s has an empty zendesk tag", sf.Name)
+		}
+		if other, ok := seen[key]; ok {
+			return nil, fmt.Errorf("fields %s and %s are both mapped to %q", other, sf.Name, key)
+		}
+		seen[key] = sf.Name
+
+		field := customObjectRecordField{index: i, name: sf.Name, key: key}
+		for _, opt := range parts[1:] {
+			if opt == "omitempty" {
+				field.omitEmpty = true
+			}
+		}
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+func isCustomObjectStandardField(key string) bool {
+	switch key {
+	case customObjectStandardFieldID, customObjectStandardFieldName, customObjectStandardFieldExternalID:
+		return true
+	}
+	return false
+}
+
+// customObjectRecordValue returns the raw value of the specified field of the record
+func customObjectRecordValue(record CustomObjectRecord, key string) interface{} {
+	switch key {
+	case customObjectStandardFieldID:
+		return record.ID
+	case customObjectStandardFieldName:
+		return record.Name
+	case customObjectStandardFieldExternalID:
+		return record.ExternalID
+	}
+	return record.CustomObjectFields[key]
+}
+
+var (
+	timeType             = reflect.TypeOf(time.Time{})
+	customObjectDateType = reflect.TypeOf(CustomObjectDate{})
+)
+
+// compatibleCustomObjectFieldType reports whether a value of the custom object field type can be mapped to t.
+// Unknown field types are accepted so that fields added to the API can still be mapped.
+func compatibleCustomObjectFieldType(fieldType string, t reflect.Type) bool {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	switch fieldType {
+	case CustomObjectFieldTypeText, CustomObjectFieldTypeTextarea, CustomObjectFieldTypeRegexp, CustomObjectFieldTypeDropdown:
+		return t.Kind() == reflect.String
+	case CustomObjectFieldTypeCheckbox:
+		return t.Kind() == reflect.Bool
+	case CustomObjectFieldTypeInteger:
+		return isIntKind(t.Kind())
+	case CustomObjectFieldTypeDecimal:
+		return t.Kind() == reflect.Float32 || t.Kind() == reflect.Float64
+	case CustomObjectFieldTypeDate:
+		return t == timeType || t == customObjectDateType || t.Kind() == reflect.String
+	case CustomObjectFieldTypeMultiselect:
+		return t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.String
+	case CustomObjectFieldTypeLookup:
+		return t.Kind() == reflect.String || isIntKind(t.Kind())
+	}
+	return true
+}
+
+func isIntKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return true
+	}
+	return false
+}
+
+// decodeCustomObjectFieldValue sets the raw JSON value of a field to v
+func decodeCustomObjectFieldValue(raw interface{}, v reflect.Value) error {
+	if raw == nil {
+		v.Set(reflect.Zero(v.Type()))
+		return nil
+	}
+
+	if v.Kind() == reflect.Ptr {
+		elem := reflect.New(v.Type().Elem())
+		if err := decodeCustomObjectFieldValue(raw, elem.Elem()); err != nil {
+			return err
+		}
+		v.Set(elem)
+		return nil
+	}
+
+	switch {
+	case v.Type() == timeType || v.Type() == customObjectDateType:
+		s, ok := raw.(string)
+		if !ok {
+			return fmt.Errorf("cannot decode %T into %s", raw, v.Type())
+		}
+		if s == "" {
+			v.Set(reflect.Zero(v.Type()))
+			return nil
+		}
+		t, err := parseCustomObjectDate(s)
+		if err != nil {
+			return err
+		}
+		if v.Type() == customObjectDateType {
+			v.Set(reflect.ValueOf(CustomObjectDate{t}))
+		} else {
+			v.Set(reflect.ValueOf(t))
+		}
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		switch r := raw.(type) {
+		case string:
+			v.SetString(r)
+		case float64:
+			v.SetString(strconv.FormatFloat(r, 'f', -1, 64))
+		case json.Number:
+			v.SetString(r.String())
+		default:
+			return fmt.Errorf("cannot decode %T into %s", raw, v.Type())
+		}
+	case reflect.Bool:
+		b, ok := raw.(bool)
+		if !ok {
+			return fmt.Errorf("cannot decode %T into %s", raw, v.Type())
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := customObjectInt(raw)
+		if err != nil {
+			return err
+		}
+		if v.OverflowInt(n) {
+			return fmt.Errorf("value %d overflows %s", n, v.Type())
+		}
+		v.SetInt(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := customObjectFloat(raw)
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	case reflect.Slice:
+		if v.Type().Elem().Kind() != reflect.String {
+			return fmt.Errorf("unsupported type %s", v.Type())
+		}
+		items, ok := raw.([]interface{})
+		if !ok {
+			if strs, ok := raw.([]string); ok {
+				items = make([]interface{}, len(strs))
+				for i, s := range strs {
+					items[i] = s
+				}
+			} else {
+				return fmt.Errorf("cannot decode %T into %s", raw, v.Type())
+			}
+		}
+		slice := reflect.MakeSlice(v.Type(), len(items), len(items))
+		for i, item := range items {
+			s, ok := item.(string)
+			if !ok {
+				return fmt.Errorf("cannot decode %T into %s", item, v.Type().Elem())
+			}
+			slice.Index(i).SetString(s)
+		}
+		v.Set(slice)
+	default:
+		return fmt.Errorf("unsupported type %s", v.Type())
+	}
+	return nil
+}
+
+// encodeCustomObjectFieldValue returns the JSON value of v
+func encodeCustomObjectFieldValue(v reflect.Value) (interface{}, error) {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil, nil
+		}
+		return encodeCustomObjectFieldValue(v.Elem())
+	}
+
+	switch v.Type() {
+	case customObjectDateType:
+		d := v.Interface().(CustomObjectDate)
+		if d.IsZero() {
+			return nil, nil
+		}
+		return d.String(), nil
+	case timeType:
+		t := v.Interface().(time.Time)
+		if t.IsZero() {
+			return nil, nil
+		}
+		return t.Format(customObjectDateLayout), nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Bool:
+		return v.Bool(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int(), nil
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), nil
+	case reflect.Slice:
+		if v.Type().Elem().Kind() != reflect.String {
+			return nil, fmt.Errorf("unsupported type %s", v.Type())
+		}
+		strs := make([]string, v.Len())
+		for i := range strs {
+			strs[i] = v.Index(i).String()
+		}
+		return strs, nil
+	}
+	return nil, fmt.Errorf("unsupported type %s", v.Type())
+}
+
+// validateCustomObjectFieldValue checks an encoded value against the options and
+// the validation regexp of the field
+func validateCustomObjectFieldValue(field CustomObjectField, value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	switch field.Type {
+	case CustomObjectFieldTypeDropdown:
+		s, _ := value.(string)
+		if s != "" && !hasCustomFieldOption(field.CustomFieldOptions, s) {
+			return fmt.Errorf("%q is not an option of the field", s)
+		}
+	case CustomObjectFieldTypeMultiselect:
+		strs, _ := value.([]string)
+		for _, s := range strs {
+			if !hasCustomFieldOption(field.CustomFieldOptions, s) {
+				return fmt.Errorf("%q is not an option of the field", s)
+			}
+		}
+	case CustomObjectFieldTypeRegexp:
+		s, _ := value.(string)
+		if s == "" || field.RegexpForValidation == "" {
+			return nil
+		}
+		re, err := regexp.Compile(field.RegexpForValidation)
+		if err != nil {
+			// the regexp syntax of Zendesk is Ruby's, which Go may not support
+			return nil
+		}
+		if !re.MatchString(s) {
+			return fmt.Errorf("%q does not match %s", s, field.RegexpForValidation)
+		}
+	}
+	return nil
+}
+
+func hasCustomFieldOption(options []CustomFieldOption, value string) bool {
+	for _, option := range options {
+		if option.Value == value {
+			return true
+		}
+	}
+	return false
+}
+
+func parseCustomObjectDate(s string) (time.Time, error) {
+	if t, err := time.Parse(customObjectDateLayout, s); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.RFC3339, s)
+}
+
+func customObjectInt(raw interface{}) (int64, error) {
+	switch r := raw.(type) {
+	case float64:
+		if r != math.Trunc(r) {
+			return 0, fmt.Errorf("value %v is not an integer", r)
+		}
+		return int64(r), nil
+	case json.Number:
+		return r.Int64()
+	case string:
+		return strconv.ParseInt(r, 10, 64)
+	}
+	return 0, fmt.Errorf("cannot decode %T into an integer", raw)
+}
+
+func customObjectFloat(raw interface{}) (float64, error) {
+	switch r := raw.(type) {
+	case float64:
+		return r, nil
+	case json.Number:
+		return r.Float64()
+	case string:
+		return strconv.ParseFloat(r, 64)
+	}
+	return 0, fmt.Errorf("cannot decode %T into a decimal", raw)
+}
@@ -0,0 +1,211 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type testAsset struct {
+	ID         string              `zendesk:"id"`
+	Name       string              `zendesk:"name"`
+	ExternalID string              `zendesk:"external_id,omitempty"`
+	Serial     string              `zendesk:"serial_number"`
+	Status     string              `zendesk:"status"`
+	Retired    bool                `zendesk:"retired"`
+	Quantity   int64               `zendesk:"quantity"`
+	Cost       float64             `zendesk:"cost,omitempty"`
+	Bought     CustomObjectDate    `zendesk:"purchase_date"`
+	Tags       []string            `zendesk:"tags"`
+	Assignee   *CustomObjectLookup `zendesk:"assignee"`
+	Ignored    string
+}
+
+var testAssetFields = []CustomObjectField{
+	{Key: "serial_number", Type: CustomObjectFieldTypeRegexp, RegexpForValidation: "^[A-Z0-9]+$"},
+	{Key: "status", Type: CustomObjectFieldTypeDropdown, CustomFieldOptions: []CustomFieldOption{
+		{Name: "In use", Value: "in_use"},
+		{Name: "Retired", Value: "retired"},
+	}},
+	{Key: "retired", Type: CustomObjectFieldTypeCheckbox},
+	{Key: "quantity", Type: CustomObjectFieldTypeInteger},
+	{Key: "cost", Type: CustomObjectFieldTypeDecimal},
+	{Key: "purchase_date", Type: CustomObjectFieldTypeDate},
+	{Key: "tags", Type: CustomObjectFieldTypeMultiselect, CustomFieldOptions: []CustomFieldOption{
+		{Name: "Laptop", Value: "laptop"},
+		{Name: "Loaner", Value: "loaner"},
+	}},
+	{Key: "assignee", Type: CustomObjectFieldTypeLookup, RelationshipTargetType: RelationshipTargetUser},
+}
+
+func TestCustomObjectRecordMapperFromRecord(t *testing.T) {
+	var record CustomObjectRecord
+	err := json.Unmarshal([]byte(`{
+		"id": "01GDXYD7ZTWYP542BA8MDDTE36",
+		"name": "MacBook Pro 14",
+		"custom_object_key": "asset",
+		"custom_object_fields": {
+			"serial_number": "C02XK1ZZJGH5",
+			"status": "in_use",
+			"retired": false,
+			"quantity": 3,
+			"cost": 2499.5,
+			"purchase_date": "2023-05-12",
+			"tags": ["laptop", "loaner"],
+			"assignee": "10001"
+		}
+	}`), &record)
+	if err != nil {
+		t.Fatalf("Failed to unmarshal record: %s", err)
+	}
+
+	mapper, err := NewCustomObjectRecordMapper[testAsset]("asset", testAssetFields)
+	if err != nil {
+		t.Fatalf("Failed to create mapper: %s", err)
+	}
+
+	asset, err := mapper.FromRecord(record)
+	if err != nil {
+		t.Fatalf("Failed to map record: %s", err)
+	}
+
+	assignee := CustomObjectLookup("10001")
+	expected := testAsset{
+		ID:       "01GDXYD7ZTWYP542BA8MDDTE36",
+		Name:     "MacBook Pro 14",
+		Serial:   "C02XK1ZZJGH5",
+		Status:   "in_use",
+		Quantity: 3,
+		Cost:     2499.5,
+		Bought:   CustomObjectDate{time.Date(2023, 5, 12, 0, 0, 0, 0, time.UTC)},
+		Tags:     []string{"laptop", "loaner"},
+		Assignee: &assignee,
+	}
+	if !reflect.DeepEqual(asset, expected) {
+		t.Fatalf("expected %+v, but got %+v", expected, asset)
+	}
+
+	userID, err := asset.Assignee.Int64()
+	if err != nil || userID != 10001 {
+		t.Fatalf("expected assignee id is 10001, but got %d: %v", userID, err)
+	}
+}
+
+func TestCustomObjectRecordMapperFromRecordNull(t *testing.T) {
+	mapper, err := NewCustomObjectRecordMapper[testAsset]("asset", nil)
+	if err != nil {
+		t.Fatalf("Failed to create mapper: %s", err)
+	}
+
+	asset, err := mapper.FromRecord(CustomObjectRecord{
+		CustomObjectFields: map[string]interface{}{
+			"assignee":      nil,
+			"purchase_date": nil,
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to map record: %s", err)
+	}
+
+	if asset.Assignee != nil || !asset.Bought.IsZero() {
+		t.Fatalf("expected null fields to be zero, but got %+v", asset)
+	}
+}
+
+func TestCustomObjectRecordMapperToRecord(t *testing.T) {
+	mapper, err := NewCustomObjectRecordMapper[testAsset]("asset", testAssetFields)
+	if err != nil {
+		t.Fatalf("Failed to create mapper: %s", err)
+	}
+
+	record, err := mapper.ToRecord(testAsset{
+		Name:     "ThinkPad X1",
+		Serial:   "PF3ABCD1",
+		Status:   "retired",
+		Retired:  true,
+		Quantity: 1,
+		Bought:   NewCustomObjectDate(time.Date(2021, 1, 4, 15, 0, 0, 0, time.UTC)),
+		Tags:     []string{"laptop"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to map value: %s", err)
+	}
+
+	if record.CustomObjectKey != "asset" || record.Name != "ThinkPad X1" {
+		t.Fatalf("unexpected record: %+v", record)
+	}
+
+	expected := map[string]interface{}{
+		"serial_number": "PF3ABCD1",
+		"status":        "retired",
+		"retired":       true,
+		"quantity":      int64(1),
+		"purchase_date": "2021-01-04",
+		"tags":          []string{"laptop"},
+		"assignee":      nil,
+	}
+	if !reflect.DeepEqual(record.CustomObjectFields, expected) {
+		t.Fatalf("expected fields %v, but got %v", expected, record.CustomObjectFields)
+	}
+}
+
+func TestCustomObjectRecordMapperSchemaValidation(t *testing.T) {
+	type unknownField struct {
+		Color string `zendesk:"color"`
+	}
+	_, err := NewCustomObjectRecordMapper[unknownField]("asset", testAssetFields)
+	var fieldErr *CustomObjectFieldError
+	if !errors.As(err, &fieldErr) || fieldErr.Key != "color" {
+		t.Fatalf("expected field error of color, but got %v", err)
+	}
+
+	type wrongType struct {
+		Quantity string `zendesk:"quantity"`
+	}
+	_, err = NewCustomObjectRecordMapper[wrongType]("asset", testAssetFields)
+	if !errors.As(err, &fieldErr) || fieldErr.Key != "quantity" {
+		t.Fatalf("expected field error of quantity, but got %v", err)
+	}
+
+	mapper, err := NewCustomObjectRecordMapper[testAsset]("asset", testAssetFields)
+	if err != nil {
+		t.Fatalf("Failed to create mapper: %s", err)
+	}
+
+	_, err = mapper.ToRecord(testAsset{Status: "lost"})
+	if !errors.As(err, &fieldErr) || fieldErr.Key != "status" {
+		t.Fatalf("expected field error of status, but got %v", err)
+	}
+
+	_, err = mapper.ToRecord(testAsset{Serial: "not valid"})
+	if !errors.As(err, &fieldErr) || fieldErr.Key != "serial_number" {
+		t.Fatalf("expected field error of serial_number, but got %v", err)
+	}
+}
+
+func TestCustomObjectRecordMapperRejectsNonStringStandardField(t *testing.T) {
+	type numericID struct {
+		ID int64 `zendesk:"id"`
+	}
+	_, err := NewCustomObjectRecordMapper[numericID]("asset", nil)
+	var fieldErr *CustomObjectFieldError
+	if !errors.As(err, &fieldErr) || fieldErr.Key != "id" {
+		t.Fatalf("expected field error of id, but got %v", err)
+	}
+}
+
+func TestCustomObjectRecordMapperRejectsNonInteger(t *testing.T) {
+	mapper, err := NewCustomObjectRecordMapper[testAsset]("asset", nil)
+	if err != nil {
+		t.Fatalf("Failed to create mapper: %s", err)
+	}
+
+	_, err = mapper.FromRecord(CustomObjectRecord{
+		CustomObjectFields: map[string]interface{}{"quantity": 1.5},
+	})
+	if err == nil {
+		t.Fatal("expected an error for a non integer quantity")
+	}
+}
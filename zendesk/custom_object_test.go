@@ -0,0 +1,292 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+)
+
+func TestGetCustomObjects(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "custom_objects.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	customObjects, err := client.GetCustomObjects(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get custom objects: %s", err)
+	}
+
+	if len(customObjects) != 2 {
+		t.Fatalf("expected length of custom objects is 2, but got %d", len(customObjects))
+	}
+}
+
+func TestGetCustomObject(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "custom_object.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	customObject, err := client.GetCustomObject(ctx, "asset")
+	if err != nil {
+		t.Fatalf("Failed to get custom object: %s", err)
+	}
+
+	if customObject.Key != "asset" {
+		t.Fatalf("expected custom object key is asset, but got %s", customObject.Key)
+	}
+}
+
+func TestCreateCustomObject(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodPost, "custom_object.json", http.StatusCreated)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.CreateCustomObject(ctx, CustomObject{
+		Key:             "asset",
+		Title:           "Asset",
+		TitlePluralized: "Assets",
+	})
+	if err != nil {
+		t.Fatalf("Failed to create custom object: %s", err)
+	}
+}
+
+func TestUpdateCustomObject(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodPatch, "custom_object.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	customObject, err := client.UpdateCustomObject(ctx, "asset", CustomObject{
+		Description: "Hardware lent to employees",
+	})
+	if err != nil {
+		t.Fatalf("Failed to update custom object: %s", err)
+	}
+
+	if customObject.Description != "Hardware lent to employees" {
+		t.Fatalf("unexpected custom object description: %s", customObject.Description)
+	}
+}
+
+func TestDeleteCustomObject(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+		w.Write(nil)
+	}))
+
+	c := newTestClient(mockAPI)
+	err := c.DeleteCustomObject(ctx, "asset")
+	if err != nil {
+		t.Fatalf("Failed to delete custom object: %s", err)
+	}
+}
+
+func TestGetCustomObjectLimit(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "custom_object_limit.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	limit, err := client.GetCustomObjectLimit(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get custom object limit: %s", err)
+	}
+
+	if limit.Count != 2 || limit.Limit != 50 {
+		t.Fatalf("unexpected custom object limit: %+v", limit)
+	}
+}
+
+func TestUpsertCustomObjectRecord(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+		if externalID := r.URL.Query().Get("external_id"); externalID != "asset-1001" {
+			t.Errorf("unexpected external_id query: %s", externalID)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodPatch, "custom_object_record.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	record, err := client.UpsertCustomObjectRecord(ctx, "asset", "asset-1001", CustomObjectRecord{
+		Name: "MacBook Pro 14",
+		CustomObjectFields: map[string]interface{}{
+			"status": "in_use",
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to upsert custom object record: %s", err)
+	}
+
+	if record.ExternalID != "asset-1001" {
+		t.Fatalf("expected external id is asset-1001, but got %s", record.ExternalID)
+	}
+}
+
+func TestDeleteCustomObjectRecord(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+		w.Write(nil)
+	}))
+
+	c := newTestClient(mockAPI)
+	err := c.DeleteCustomObjectRecord(ctx, "asset", "01GDXYD7ZTWYP542BA8MDDTE36")
+	if err != nil {
+		t.Fatalf("Failed to delete custom object record: %s", err)
+	}
+}
+
+func TestDeleteCustomObjectRecordByExternalID(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if externalID := r.URL.Query().Get("external_id"); externalID != "asset-1001" {
+			t.Errorf("unexpected external_id query: %s", externalID)
+		}
+		w.WriteHeader(http.StatusNoContent)
+		w.Write(nil)
+	}))
+
+	c := newTestClient(mockAPI)
+	err := c.DeleteCustomObjectRecordByExternalID(ctx, "asset", "asset-1001")
+	if err != nil {
+		t.Fatalf("Failed to delete custom object record by external id: %s", err)
+	}
+}
+
+func TestFilteredSearchCustomObjectRecords(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data struct {
+			Filter map[string]map[string]string `json:"filter"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+			t.Errorf("Failed to decode request body: %s", err)
+		}
+		if data.Filter["custom_object_fields.status"]["$eq"] != "in_use" {
+			t.Errorf("unexpected filter: %v", data.Filter)
+		}
+		if size := r.URL.Query().Get("page[size]"); size != "50" {
+			t.Errorf("unexpected page size: %s", size)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodPost, "custom_object_records.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	filter := CustomObjectRecordFilter{
+		"custom_object_fields.status": map[string]interface{}{"$eq": "in_use"},
+	}
+	opts := NewPaginationOptions()
+	opts.PageSize = 50
+	it := client.FilteredSearchCustomObjectRecordsIterator(ctx, "asset", filter, opts)
+
+	var records []CustomObjectRecord
+	for it.HasMore() {
+		page, err := it.GetNext()
+		if err != nil {
+			t.Fatalf("Failed to search custom object records: %s", err)
+		}
+		records = append(records, page...)
+	}
+
+	if len(records) != 2 {
+		t.Fatalf("expected length of custom object records is 2, but got %d", len(records))
+	}
+}
+
+func TestCountCustomObjectRecords(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "custom_object_record_count.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	count, err := client.CountCustomObjectRecords(ctx, "asset")
+	if err != nil {
+		t.Fatalf("Failed to count custom object records: %s", err)
+	}
+
+	if count.Value != 200000 {
+		t.Fatalf("expected count of custom object records is 200000, but got %d", count.Value)
+	}
+}
+
+func TestGetCustomObjectRecordsIterator(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "custom_object_records.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	it := client.GetCustomObjectRecordsIterator(ctx, "asset", NewPaginationOptions())
+
+	var records []CustomObjectRecord
+	for it.HasMore() {
+		page, err := it.GetNext()
+		if err != nil {
+			t.Fatalf("Failed to get custom object records: %s", err)
+		}
+		records = append(records, page...)
+	}
+
+	if len(records) != 2 {
+		t.Fatalf("expected length of custom object records is 2, but got %d", len(records))
+	}
+}
+
+func TestCreateOrUpdateManyCustomObjectRecords(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data struct {
+			Job struct {
+				Action string               `json:"action"`
+				Items  []CustomObjectRecord `json:"items"`
+			} `json:"job"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+			t.Errorf("Failed to decode request body: %s", err)
+		}
+		if data.Job.Action != CustomObjectRecordJobCreateOrUpdate {
+			t.Errorf("unexpected job action: %s", data.Job.Action)
+		}
+		if len(data.Job.Items) != 2 {
+			t.Errorf("expected length of job items is 2, but got %d", len(data.Job.Items))
+		}
+		w.Write(readFixture(filepath.Join(http.MethodPost, "custom_object_job_status.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	status, err := client.CreateOrUpdateManyCustomObjectRecords(ctx, "asset", []CustomObjectRecord{
+		{Name: "MacBook Pro 14", ExternalID: "asset-1001"},
+		{Name: "ThinkPad X1", ExternalID: "asset-1002"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create or update many custom object records: %s", err)
+	}
+
+	if len(status.Results) != 2 || status.Results[0].ID != "01GDXYD7ZTWYP542BA8MDDTE36" {
+		t.Fatalf("unexpected job status results: %v", status.Results)
+	}
+}
+
+func TestDeleteManyCustomObjectRecords(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data struct {
+			Job struct {
+				Action string   `json:"action"`
+				Items  []string `json:"items"`
+			} `json:"job"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+			t.Errorf("Failed to decode request body: %s", err)
+		}
+		if data.Job.Action != CustomObjectRecordJobDelete {
+			t.Errorf("unexpected job action: %s", data.Job.Action)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodPost, "custom_object_job_status.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.DeleteManyCustomObjectRecords(ctx, "asset", []string{"01GDXYD7ZTWYP542BA8MDDTE36"})
+	if err != nil {
+		t.Fatalf("Failed to delete many custom object records: %s", err)
+	}
+}
@@ -0,0 +1,103 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// CustomStatus is struct for custom ticket status payload.
+// Each custom status belongs to one of the status categories "new", "open", "pending", "hold" and "solved".
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/custom_ticket_statuses/
+type CustomStatus struct {
+	ID                    int64      `json:"id,omitempty"`
+	StatusCategory        string     `json:"status_category"`
+	AgentLabel            string     `json:"agent_label"`
+	RawAgentLabel         string     `json:"raw_agent_label,omitempty"`
+	EndUserLabel          string     `json:"end_user_label,omitempty"`
+	RawEndUserLabel       string     `json:"raw_end_user_label,omitempty"`
+	Description           string     `json:"description,omitempty"`
+	RawDescription        string     `json:"raw_description,omitempty"`
+	EndUserDescription    string     `json:"end_user_description,omitempty"`
+	RawEndUserDescription string     `json:"raw_end_user_description,omitempty"`
+	Active                bool       `json:"active"`
+	Default               bool       `json:"default,omitempty"`
+	CreatedAt             *time.Time `json:"created_at,omitempty"`
+	UpdatedAt             *time.Time `json:"updated_at,omitempty"`
+}
+
+// CustomStatusListOptions is options for GetCustomStatuses
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/custom_ticket_statuses/#list-custom-ticket-statuses
+type CustomStatusListOptions struct {
+	// StatusCategories filters custom statuses by status category, such as "open"
+	StatusCategories []string `url:"-"`
+	Active           *bool    `url:"active,omitempty"`
+	Default          *bool    `url:"default,omitempty"`
+}
+
+// CustomStatusAPI an interface containing all custom ticket status related methods
+type CustomStatusAPI interface {
+	GetCustomStatuses(ctx context.Context, opts *CustomStatusListOptions) ([]CustomStatus, error)
+	GetCustomStatus(ctx context.Context, id int64) (CustomStatus, error)
+}
+
+// GetCustomStatuses fetches custom ticket statuses. The endpoint isn't paginated.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/custom_ticket_statuses/#list-custom-ticket-statuses
+func (z *Client) GetCustomStatuses(ctx context.Context, opts *CustomStatusListOptions) ([]CustomStatus, error) {
+	var result struct {
+		CustomStatuses []CustomStatus `json:"custom_statuses"`
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &CustomStatusListOptions{}
+	}
+
+	var req struct {
+		*CustomStatusListOptions
+		StatusCategories string `url:"status_categories,omitempty"`
+	}
+	req.CustomStatusListOptions = tmp
+	req.StatusCategories = strings.Join(tmp.StatusCategories, ",")
+
+	u, err := addOptions("/custom_statuses.json", req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.CustomStatuses, nil
+}
+
+// GetCustomStatus fetches the specified custom ticket status
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/custom_ticket_statuses/#show-custom-ticket-status
+func (z *Client) GetCustomStatus(ctx context.Context, id int64) (CustomStatus, error) {
+	var result struct {
+		CustomStatus CustomStatus `json:"custom_status"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/custom_statuses/%d.json", id))
+	if err != nil {
+		return CustomStatus{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return CustomStatus{}, err
+	}
+	return result.CustomStatus, nil
+}
@@ -0,0 +1,47 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+)
+
+func TestGetCustomStatuses(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if q := r.URL.Query(); q.Get("status_categories") != "open,hold" || q.Get("active") != "true" {
+			t.Errorf("unexpected query %s", r.URL.RawQuery)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "custom_statuses.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	active := true
+	statuses, err := client.GetCustomStatuses(ctx, &CustomStatusListOptions{
+		StatusCategories: []string{"open", "hold"},
+		Active:           &active,
+	})
+	if err != nil {
+		t.Fatalf("Failed to get custom statuses: %s", err)
+	}
+
+	if len(statuses) != 2 {
+		t.Fatalf("expected length of custom statuses is 2, but got %d", len(statuses))
+	}
+}
+
+func TestGetCustomStatus(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "custom_status.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	status, err := client.GetCustomStatus(ctx, 35437)
+	if err != nil {
+		t.Fatalf("Failed to get custom status: %s", err)
+	}
+
+	if status.AgentLabel != "Waiting for vendor" || status.StatusCategory != "hold" {
+		t.Fatalf("unexpected custom status %+v", status)
+	}
+}
@@ -37,6 +37,15 @@ type GroupAPI interface {
 	CreateGroup(ctx context.Context, group Group) (Group, error)
 	UpdateGroup(ctx context.Context, groupID int64, group Group) (Group, error)
 	DeleteGroup(ctx context.Context, groupID int64) error
+	GetAssignableGroupsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[Group]
+	GetAssignableGroupsOBP(ctx context.Context, opts *OBPOptions) ([]Group, Page, error)
+	GetAssignableGroupsCBP(ctx context.Context, opts *CBPOptions) ([]Group, CursorPaginationMeta, error)
+	GetUserGroupsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[Group]
+	GetUserGroupsOBP(ctx context.Context, opts *OBPOptions) ([]Group, Page, error)
+	GetUserGroupsCBP(ctx context.Context, opts *CBPOptions) ([]Group, CursorPaginationMeta, error)
+	GetGroupUsersIterator(ctx context.Context, opts *PaginationOptions) *Iterator[User]
+	GetGroupUsersOBP(ctx context.Context, opts *OBPOptions) ([]User, Page, error)
+	GetGroupUsersCBP(ctx context.Context, opts *CBPOptions) ([]User, CursorPaginationMeta, error)
 }
 
 // GetGroups fetches group list
@@ -3,6 +3,7 @@ package zendesk
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"time"
 )
 
@@ -34,6 +35,21 @@ type (
 		GetGroupMembershipsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[GroupMembership]
 		GetGroupMembershipsOBP(ctx context.Context, opts *OBPOptions) ([]GroupMembership, Page, error)
 		GetGroupMembershipsCBP(ctx context.Context, opts *CBPOptions) ([]GroupMembership, CursorPaginationMeta, error)
+		GetGroupMembership(ctx context.Context, membershipID int64) (GroupMembership, error)
+		CreateGroupMembership(ctx context.Context, membership GroupMembership) (GroupMembership, error)
+		DeleteGroupMembership(ctx context.Context, membershipID int64) error
+		CreateManyGroupMemberships(ctx context.Context, memberships []GroupMembership) (JobStatus, error)
+		DeleteManyGroupMemberships(ctx context.Context, membershipIDs []int64) (JobStatus, error)
+		SetDefaultGroupMembership(ctx context.Context, userID, membershipID int64) ([]GroupMembership, error)
+		GetGroupMembershipsByUserIterator(ctx context.Context, opts *PaginationOptions) *Iterator[GroupMembership]
+		GetGroupMembershipsByUserOBP(ctx context.Context, opts *OBPOptions) ([]GroupMembership, Page, error)
+		GetGroupMembershipsByUserCBP(ctx context.Context, opts *CBPOptions) ([]GroupMembership, CursorPaginationMeta, error)
+		GetGroupMembershipsByGroupIterator(ctx context.Context, opts *PaginationOptions) *Iterator[GroupMembership]
+		GetGroupMembershipsByGroupOBP(ctx context.Context, opts *OBPOptions) ([]GroupMembership, Page, error)
+		GetGroupMembershipsByGroupCBP(ctx context.Context, opts *CBPOptions) ([]GroupMembership, CursorPaginationMeta, error)
+		GetAssignableGroupMembershipsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[GroupMembership]
+		GetAssignableGroupMembershipsOBP(ctx context.Context, opts *OBPOptions) ([]GroupMembership, Page, error)
+		GetAssignableGroupMembershipsCBP(ctx context.Context, opts *CBPOptions) ([]GroupMembership, CursorPaginationMeta, error)
 	}
 )
 
@@ -66,3 +82,105 @@ func (z *Client) GetGroupMemberships(ctx context.Context, opts *GroupMembershipL
 
 	return result.GroupMemberships, result.Page, nil
 }
+
+// GetGroupMembership gets the specified group membership
+// ref: https://developer.zendesk.com/api-reference/ticketing/groups/group_memberships/#show-membership
+func (z *Client) GetGroupMembership(ctx context.Context, membershipID int64) (GroupMembership, error) {
+	var result struct {
+		GroupMembership GroupMembership `json:"group_membership"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/group_memberships/%d.json", membershipID))
+	if err != nil {
+		return GroupMembership{}, err
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return GroupMembership{}, err
+	}
+
+	return result.GroupMembership, nil
+}
+
+// CreateGroupMembership assigns an agent to a group.
+// Only UserID and GroupID of the membership are required.
+// ref: https://developer.zendesk.com/api-reference/ticketing/groups/group_memberships/#create-membership
+func (z *Client) CreateGroupMembership(ctx context.Context, membership GroupMembership) (GroupMembership, error) {
+	var data, result struct {
+		GroupMembership GroupMembership `json:"group_membership"`
+	}
+	data.GroupMembership = membership
+
+	body, err := z.post(ctx, "/group_memberships.json", data)
+	if err != nil {
+		return GroupMembership{}, err
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return GroupMembership{}, err
+	}
+
+	return result.GroupMembership, nil
+}
+
+// DeleteGroupMembership removes an agent from a group
+// ref: https://developer.zendesk.com/api-reference/ticketing/groups/group_memberships/#delete-membership
+func (z *Client) DeleteGroupMembership(ctx context.Context, membershipID int64) error {
+	err := z.delete(ctx, fmt.Sprintf("/group_memberships/%d.json", membershipID))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// CreateManyGroupMemberships enqueues a job to assign up to 100 agents to groups
+// ref: https://developer.zendesk.com/api-reference/ticketing/groups/group_memberships/#bulk-create-memberships
+func (z *Client) CreateManyGroupMemberships(ctx context.Context, memberships []GroupMembership) (JobStatus, error) {
+	var data struct {
+		GroupMemberships []GroupMembership `json:"group_memberships"`
+	}
+	data.GroupMemberships = memberships
+
+	body, err := z.post(ctx, "/group_memberships/create_many.json", data)
+	if err != nil {
+		return JobStatus{}, err
+	}
+
+	return jobStatusResponse(body)
+}
+
+// DeleteManyGroupMemberships enqueues a job to remove up to 100 group memberships
+// ref: https://developer.zendesk.com/api-reference/ticketing/groups/group_memberships/#bulk-delete-memberships
+func (z *Client) DeleteManyGroupMemberships(ctx context.Context, membershipIDs []int64) (JobStatus, error) {
+	u, err := idsOptions("/group_memberships/destroy_many.json", membershipIDs)
+	if err != nil {
+		return JobStatus{}, err
+	}
+
+	body, err := z.deleteWithResponse(ctx, u)
+	if err != nil {
+		return JobStatus{}, err
+	}
+
+	return jobStatusResponse(body)
+}
+
+// SetDefaultGroupMembership sets the default group of an agent and returns the memberships of the agent
+// ref: https://developer.zendesk.com/api-reference/ticketing/groups/group_memberships/#set-membership-as-default
+func (z *Client) SetDefaultGroupMembership(ctx context.Context, userID, membershipID int64) ([]GroupMembership, error) {
+	var result struct {
+		GroupMemberships []GroupMembership `json:"group_memberships"`
+	}
+
+	body, err := z.put(ctx, fmt.Sprintf("/users/%d/group_memberships/%d/make_default.json", userID, membershipID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, err
+	}
+
+	return result.GroupMemberships, nil
+}
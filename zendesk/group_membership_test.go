@@ -2,6 +2,8 @@ package zendesk
 
 import (
 	"net/http"
+	"net/http/httptest"
+	"path/filepath"
 	"testing"
 )
 
@@ -19,3 +21,129 @@ func TestGetGroupMemberships(t *testing.T) {
 		t.Fatalf("expected length of group memberships is 2, but got %d", len(groupMemberships))
 	}
 }
+
+func TestGetGroupMembership(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "group_membership.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	membership, err := client.GetGroupMembership(ctx, 360002440594)
+	if err != nil {
+		t.Fatalf("Failed to get group membership: %s", err)
+	}
+
+	expectedID := int64(360002440594)
+	if membership.ID != expectedID {
+		t.Fatalf("Returned group membership does not have the expected ID %d. Membership id is %d", expectedID, membership.ID)
+	}
+}
+
+func TestCreateGroupMembership(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodPost, "group_membership.json", http.StatusCreated)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	membership, err := client.CreateGroupMembership(ctx, GroupMembership{
+		UserID:  15439980,
+		GroupID: 98907558,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create group membership: %s", err)
+	}
+
+	if membership.GroupID != 98907558 {
+		t.Fatalf("expected group id is 98907558, but got %d", membership.GroupID)
+	}
+}
+
+func TestDeleteGroupMembership(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+		w.Write(nil)
+	}))
+
+	c := newTestClient(mockAPI)
+	err := c.DeleteGroupMembership(ctx, 360002440594)
+	if err != nil {
+		t.Fatalf("Failed to delete group membership: %s", err)
+	}
+}
+
+func TestCreateManyGroupMemberships(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodPost, "job_status.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	status, err := client.CreateManyGroupMemberships(ctx, []GroupMembership{
+		{UserID: 15439980, GroupID: 98907558},
+		{UserID: 15439981, GroupID: 98907558},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create many group memberships: %s", err)
+	}
+
+	if status.Status != JobStatusQueued {
+		t.Fatalf("expected job status is queued, but got %s", status.Status)
+	}
+}
+
+func TestDeleteManyGroupMemberships(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if ids := r.URL.Query().Get("ids"); ids != "360002440594,360002440595" {
+			t.Errorf("unexpected ids query: %s", ids)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodDelete, "job_status.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	status, err := client.DeleteManyGroupMemberships(ctx, []int64{360002440594, 360002440595})
+	if err != nil {
+		t.Fatalf("Failed to delete many group memberships: %s", err)
+	}
+
+	if status.Status != JobStatusQueued {
+		t.Fatalf("expected job status is queued, but got %s", status.Status)
+	}
+}
+
+func TestSetDefaultGroupMembership(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodPut, "group_memberships.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	memberships, err := client.SetDefaultGroupMembership(ctx, 15439980, 360002440594)
+	if err != nil {
+		t.Fatalf("Failed to set default group membership: %s", err)
+	}
+
+	if len(memberships) != 2 {
+		t.Fatalf("expected length of group memberships is 2, but got %d", len(memberships))
+	}
+	if !memberships[0].Default {
+		t.Fatalf("expected first group membership to be default")
+	}
+}
+
+func TestGetGroupMembershipsByUserIterator(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "group_memberships.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	opts := NewPaginationOptions()
+	opts.Id = 15439980
+	it := client.GetGroupMembershipsByUserIterator(ctx, opts)
+
+	var memberships []GroupMembership
+	for it.HasMore() {
+		page, err := it.GetNext()
+		if err != nil {
+			t.Fatalf("Failed to get group memberships of user: %s", err)
+		}
+		memberships = append(memberships, page...)
+	}
+
+	if len(memberships) != 2 {
+		t.Fatalf("expected length of group memberships is 2, but got %d", len(memberships))
+	}
+}
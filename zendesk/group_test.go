@@ -133,3 +133,56 @@ func TestDeleteGroup(t *testing.T) {
 		t.Fatalf("Failed to delete group: %s", err)
 	}
 }
+
+func TestGetAssignableGroupsCBP(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "groups.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	groups, _, err := client.GetAssignableGroupsCBP(ctx, nil)
+	if err != nil {
+		t.Fatalf("Failed to get assignable groups: %s", err)
+	}
+
+	if len(groups) != 1 {
+		t.Fatalf("expected length of groups is 1, but got %d", len(groups))
+	}
+}
+
+func TestGetUserGroupsOBP(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "groups.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	groups, _, err := client.GetUserGroupsOBP(ctx, &OBPOptions{
+		CommonOptions: CommonOptions{
+			Id: 15439980,
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to get groups of user: %s", err)
+	}
+
+	if len(groups) != 1 {
+		t.Fatalf("expected length of groups is 1, but got %d", len(groups))
+	}
+}
+
+func TestGetGroupUsersCBP(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "users.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	users, _, err := client.GetGroupUsersCBP(ctx, &CBPOptions{
+		CommonOptions: CommonOptions{
+			Id: 98907558,
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to get users of group: %s", err)
+	}
+
+	if len(users) != 2 {
+		t.Fatalf("expected length of users is 2, but got %d", len(users))
+	}
+}
@@ -0,0 +1,19 @@
+package zendesk
+
+import "fmt"
+
+// helpCenterPath returns the path of a Help Center endpoint, scoped to the locale if it's not zero.
+// path is relative to /help_center, such as "/articles.json".
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/help_center_introduction/#locales
+func helpCenterPath(locale int, path string) (string, error) {
+	if locale == 0 {
+		return "/help_center" + path, nil
+	}
+
+	text := LocaleTypeText(locale)
+	if text == "" {
+		return "", fmt.Errorf("unknown locale %d", locale)
+	}
+	return "/help_center/" + text + path, nil
+}
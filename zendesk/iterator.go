@@ -137,3 +137,16 @@ func (i *Iterator[T]) GetNext() ([]T, error) {
 	i.pageAfter = meta.AfterCursor
 	return results, nil
 }
+
+// iterateAll fetches the remaining pages of the iterator
+func iterateAll[T any](it *Iterator[T]) ([]T, error) {
+	var all []T
+	for it.HasMore() {
+		items, err := it.GetNext()
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, items...)
+	}
+	return all, nil
+}
@@ -0,0 +1,205 @@
+package zendesk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Job status values
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/job_statuses/#json-format
+const (
+	JobStatusQueued    = "queued"
+	JobStatusWorking   = "working"
+	JobStatusFailed    = "failed"
+	JobStatusCompleted = "completed"
+	JobStatusKilled    = "killed"
+)
+
+// JobStatus is struct for job status payload.
+// Bulk endpoints enqueue a background job and return its status.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/job_statuses/
+type JobStatus struct {
+	ID       string            `json:"id"`
+	URL      string            `json:"url,omitempty"`
+	Total    int64             `json:"total,omitempty"`
+	Progress int64             `json:"progress,omitempty"`
+	Status   string            `json:"status"`
+	Message  string            `json:"message,omitempty"`
+	JobType  string            `json:"job_type,omitempty"`
+	Results  []JobStatusResult `json:"results,omitempty"`
+}
+
+// JobStatusResult is the result of a single item processed by a job
+type JobStatusResult struct {
+	ID         JobStatusResultID `json:"id,omitempty"`
+	Index      int64             `json:"index"`
+	Action     string            `json:"action,omitempty"`
+	Success    bool              `json:"success,omitempty"`
+	Status     string            `json:"status,omitempty"`
+	Error      string            `json:"error,omitempty"`
+	Details    string            `json:"details,omitempty"`
+	ExternalID string            `json:"external_id,omitempty"`
+	Email      string            `json:"email,omitempty"`
+}
+
+// JobStatusResultID is the ID of an item processed by a job.
+// Most jobs return numeric IDs, while custom object record jobs return string IDs.
+type JobStatusResultID string
+
+// UnmarshalJSON accepts both numeric and string IDs
+func (id *JobStatusResultID) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(b, []byte("null")) {
+		*id = ""
+		return nil
+	}
+	if len(b) > 0 && b[0] == '"' {
+		var s string
+		if err := json.Unmarshal(b, &s); err != nil {
+			return err
+		}
+		*id = JobStatusResultID(s)
+		return nil
+	}
+
+	var n json.Number
+	if err := json.Unmarshal(b, &n); err != nil {
+		return err
+	}
+	*id = JobStatusResultID(n.String())
+	return nil
+}
+
+// Int64 returns the numeric value of the ID
+func (id JobStatusResultID) Int64() (int64, error) {
+	return strconv.ParseInt(string(id), 10, 64)
+}
+
+// Done reports whether the job has finished, successfully or not
+func (j JobStatus) Done() bool {
+	switch j.Status {
+	case JobStatusCompleted, JobStatusFailed, JobStatusKilled:
+		return true
+	}
+	return false
+}
+
+// JobStatusAPI an interface containing all job status related methods
+type JobStatusAPI interface {
+	GetJobStatus(ctx context.Context, jobID string) (JobStatus, error)
+	GetManyJobStatuses(ctx context.Context, jobIDs []string) ([]JobStatus, error)
+	WaitJobStatus(ctx context.Context, jobID string, interval time.Duration) (JobStatus, error)
+}
+
+// GetJobStatus returns the specified job status
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/job_statuses/#show-job-status
+func (z *Client) GetJobStatus(ctx context.Context, jobID string) (JobStatus, error) {
+	var result struct {
+		JobStatus JobStatus `json:"job_status"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/job_statuses/%s.json", jobID))
+	if err != nil {
+		return JobStatus{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return JobStatus{}, err
+	}
+	return result.JobStatus, nil
+}
+
+// GetManyJobStatuses returns the specified job statuses
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/job_statuses/#show-many-job-statuses
+func (z *Client) GetManyJobStatuses(ctx context.Context, jobIDs []string) ([]JobStatus, error) {
+	var result struct {
+		JobStatuses []JobStatus `json:"job_statuses"`
+	}
+
+	var req struct {
+		IDs string `url:"ids,omitempty"`
+	}
+	req.IDs = strings.Join(jobIDs, ",")
+
+	u, err := addOptions("/job_statuses/show_many.json", req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.JobStatuses, nil
+}
+
+// WaitJobStatus polls the specified job status every interval until the job is done
+// or ctx is cancelled. The last fetched status is returned in both cases.
+// interval must be positive.
+func (z *Client) WaitJobStatus(ctx context.Context, jobID string, interval time.Duration) (JobStatus, error) {
+	if interval <= 0 {
+		return JobStatus{}, fmt.Errorf("interval must be positive, but got %s", interval)
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var last JobStatus
+	for {
+		status, err := z.GetJobStatus(ctx, jobID)
+		if err != nil {
+			return last, err
+		}
+		if status.Done() {
+			return status, nil
+		}
+		last = status
+
+		select {
+		case <-ctx.Done():
+			return last, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// jobStatusResponse unmarshals the job status returned by a bulk endpoint
+func jobStatusResponse(body []byte) (JobStatus, error) {
+	var result struct {
+		JobStatus JobStatus `json:"job_status"`
+	}
+
+	err := json.Unmarshal(body, &result)
+	if err != nil {
+		return JobStatus{}, err
+	}
+	return result.JobStatus, nil
+}
+
+// idsOptions builds the `ids` query string used by many bulk endpoints
+func idsOptions(path string, ids []int64) (string, error) {
+	var req struct {
+		IDs string `url:"ids,omitempty"`
+	}
+	idStrs := make([]string, len(ids))
+	for i := 0; i < len(ids); i++ {
+		idStrs[i] = strconv.FormatInt(ids[i], 10)
+	}
+	req.IDs = strings.Join(idStrs, ",")
+
+	return addOptions(path, req)
+}
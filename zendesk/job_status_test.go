@@ -0,0 +1,110 @@
+package zendesk
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestGetJobStatus(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "job_status.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	status, err := client.GetJobStatus(ctx, "82de0b044094f0c67893ac9fe64f1a99")
+	if err != nil {
+		t.Fatalf("Failed to get job status: %s", err)
+	}
+
+	if !status.Done() {
+		t.Fatalf("expected job status to be done, but got %s", status.Status)
+	}
+
+	if len(status.Results) != 2 || status.Results[1].ID != "245" {
+		t.Fatalf("unexpected job status results: %v", status.Results)
+	}
+
+	id, err := status.Results[1].ID.Int64()
+	if err != nil || id != 245 {
+		t.Fatalf("expected numeric result id is 245, but got %d: %v", id, err)
+	}
+}
+
+func TestGetManyJobStatuses(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if ids := r.URL.Query().Get("ids"); ids != "8b726e606741012ffc2d782bcb7848fe,e7665094164c498781ebe4c8db6d2af5" {
+			t.Errorf("unexpected ids query: %s", ids)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "job_statuses.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	statuses, err := client.GetManyJobStatuses(ctx, []string{"8b726e606741012ffc2d782bcb7848fe", "e7665094164c498781ebe4c8db6d2af5"})
+	if err != nil {
+		t.Fatalf("Failed to get job statuses: %s", err)
+	}
+
+	if len(statuses) != 2 {
+		t.Fatalf("expected length of job statuses is 2, but got %d", len(statuses))
+	}
+
+	if statuses[1].Done() {
+		t.Fatal("expected working job status not to be done")
+	}
+}
+
+func TestWaitJobStatus(t *testing.T) {
+	requests := 0
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests < 3 {
+			w.Write([]byte(`{"job_status": {"id": "82de0b044094f0c67893ac9fe64f1a99", "status": "working"}}`))
+			return
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "job_status.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	status, err := client.WaitJobStatus(ctx, "82de0b044094f0c67893ac9fe64f1a99", time.Millisecond)
+	if err != nil {
+		t.Fatalf("Failed to wait job status: %s", err)
+	}
+
+	if status.Status != JobStatusCompleted || requests != 3 {
+		t.Fatalf("expected job to be completed after 3 requests, but got %s after %d requests", status.Status, requests)
+	}
+}
+
+func TestWaitJobStatusCancelled(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"job_status": {"id": "82de0b044094f0c67893ac9fe64f1a99", "status": "queued"}}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	cctx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+
+	status, err := client.WaitJobStatus(cctx, "82de0b044094f0c67893ac9fe64f1a99", 5*time.Millisecond)
+	if err == nil {
+		t.Fatal("expected an error when context is cancelled")
+	}
+
+	if status.Status != JobStatusQueued {
+		t.Fatalf("expected last job status to be returned, but got %v", status)
+	}
+}
+
+func TestWaitJobStatusInvalidInterval(t *testing.T) {
+	client, _ := NewClient(nil)
+
+	_, err := client.WaitJobStatus(ctx, "82de0b044094f0c67893ac9fe64f1a99", 0)
+	if err == nil {
+		t.Fatal("expected an error for non-positive interval")
+	}
+}
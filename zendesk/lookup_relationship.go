@@ -0,0 +1,223 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+type (
+	// LookupRelationshipTarget is the object referred to by lookup relationship fields.
+	// Type is one of RelationshipTargetTicket, RelationshipTargetUser, RelationshipTargetOrganization
+	// or the result of CustomObjectRelationshipTarget, and ID is the ID of the ticket, user,
+	// organization or custom object record.
+	LookupRelationshipTarget struct {
+		Type string
+		ID   string
+	}
+
+	// RelationshipFilterDefinitions is the list of conditions available for relationship filters
+	// ref: https://developer.zendesk.com/api-reference/ticketing/lookup_relationships/lookup_relationships/#filter-definitions
+	RelationshipFilterDefinitions struct {
+		ConditionsAll []RelationshipFilterDefinition `json:"conditions_all"`
+		ConditionsAny []RelationshipFilterDefinition `json:"conditions_any"`
+	}
+
+	// RelationshipFilterDefinition describes a condition available for relationship filters
+	RelationshipFilterDefinition struct {
+		Title      string                              `json:"title"`
+		Subject    string                              `json:"subject"`
+		Type       string                              `json:"type"`
+		Group      string                              `json:"group"`
+		Nullable   bool                                `json:"nullable"`
+		Repeatable bool                                `json:"repeatable"`
+		Operators  []RelationshipFilterValueDefinition `json:"operators,omitempty"`
+		Values     []RelationshipFilterValueDefinition `json:"values,omitempty"`
+	}
+
+	// RelationshipFilterValueDefinition is an operator or a value available for a relationship filter condition
+	RelationshipFilterValueDefinition struct {
+		Title    string `json:"title"`
+		Value    string `json:"value"`
+		Enabled  bool   `json:"enabled,omitempty"`
+		Terminal bool   `json:"terminal,omitempty"`
+	}
+
+	// LookupRelationshipAPI is an interface containing lookup relationship related methods.
+	// Each method lists the objects whose lookup relationship field refers to the target.
+	LookupRelationshipAPI interface {
+		GetRelatedTickets(ctx context.Context, target LookupRelationshipTarget, fieldID int64, opts *CBPOptions) ([]Ticket, CursorPaginationMeta, error)
+		GetRelatedTicketsIterator(ctx context.Context, target LookupRelationshipTarget, fieldID int64, opts *PaginationOptions) *Iterator[Ticket]
+		GetRelatedUsers(ctx context.Context, target LookupRelationshipTarget, fieldID int64, opts *CBPOptions) ([]User, CursorPaginationMeta, error)
+		GetRelatedUsersIterator(ctx context.Context, target LookupRelationshipTarget, fieldID int64, opts *PaginationOptions) *Iterator[User]
+		GetRelatedOrganizations(ctx context.Context, target LookupRelationshipTarget, fieldID int64, opts *CBPOptions) ([]Organization, CursorPaginationMeta, error)
+		GetRelatedOrganizationsIterator(ctx context.Context, target LookupRelationshipTarget, fieldID int64, opts *PaginationOptions) *Iterator[Organization]
+		GetRelatedCustomObjectRecords(ctx context.Context, target LookupRelationshipTarget, fieldID int64, customObjectKey string, opts *CBPOptions) ([]CustomObjectRecord, CursorPaginationMeta, error)
+		GetRelatedCustomObjectRecordsIterator(ctx context.Context, target LookupRelationshipTarget, fieldID int64, customObjectKey string, opts *PaginationOptions) *Iterator[CustomObjectRecord]
+		GetRelationshipFilterDefinitions(ctx context.Context, targetType string, sourceType string) (RelationshipFilterDefinitions, error)
+	}
+)
+
+// GetRelatedTickets lists the tickets whose lookup relationship field refers to the target
+// ref: https://developer.zendesk.com/api-reference/ticketing/lookup_relationships/lookup_relationships/#get-sources-by-target
+func (z *Client) GetRelatedTickets(ctx context.Context, target LookupRelationshipTarget, fieldID int64, opts *CBPOptions) ([]Ticket, CursorPaginationMeta, error) {
+	return getRelationshipSources[Ticket](ctx, z, target, fieldID, "tickets", "tickets", opts)
+}
+
+// GetRelatedTicketsIterator returns an iterator over the tickets whose lookup relationship field refers to the target.
+// The endpoint supports cursor based pagination only, so IsCBP is ignored.
+func (z *Client) GetRelatedTicketsIterator(ctx context.Context, target LookupRelationshipTarget, fieldID int64, opts *PaginationOptions) *Iterator[Ticket] {
+	return newRelationshipSourcesIterator(ctx, opts, func(ctx context.Context, opts *CBPOptions) ([]Ticket, CursorPaginationMeta, error) {
+		return z.GetRelatedTickets(ctx, target, fieldID, opts)
+	})
+}
+
+// GetRelatedUsers lists the users whose lookup relationship field refers to the target
+// ref: https://developer.zendesk.com/api-reference/ticketing/lookup_relationships/lookup_relationships/#get-sources-by-target
+func (z *Client) GetRelatedUsers(ctx context.Context, target LookupRelationshipTarget, fieldID int64, opts *CBPOptions) ([]User, CursorPaginationMeta, error) {
+	return getRelationshipSources[User](ctx, z, target, fieldID, "users", "users", opts)
+}
+
+// GetRelatedUsersIterator returns an iterator over the users whose lookup relationship field refers to the target.
+// The endpoint supports cursor based pagination only, so IsCBP is ignored.
+func (z *Client) GetRelatedUsersIterator(ctx context.Context, target LookupRelationshipTarget, fieldID int64, opts *PaginationOptions) *Iterator[User] {
+	return newRelationshipSourcesIterator(ctx, opts, func(ctx context.Context, opts *CBPOptions) ([]User, CursorPaginationMeta, error) {
+		return z.GetRelatedUsers(ctx, target, fieldID, opts)
+	})
+}
+
+// GetRelatedOrganizations lists the organizations whose lookup relationship field refers to the target
+// ref: https://developer.zendesk.com/api-reference/ticketing/lookup_relationships/lookup_relationships/#get-sources-by-target
+func (z *Client) GetRelatedOrganizations(ctx context.Context, target LookupRelationshipTarget, fieldID int64, opts *CBPOptions) ([]Organization, CursorPaginationMeta, error) {
+	return getRelationshipSources[Organization](ctx, z, target, fieldID, "organizations", "organizations", opts)
+}
+
+// GetRelatedOrganizationsIterator returns an iterator over the organizations whose lookup relationship field refers to the target.
+// The endpoint supports cursor based pagination only, so IsCBP is ignored.
+func (z *Client) GetRelatedOrganizationsIterator(ctx context.Context, target LookupRelationshipTarget, fieldID int64, opts *PaginationOptions) *Iterator[Organization] {
+	return newRelationshipSourcesIterator(ctx, opts, func(ctx context.Context, opts *CBPOptions) ([]Organization, CursorPaginationMeta, error) {
+		return z.GetRelatedOrganizations(ctx, target, fieldID, opts)
+	})
+}
+
+// GetRelatedCustomObjectRecords lists the records of the specified custom object
+// whose lookup relationship field refers to the target
+// ref: https://developer.zendesk.com/api-reference/ticketing/lookup_relationships/lookup_relationships/#get-sources-by-target
+func (z *Client) GetRelatedCustomObjectRecords(ctx context.Context, target LookupRelationshipTarget, fieldID int64, customObjectKey string, opts *CBPOptions) ([]CustomObjectRecord, CursorPaginationMeta, error) {
+	source := fmt.Sprintf("custom_objects/%s", customObjectKey)
+	return getRelationshipSources[CustomObjectRecord](ctx, z, target, fieldID, source, "custom_object_records", opts)
+}
+
+// GetRelatedCustomObjectRecordsIterator returns an iterator over the records of the specified custom object
+// whose lookup relationship field refers to the target.
+// The endpoint supports cursor based pagination only, so IsCBP is ignored.
+func (z *Client) GetRelatedCustomObjectRecordsIterator(ctx context.Context, target LookupRelationshipTarget, fieldID int64, customObjectKey string, opts *PaginationOptions) *Iterator[CustomObjectRecord] {
+	return newRelationshipSourcesIterator(ctx, opts, func(ctx context.Context, opts *CBPOptions) ([]CustomObjectRecord, CursorPaginationMeta, error) {
+		return z.GetRelatedCustomObjectRecords(ctx, target, fieldID, customObjectKey, opts)
+	})
+}
+
+// GetRelationshipFilterDefinitions fetches the conditions available for the relationship filter
+// of a lookup field of sourceType which refers to targetType, such as RelationshipTargetUser
+// ref: https://developer.zendesk.com/api-reference/ticketing/lookup_relationships/lookup_relationships/#filter-definitions
+func (z *Client) GetRelationshipFilterDefinitions(ctx context.Context, targetType string, sourceType string) (RelationshipFilterDefinitions, error) {
+	var result struct {
+		Definitions RelationshipFilterDefinitions `json:"definitions"`
+	}
+
+	var opts struct {
+		SourceType string `url:"source_type,omitempty"`
+	}
+	opts.SourceType = sourceType
+
+	u, err := addOptions(fmt.Sprintf("/relationships/definitions/%s", targetType), opts)
+	if err != nil {
+		return RelationshipFilterDefinitions{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return RelationshipFilterDefinitions{}, err
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return RelationshipFilterDefinitions{}, err
+	}
+	return result.Definitions, nil
+}
+
+// path returns the path of the target, such as "tickets/35436"
+func (t LookupRelationshipTarget) path() (string, error) {
+	switch t.Type {
+	case RelationshipTargetTicket:
+		return "tickets/" + t.ID, nil
+	case RelationshipTargetUser:
+		return "users/" + t.ID, nil
+	case RelationshipTargetOrganization:
+		return "organizations/" + t.ID, nil
+	}
+
+	prefix := CustomObjectRelationshipTarget("")
+	if key := strings.TrimPrefix(t.Type, prefix); key != t.Type && key != "" {
+		return fmt.Sprintf("custom_objects/%s/%s", key, t.ID), nil
+	}
+	return "", fmt.Errorf("unknown relationship target type %q", t.Type)
+}
+
+// getRelationshipSources fetches a page of the sources of a lookup relationship field.
+// jsonName is the key of the sources in the response, which depends on the source type.
+func getRelationshipSources[T any](ctx context.Context, z *Client, target LookupRelationshipTarget, fieldID int64, source string, jsonName string, opts *CBPOptions) ([]T, CursorPaginationMeta, error) {
+	targetPath, err := target.path()
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &CBPOptions{}
+	}
+
+	u, err := addOptions(fmt.Sprintf("/%s/relationship_fields/%d/%s", targetPath, fieldID, source), tmp.CursorPagination)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	var result map[string]json.RawMessage
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	var sources []T
+	if raw, ok := result[jsonName]; ok {
+		if err := json.Unmarshal(raw, &sources); err != nil {
+			return nil, CursorPaginationMeta{}, err
+		}
+	}
+
+	var meta CursorPaginationMeta
+	if raw, ok := result["meta"]; ok {
+		if err := json.Unmarshal(raw, &meta); err != nil {
+			return nil, CursorPaginationMeta{}, err
+		}
+	}
+	return sources, meta, nil
+}
+
+func newRelationshipSourcesIterator[T any](ctx context.Context, opts *PaginationOptions, cbpFunc CbpFunc[T]) *Iterator[T] {
+	return &Iterator[T]{
+		CommonOptions: opts.CommonOptions,
+		pageSize:      opts.PageSize,
+		hasMore:       true,
+		isCBP:         true,
+		pageAfter:     "",
+		pageIndex:     1,
+		ctx:           ctx,
+		cbpFunc:       cbpFunc,
+	}
+}
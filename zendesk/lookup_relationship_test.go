@@ -0,0 +1,93 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+)
+
+func TestGetRelatedCustomObjectRecordsIterator(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/tickets/35436/relationship_fields/4398096842883/custom_objects/asset" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "custom_object_records.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	target := LookupRelationshipTarget{Type: RelationshipTargetTicket, ID: "35436"}
+	it := client.GetRelatedCustomObjectRecordsIterator(ctx, target, 4398096842883, "asset", NewPaginationOptions())
+
+	var records []CustomObjectRecord
+	for it.HasMore() {
+		page, err := it.GetNext()
+		if err != nil {
+			t.Fatalf("Failed to get related custom object records: %s", err)
+		}
+		records = append(records, page...)
+	}
+
+	if len(records) != 2 {
+		t.Fatalf("expected length of custom object records is 2, but got %d", len(records))
+	}
+}
+
+func TestGetRelatedUsers(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/custom_objects/asset/01GDXYD7ZTWYP542BA8MDDTE36/relationship_fields/1234/users" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if size := r.URL.Query().Get("page[size]"); size != "10" {
+			t.Errorf("unexpected page size: %s", size)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "users.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	target := LookupRelationshipTarget{Type: CustomObjectRelationshipTarget("asset"), ID: "01GDXYD7ZTWYP542BA8MDDTE36"}
+	users, _, err := client.GetRelatedUsers(ctx, target, 1234, &CBPOptions{
+		CursorPagination: CursorPagination{PageSize: 10},
+	})
+	if err != nil {
+		t.Fatalf("Failed to get related users: %s", err)
+	}
+
+	if len(users) != 2 {
+		t.Fatalf("expected length of users is 2, but got %d", len(users))
+	}
+}
+
+func TestGetRelatedTicketsUnknownTarget(t *testing.T) {
+	client := newTestClient(httptest.NewServer(http.NotFoundHandler()))
+
+	_, _, err := client.GetRelatedTickets(ctx, LookupRelationshipTarget{Type: "zen:brand", ID: "1"}, 1234, nil)
+	if err == nil {
+		t.Fatal("expected an error for an unknown relationship target type")
+	}
+}
+
+func TestGetRelationshipFilterDefinitions(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/relationships/definitions/zen:user" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if source := r.URL.Query().Get("source_type"); source != "zen:custom_object:asset" {
+			t.Errorf("unexpected source_type query: %s", source)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "relationship_filter_definitions.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	definitions, err := client.GetRelationshipFilterDefinitions(ctx, RelationshipTargetUser, CustomObjectRelationshipTarget("asset"))
+	if err != nil {
+		t.Fatalf("Failed to get relationship filter definitions: %s", err)
+	}
+
+	if len(definitions.ConditionsAll) != 1 || len(definitions.ConditionsAll[0].Values) != 3 {
+		t.Fatalf("unexpected relationship filter definitions: %+v", definitions)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"time"
 )
 
@@ -48,6 +49,87 @@ type MacroListOptions struct {
 	SortOrder string `url:"sort_order,omitempty"`
 }
 
+// MacroSearchOptions is parameters used of SearchMacros
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/macros/#search-macros
+type MacroSearchOptions struct {
+	PageOptions
+
+	// Query is matched against the title of macros
+	Query        string `url:"query"`
+	Access       string `url:"access,omitempty"`
+	Active       *bool  `url:"active,omitempty"`
+	Category     int64  `url:"category,omitempty"`
+	GroupID      int64  `url:"group_id,omitempty"`
+	Include      string `url:"include,omitempty"`
+	OnlyViewable bool   `url:"only_viewable,omitempty"`
+
+	// SortBy can take "created_at", "updated_at", "usage_1h", "usage_24h",
+	// "usage_7d", "usage_30d", "alphabetical"
+	SortBy string `url:"sort_by,omitempty"`
+
+	// SortOrder can take "asc" or "desc"
+	SortOrder string `url:"sort_order,omitempty"`
+}
+
+// MacroApplyResult is the result of applying a macro.
+// Applying a macro doesn't change the ticket; the result shows what would be changed.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/macros/#show-changes-to-ticket
+type MacroApplyResult struct {
+	Ticket  MacroTicketChanges  `json:"ticket"`
+	Comment MacroCommentPreview `json:"comment"`
+}
+
+// MacroTicketChanges is the ticket as it would be after applying a macro.
+// Custom field changes are returned in Fields rather than CustomFields.
+type MacroTicketChanges struct {
+	Ticket
+	Fields []CustomField `json:"fields,omitempty"`
+}
+
+// MacroCommentPreview is the comment which would be added by applying a macro
+type MacroCommentPreview struct {
+	Body     string `json:"body,omitempty"`
+	HTMLBody string `json:"html_body,omitempty"`
+
+	// ScopedBody is the list of pairs of channel and body, such as ["channel:all", "Thanks"]
+	ScopedBody [][]string `json:"scoped_body,omitempty"`
+	Public     *bool      `json:"public,omitempty"`
+}
+
+// MacroActionDefinition describes an action available for macros
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/macros/#list-supported-actions-for-macros
+type MacroActionDefinition struct {
+	Title      string                       `json:"title"`
+	Subject    string                       `json:"subject"`
+	Type       string                       `json:"type"`
+	Group      string                       `json:"group"`
+	Nullable   bool                         `json:"nullable"`
+	Repeatable bool                         `json:"repeatable"`
+	Values     []MacroActionValueDefinition `json:"values,omitempty"`
+}
+
+// MacroActionValueDefinition is a value available for a macro action
+type MacroActionValueDefinition struct {
+	Title   string `json:"title"`
+	Value   string `json:"value"`
+	Enabled bool   `json:"enabled"`
+}
+
+// MacroAttachment is a file attached to comments added by a macro
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/macros/#list-macro-attachments
+type MacroAttachment struct {
+	ID          int64     `json:"id,omitempty"`
+	Filename    string    `json:"filename,omitempty"`
+	ContentType string    `json:"content_type,omitempty"`
+	ContentURL  string    `json:"content_url,omitempty"`
+	Size        int64     `json:"size,omitempty"`
+	CreatedAt   time.Time `json:"created_at,omitempty"`
+}
+
 // MacroAPI an interface containing all macro related methods
 type MacroAPI interface {
 	GetMacros(ctx context.Context, opts *MacroListOptions) ([]Macro, Page, error)
@@ -55,6 +137,15 @@ type MacroAPI interface {
 	CreateMacro(ctx context.Context, macro Macro) (Macro, error)
 	UpdateMacro(ctx context.Context, macroID int64, macro Macro) (Macro, error)
 	DeleteMacro(ctx context.Context, macroID int64) error
+	SearchMacros(ctx context.Context, opts *MacroSearchOptions) ([]Macro, Page, error)
+	ApplyMacro(ctx context.Context, macroID int64) (MacroApplyResult, error)
+	ApplyMacroToTicket(ctx context.Context, ticketID, macroID int64) (MacroApplyResult, error)
+	GetMacroCategories(ctx context.Context) ([]string, error)
+	GetMacroActionDefinitions(ctx context.Context) ([]MacroActionDefinition, error)
+	GetMacroAttachments(ctx context.Context, macroID int64) ([]MacroAttachment, error)
+	GetMacroAttachment(ctx context.Context, attachmentID int64) (MacroAttachment, error)
+	CreateMacroAttachment(ctx context.Context, macroID int64, filename string, file io.Reader) (MacroAttachment, error)
+	UploadMacroAttachment(ctx context.Context, filename string, file io.Reader) (MacroAttachment, error)
 	GetMacrosIterator(ctx context.Context, opts *PaginationOptions) *Iterator[Macro]
 	GetMacrosOBP(ctx context.Context, opts *OBPOptions) ([]Macro, Page, error)
 	GetMacrosCBP(ctx context.Context, opts *CBPOptions) ([]Macro, CursorPaginationMeta, error)
@@ -166,3 +257,176 @@ func (z *Client) DeleteMacro(ctx context.Context, macroID int64) error {
 
 	return nil
 }
+
+// SearchMacros searches macros by title
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/macros/#search-macros
+func (z *Client) SearchMacros(ctx context.Context, opts *MacroSearchOptions) ([]Macro, Page, error) {
+	var data struct {
+		Macros []Macro `json:"macros"`
+		Page
+	}
+
+	if opts == nil {
+		return nil, Page{}, &OptionsError{opts}
+	}
+
+	u, err := addOptions("/macros/search.json", opts)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	return data.Macros, data.Page, nil
+}
+
+// ApplyMacro returns the changes the specified macro would make to a new ticket
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/macros/#show-changes-to-ticket
+func (z *Client) ApplyMacro(ctx context.Context, macroID int64) (MacroApplyResult, error) {
+	return z.applyMacro(ctx, fmt.Sprintf("/macros/%d/apply.json", macroID))
+}
+
+// ApplyMacroToTicket returns the full ticket object as it would be if the specified macro were applied to it
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/macros/#show-ticket-after-changes
+func (z *Client) ApplyMacroToTicket(ctx context.Context, ticketID, macroID int64) (MacroApplyResult, error) {
+	return z.applyMacro(ctx, fmt.Sprintf("/tickets/%d/macros/%d/apply.json", ticketID, macroID))
+}
+
+func (z *Client) applyMacro(ctx context.Context, path string) (MacroApplyResult, error) {
+	var result struct {
+		Result MacroApplyResult `json:"result"`
+	}
+
+	body, err := z.get(ctx, path)
+	if err != nil {
+		return MacroApplyResult{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return MacroApplyResult{}, err
+	}
+	return result.Result, nil
+}
+
+// GetMacroCategories lists the categories of all macros
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/macros/#list-macro-categories
+func (z *Client) GetMacroCategories(ctx context.Context) ([]string, error) {
+	var result struct {
+		Categories []string `json:"categories"`
+	}
+
+	body, err := z.get(ctx, "/macros/categories.json")
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Categories, nil
+}
+
+// GetMacroActionDefinitions lists the actions available for macros
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/macros/#list-supported-actions-for-macros
+func (z *Client) GetMacroActionDefinitions(ctx context.Context) ([]MacroActionDefinition, error) {
+	var result struct {
+		Actions []MacroActionDefinition `json:"actions"`
+	}
+
+	body, err := z.get(ctx, "/macros/actions.json")
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Actions, nil
+}
+
+// GetMacroAttachments lists the attachments of the specified macro
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/macros/#list-macro-attachments
+func (z *Client) GetMacroAttachments(ctx context.Context, macroID int64) ([]MacroAttachment, error) {
+	var result struct {
+		MacroAttachments []MacroAttachment `json:"macro_attachments"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/macros/%d/attachments.json", macroID))
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.MacroAttachments, nil
+}
+
+// GetMacroAttachment gets the specified macro attachment
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/macros/#show-macro-attachment
+func (z *Client) GetMacroAttachment(ctx context.Context, attachmentID int64) (MacroAttachment, error) {
+	var result struct {
+		MacroAttachment MacroAttachment `json:"macro_attachment"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/macros/attachments/%d.json", attachmentID))
+	if err != nil {
+		return MacroAttachment{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return MacroAttachment{}, err
+	}
+	return result.MacroAttachment, nil
+}
+
+// CreateMacroAttachment uploads a file and attaches it to the specified macro.
+// A macro can have up to five attachments.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/macros/#create-macro-attachment
+func (z *Client) CreateMacroAttachment(ctx context.Context, macroID int64, filename string, file io.Reader) (MacroAttachment, error) {
+	return z.uploadMacroAttachment(ctx, fmt.Sprintf("/macros/%d/attachments.json", macroID), filename, file)
+}
+
+// UploadMacroAttachment uploads a file which can be associated with a macro later
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/macros/#create-unassociated-macro-attachment
+func (z *Client) UploadMacroAttachment(ctx context.Context, filename string, file io.Reader) (MacroAttachment, error) {
+	return z.uploadMacroAttachment(ctx, "/macros/attachments.json", filename, file)
+}
+
+func (z *Client) uploadMacroAttachment(ctx context.Context, path string, filename string, file io.Reader) (MacroAttachment, error) {
+	var result struct {
+		MacroAttachment MacroAttachment `json:"macro_attachment"`
+	}
+
+	body, err := z.postMultipart(ctx, path, "attachment", filename, file, map[string]string{"filename": filename})
+	if err != nil {
+		return MacroAttachment{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return MacroAttachment{}, err
+	}
+	return result.MacroAttachment, nil
+}
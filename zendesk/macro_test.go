@@ -1,8 +1,11 @@
 package zendesk
 
 import (
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -106,3 +109,188 @@ func TestDeleteMacro(t *testing.T) {
 		t.Fatalf("Failed to delete macro field: %s", err)
 	}
 }
+
+func TestSearchMacros(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/macros/search.json" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if q := r.URL.Query().Get("query"); q != "close" {
+			t.Errorf("unexpected query: %s", q)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "macros.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	macros, _, err := client.SearchMacros(ctx, &MacroSearchOptions{Query: "close"})
+	if err != nil {
+		t.Fatalf("Failed to search macros: %s", err)
+	}
+
+	if len(macros) != 2 {
+		t.Fatalf("expected length of macros is 2, but got %d", len(macros))
+	}
+}
+
+func TestApplyMacro(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/macros/360111062754/apply.json" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "macro_apply.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	result, err := client.ApplyMacro(ctx, 360111062754)
+	if err != nil {
+		t.Fatalf("Failed to apply macro: %s", err)
+	}
+
+	if result.Ticket.Status != "solved" || result.Ticket.AssigneeID != 235323 {
+		t.Fatalf("unexpected ticket changes: %+v", result.Ticket)
+	}
+	if len(result.Ticket.Fields) != 1 || result.Ticket.Fields[0].Value != "745" {
+		t.Fatalf("unexpected field changes: %+v", result.Ticket.Fields)
+	}
+	if result.Comment.Public == nil || !*result.Comment.Public {
+		t.Fatal("expected comment to be public")
+	}
+	if len(result.Comment.ScopedBody) != 1 || result.Comment.ScopedBody[0][0] != "channel:all" {
+		t.Fatalf("unexpected scoped body: %v", result.Comment.ScopedBody)
+	}
+}
+
+func TestApplyMacroToTicket(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/tickets/35436/macros/360111062754/apply.json" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "macro_apply.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	result, err := client.ApplyMacroToTicket(ctx, 35436, 360111062754)
+	if err != nil {
+		t.Fatalf("Failed to apply macro to ticket: %s", err)
+	}
+
+	if result.Ticket.ID != 35436 {
+		t.Fatalf("expected ticket id is 35436, but got %d", result.Ticket.ID)
+	}
+}
+
+func TestGetMacroCategories(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "macro_categories.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	categories, err := client.GetMacroCategories(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get macro categories: %s", err)
+	}
+
+	if len(categories) != 2 {
+		t.Fatalf("expected length of macro categories is 2, but got %d", len(categories))
+	}
+}
+
+func TestGetMacroActionDefinitions(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "macro_actions.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	actions, err := client.GetMacroActionDefinitions(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get macro action definitions: %s", err)
+	}
+
+	if len(actions) != 2 {
+		t.Fatalf("expected length of macro actions is 2, but got %d", len(actions))
+	}
+	if actions[0].Subject != "status" || len(actions[0].Values) != 3 {
+		t.Fatalf("unexpected action definition: %+v", actions[0])
+	}
+}
+
+func TestGetMacroAttachments(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "macro_attachments.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	attachments, err := client.GetMacroAttachments(ctx, 360111062754)
+	if err != nil {
+		t.Fatalf("Failed to get macro attachments: %s", err)
+	}
+
+	if len(attachments) != 2 {
+		t.Fatalf("expected length of macro attachments is 2, but got %d", len(attachments))
+	}
+}
+
+func TestGetMacroAttachment(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "macro_attachment.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	attachment, err := client.GetMacroAttachment(ctx, 100)
+	if err != nil {
+		t.Fatalf("Failed to get macro attachment: %s", err)
+	}
+
+	if attachment.Filename != "foobar.jpg" {
+		t.Fatalf("expected filename is foobar.jpg, but got %s", attachment.Filename)
+	}
+}
+
+func TestCreateMacroAttachment(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/macros/360111062754/attachments.json" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if filename := r.FormValue("filename"); filename != "foobar.jpg" {
+			t.Errorf("unexpected filename: %s", filename)
+		}
+		file, header, err := r.FormFile("attachment")
+		if err != nil {
+			t.Errorf("Failed to read attachment: %s", err)
+		} else {
+			content, _ := io.ReadAll(file)
+			if string(content) != "image" || header.Filename != "foobar.jpg" {
+				t.Errorf("unexpected attachment: %s %s", header.Filename, content)
+			}
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write(readFixture(filepath.Join(http.MethodPost, "macro_attachment.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	attachment, err := client.CreateMacroAttachment(ctx, 360111062754, "foobar.jpg", strings.NewReader("image"))
+	if err != nil {
+		t.Fatalf("Failed to create macro attachment: %s", err)
+	}
+
+	if attachment.ID != 100 {
+		t.Fatalf("expected id is 100, but got %d", attachment.ID)
+	}
+}
+
+func TestUploadMacroAttachment(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/macros/attachments.json" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write(readFixture(filepath.Join(http.MethodPost, "macro_attachment.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.UploadMacroAttachment(ctx, "foobar.jpg", strings.NewReader("image"))
+	if err != nil {
+		t.Fatalf("Failed to upload macro attachment: %s", err)
+	}
+}
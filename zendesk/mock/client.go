@@ -10,7 +10,9 @@ package mock
 
 import (
 	context "context"
+	io "io"
 	reflect "reflect"
+	time "time"
 
 	zendesk "github.com/nukosuke/go-zendesk/zendesk"
 	gomock "go.uber.org/mock/gomock"
@@ -84,6 +86,51 @@ func (mr *ClientMockRecorder) AddUserTags(ctx, userID, tags any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUserTags", reflect.TypeOf((*Client)(nil).AddUserTags), ctx, userID, tags)
 }
 
+// ApplyMacro mocks base method.
+func (m *Client) ApplyMacro(ctx context.Context, macroID int64) (zendesk.MacroApplyResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyMacro", ctx, macroID)
+	ret0, _ := ret[0].(zendesk.MacroApplyResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyMacro indicates an expected call of ApplyMacro.
+func (mr *ClientMockRecorder) ApplyMacro(ctx, macroID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyMacro", reflect.TypeOf((*Client)(nil).ApplyMacro), ctx, macroID)
+}
+
+// ApplyMacroToTicket mocks base method.
+func (m *Client) ApplyMacroToTicket(ctx context.Context, ticketID, macroID int64) (zendesk.MacroApplyResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyMacroToTicket", ctx, ticketID, macroID)
+	ret0, _ := ret[0].(zendesk.MacroApplyResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyMacroToTicket indicates an expected call of ApplyMacroToTicket.
+func (mr *ClientMockRecorder) ApplyMacroToTicket(ctx, ticketID, macroID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyMacroToTicket", reflect.TypeOf((*Client)(nil).ApplyMacroToTicket), ctx, ticketID, macroID)
+}
+
+// AutocompleteOrganizations mocks base method.
+func (m *Client) AutocompleteOrganizations(ctx context.Context, name string) ([]zendesk.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AutocompleteOrganizations", ctx, name)
+	ret0, _ := ret[0].([]zendesk.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AutocompleteOrganizations indicates an expected call of AutocompleteOrganizations.
+func (mr *ClientMockRecorder) AutocompleteOrganizations(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AutocompleteOrganizations", reflect.TypeOf((*Client)(nil).AutocompleteOrganizations), ctx, name)
+}
+
 // AutocompleteSearchCustomObjectRecords mocks base method.
 func (m *Client) AutocompleteSearchCustomObjectRecords(ctx context.Context, customObjectKey string, opts *zendesk.CustomObjectAutocompleteOptions) ([]zendesk.CustomObjectRecord, zendesk.Page, error) {
 	m.ctrl.T.Helper()
@@ -100,6 +147,141 @@ func (mr *ClientMockRecorder) AutocompleteSearchCustomObjectRecords(ctx, customO
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AutocompleteSearchCustomObjectRecords", reflect.TypeOf((*Client)(nil).AutocompleteSearchCustomObjectRecords), ctx, customObjectKey, opts)
 }
 
+// BatchUpdateTriggerCategories mocks base method.
+func (m *Client) BatchUpdateTriggerCategories(ctx context.Context, batch zendesk.TriggerCategoryBatch) ([]zendesk.TriggerCategory, []zendesk.Trigger, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchUpdateTriggerCategories", ctx, batch)
+	ret0, _ := ret[0].([]zendesk.TriggerCategory)
+	ret1, _ := ret[1].([]zendesk.Trigger)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// BatchUpdateTriggerCategories indicates an expected call of BatchUpdateTriggerCategories.
+func (mr *ClientMockRecorder) BatchUpdateTriggerCategories(ctx, batch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchUpdateTriggerCategories", reflect.TypeOf((*Client)(nil).BatchUpdateTriggerCategories), ctx, batch)
+}
+
+// BulkUpdateUsers mocks base method.
+func (m *Client) BulkUpdateUsers(ctx context.Context, userIDs []int64, user zendesk.User) (zendesk.JobStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkUpdateUsers", ctx, userIDs, user)
+	ret0, _ := ret[0].(zendesk.JobStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BulkUpdateUsers indicates an expected call of BulkUpdateUsers.
+func (mr *ClientMockRecorder) BulkUpdateUsers(ctx, userIDs, user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkUpdateUsers", reflect.TypeOf((*Client)(nil).BulkUpdateUsers), ctx, userIDs, user)
+}
+
+// ChangeUserPassword mocks base method.
+func (m *Client) ChangeUserPassword(ctx context.Context, userID int64, previousPassword, password string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeUserPassword", ctx, userID, previousPassword, password)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangeUserPassword indicates an expected call of ChangeUserPassword.
+func (mr *ClientMockRecorder) ChangeUserPassword(ctx, userID, previousPassword, password any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeUserPassword", reflect.TypeOf((*Client)(nil).ChangeUserPassword), ctx, userID, previousPassword, password)
+}
+
+// CloneTicketForm mocks base method.
+func (m *Client) CloneTicketForm(ctx context.Context, id int64, prependCloneTitle bool) (zendesk.TicketForm, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloneTicketForm", ctx, id, prependCloneTitle)
+	ret0, _ := ret[0].(zendesk.TicketForm)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloneTicketForm indicates an expected call of CloneTicketForm.
+func (mr *ClientMockRecorder) CloneTicketForm(ctx, id, prependCloneTitle any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloneTicketForm", reflect.TypeOf((*Client)(nil).CloneTicketForm), ctx, id, prependCloneTitle)
+}
+
+// CloneWebhook mocks base method.
+func (m *Client) CloneWebhook(ctx context.Context, webhookID string) (*zendesk.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloneWebhook", ctx, webhookID)
+	ret0, _ := ret[0].(*zendesk.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloneWebhook indicates an expected call of CloneWebhook.
+func (mr *ClientMockRecorder) CloneWebhook(ctx, webhookID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloneWebhook", reflect.TypeOf((*Client)(nil).CloneWebhook), ctx, webhookID)
+}
+
+// CountCustomObjectRecords mocks base method.
+func (m *Client) CountCustomObjectRecords(ctx context.Context, customObjectKey string) (zendesk.CustomObjectRecordCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountCustomObjectRecords", ctx, customObjectKey)
+	ret0, _ := ret[0].(zendesk.CustomObjectRecordCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountCustomObjectRecords indicates an expected call of CountCustomObjectRecords.
+func (mr *ClientMockRecorder) CountCustomObjectRecords(ctx, customObjectKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountCustomObjectRecords", reflect.TypeOf((*Client)(nil).CountCustomObjectRecords), ctx, customObjectKey)
+}
+
+// CreateArticle mocks base method.
+func (m *Client) CreateArticle(ctx context.Context, locale int, sectionID int64, article zendesk.Article, notifySubscribers bool) (zendesk.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateArticle", ctx, locale, sectionID, article, notifySubscribers)
+	ret0, _ := ret[0].(zendesk.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateArticle indicates an expected call of CreateArticle.
+func (mr *ClientMockRecorder) CreateArticle(ctx, locale, sectionID, article, notifySubscribers any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateArticle", reflect.TypeOf((*Client)(nil).CreateArticle), ctx, locale, sectionID, article, notifySubscribers)
+}
+
+// CreateArticleAttachment mocks base method.
+func (m *Client) CreateArticleAttachment(ctx context.Context, articleID int64, filename string, file io.Reader, inline bool) (zendesk.ArticleAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateArticleAttachment", ctx, articleID, filename, file, inline)
+	ret0, _ := ret[0].(zendesk.ArticleAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateArticleAttachment indicates an expected call of CreateArticleAttachment.
+func (mr *ClientMockRecorder) CreateArticleAttachment(ctx, articleID, filename, file, inline any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateArticleAttachment", reflect.TypeOf((*Client)(nil).CreateArticleAttachment), ctx, articleID, filename, file, inline)
+}
+
+// CreateArticleLabel mocks base method.
+func (m *Client) CreateArticleLabel(ctx context.Context, articleID int64, name string) (zendesk.ArticleLabel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateArticleLabel", ctx, articleID, name)
+	ret0, _ := ret[0].(zendesk.ArticleLabel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateArticleLabel indicates an expected call of CreateArticleLabel.
+func (mr *ClientMockRecorder) CreateArticleLabel(ctx, articleID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateArticleLabel", reflect.TypeOf((*Client)(nil).CreateArticleLabel), ctx, articleID, name)
+}
+
 // CreateAutomation mocks base method.
 func (m *Client) CreateAutomation(ctx context.Context, automation zendesk.Automation) (zendesk.Automation, error) {
 	m.ctrl.T.Helper()
@@ -130,6 +312,66 @@ func (mr *ClientMockRecorder) CreateBrand(ctx, brand any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBrand", reflect.TypeOf((*Client)(nil).CreateBrand), ctx, brand)
 }
 
+// CreateCapacityRule mocks base method.
+func (m *Client) CreateCapacityRule(ctx context.Context, rule zendesk.CapacityRule) (zendesk.CapacityRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCapacityRule", ctx, rule)
+	ret0, _ := ret[0].(zendesk.CapacityRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCapacityRule indicates an expected call of CreateCapacityRule.
+func (mr *ClientMockRecorder) CreateCapacityRule(ctx, rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCapacityRule", reflect.TypeOf((*Client)(nil).CreateCapacityRule), ctx, rule)
+}
+
+// CreateCategory mocks base method.
+func (m *Client) CreateCategory(ctx context.Context, locale int, category zendesk.Category) (zendesk.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCategory", ctx, locale, category)
+	ret0, _ := ret[0].(zendesk.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCategory indicates an expected call of CreateCategory.
+func (mr *ClientMockRecorder) CreateCategory(ctx, locale, category any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCategory", reflect.TypeOf((*Client)(nil).CreateCategory), ctx, locale, category)
+}
+
+// CreateCustomObject mocks base method.
+func (m *Client) CreateCustomObject(ctx context.Context, customObject zendesk.CustomObject) (zendesk.CustomObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCustomObject", ctx, customObject)
+	ret0, _ := ret[0].(zendesk.CustomObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCustomObject indicates an expected call of CreateCustomObject.
+func (mr *ClientMockRecorder) CreateCustomObject(ctx, customObject any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCustomObject", reflect.TypeOf((*Client)(nil).CreateCustomObject), ctx, customObject)
+}
+
+// CreateCustomObjectField mocks base method.
+func (m *Client) CreateCustomObjectField(ctx context.Context, customObjectKey string, field zendesk.CustomObjectField) (zendesk.CustomObjectField, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCustomObjectField", ctx, customObjectKey, field)
+	ret0, _ := ret[0].(zendesk.CustomObjectField)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCustomObjectField indicates an expected call of CreateCustomObjectField.
+func (mr *ClientMockRecorder) CreateCustomObjectField(ctx, customObjectKey, field any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCustomObjectField", reflect.TypeOf((*Client)(nil).CreateCustomObjectField), ctx, customObjectKey, field)
+}
+
 // CreateCustomObjectRecord mocks base method.
 func (m *Client) CreateCustomObjectRecord(ctx context.Context, record zendesk.CustomObjectRecord, customObjectKey string) (zendesk.CustomObjectRecord, error) {
 	m.ctrl.T.Helper()
@@ -175,1302 +417,3646 @@ func (mr *ClientMockRecorder) CreateGroup(ctx, group any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGroup", reflect.TypeOf((*Client)(nil).CreateGroup), ctx, group)
 }
 
-// CreateMacro mocks base method.
-func (m *Client) CreateMacro(ctx context.Context, macro zendesk.Macro) (zendesk.Macro, error) {
+// CreateGroupMembership mocks base method.
+func (m *Client) CreateGroupMembership(ctx context.Context, membership zendesk.GroupMembership) (zendesk.GroupMembership, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateMacro", ctx, macro)
-	ret0, _ := ret[0].(zendesk.Macro)
+	ret := m.ctrl.Call(m, "CreateGroupMembership", ctx, membership)
+	ret0, _ := ret[0].(zendesk.GroupMembership)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateMacro indicates an expected call of CreateMacro.
-func (mr *ClientMockRecorder) CreateMacro(ctx, macro any) *gomock.Call {
+// CreateGroupMembership indicates an expected call of CreateGroupMembership.
+func (mr *ClientMockRecorder) CreateGroupMembership(ctx, membership any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMacro", reflect.TypeOf((*Client)(nil).CreateMacro), ctx, macro)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGroupMembership", reflect.TypeOf((*Client)(nil).CreateGroupMembership), ctx, membership)
 }
 
-// CreateOrUpdateUser mocks base method.
-func (m *Client) CreateOrUpdateUser(ctx context.Context, user zendesk.User) (zendesk.User, error) {
+// CreateHoliday mocks base method.
+func (m *Client) CreateHoliday(ctx context.Context, scheduleID int64, holiday zendesk.Holiday) (zendesk.Holiday, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateOrUpdateUser", ctx, user)
-	ret0, _ := ret[0].(zendesk.User)
+	ret := m.ctrl.Call(m, "CreateHoliday", ctx, scheduleID, holiday)
+	ret0, _ := ret[0].(zendesk.Holiday)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateOrUpdateUser indicates an expected call of CreateOrUpdateUser.
-func (mr *ClientMockRecorder) CreateOrUpdateUser(ctx, user any) *gomock.Call {
+// CreateHoliday indicates an expected call of CreateHoliday.
+func (mr *ClientMockRecorder) CreateHoliday(ctx, scheduleID, holiday any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdateUser", reflect.TypeOf((*Client)(nil).CreateOrUpdateUser), ctx, user)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateHoliday", reflect.TypeOf((*Client)(nil).CreateHoliday), ctx, scheduleID, holiday)
 }
 
-// CreateOrganization mocks base method.
-func (m *Client) CreateOrganization(ctx context.Context, org zendesk.Organization) (zendesk.Organization, error) {
+// CreateMacro mocks base method.
+func (m *Client) CreateMacro(ctx context.Context, macro zendesk.Macro) (zendesk.Macro, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateOrganization", ctx, org)
-	ret0, _ := ret[0].(zendesk.Organization)
+	ret := m.ctrl.Call(m, "CreateMacro", ctx, macro)
+	ret0, _ := ret[0].(zendesk.Macro)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateOrganization indicates an expected call of CreateOrganization.
-func (mr *ClientMockRecorder) CreateOrganization(ctx, org any) *gomock.Call {
+// CreateMacro indicates an expected call of CreateMacro.
+func (mr *ClientMockRecorder) CreateMacro(ctx, macro any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrganization", reflect.TypeOf((*Client)(nil).CreateOrganization), ctx, org)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMacro", reflect.TypeOf((*Client)(nil).CreateMacro), ctx, macro)
 }
 
-// CreateOrganizationField mocks base method.
-func (m *Client) CreateOrganizationField(ctx context.Context, organizationField zendesk.OrganizationField) (zendesk.OrganizationField, error) {
+// CreateMacroAttachment mocks base method.
+func (m *Client) CreateMacroAttachment(ctx context.Context, macroID int64, filename string, file io.Reader) (zendesk.MacroAttachment, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateOrganizationField", ctx, organizationField)
-	ret0, _ := ret[0].(zendesk.OrganizationField)
+	ret := m.ctrl.Call(m, "CreateMacroAttachment", ctx, macroID, filename, file)
+	ret0, _ := ret[0].(zendesk.MacroAttachment)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateOrganizationField indicates an expected call of CreateOrganizationField.
-func (mr *ClientMockRecorder) CreateOrganizationField(ctx, organizationField any) *gomock.Call {
+// CreateMacroAttachment indicates an expected call of CreateMacroAttachment.
+func (mr *ClientMockRecorder) CreateMacroAttachment(ctx, macroID, filename, file any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrganizationField", reflect.TypeOf((*Client)(nil).CreateOrganizationField), ctx, organizationField)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMacroAttachment", reflect.TypeOf((*Client)(nil).CreateMacroAttachment), ctx, macroID, filename, file)
 }
 
-// CreateOrganizationMembership mocks base method.
-func (m *Client) CreateOrganizationMembership(arg0 context.Context, arg1 zendesk.OrganizationMembershipOptions) (zendesk.OrganizationMembership, error) {
+// CreateManyCustomObjectRecords mocks base method.
+func (m *Client) CreateManyCustomObjectRecords(ctx context.Context, customObjectKey string, records []zendesk.CustomObjectRecord) (zendesk.JobStatus, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateOrganizationMembership", arg0, arg1)
-	ret0, _ := ret[0].(zendesk.OrganizationMembership)
+	ret := m.ctrl.Call(m, "CreateManyCustomObjectRecords", ctx, customObjectKey, records)
+	ret0, _ := ret[0].(zendesk.JobStatus)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateOrganizationMembership indicates an expected call of CreateOrganizationMembership.
-func (mr *ClientMockRecorder) CreateOrganizationMembership(arg0, arg1 any) *gomock.Call {
+// CreateManyCustomObjectRecords indicates an expected call of CreateManyCustomObjectRecords.
+func (mr *ClientMockRecorder) CreateManyCustomObjectRecords(ctx, customObjectKey, records any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrganizationMembership", reflect.TypeOf((*Client)(nil).CreateOrganizationMembership), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateManyCustomObjectRecords", reflect.TypeOf((*Client)(nil).CreateManyCustomObjectRecords), ctx, customObjectKey, records)
 }
 
-// CreateSLAPolicy mocks base method.
-func (m *Client) CreateSLAPolicy(ctx context.Context, slaPolicy zendesk.SLAPolicy) (zendesk.SLAPolicy, error) {
+// CreateManyGroupMemberships mocks base method.
+func (m *Client) CreateManyGroupMemberships(ctx context.Context, memberships []zendesk.GroupMembership) (zendesk.JobStatus, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateSLAPolicy", ctx, slaPolicy)
-	ret0, _ := ret[0].(zendesk.SLAPolicy)
+	ret := m.ctrl.Call(m, "CreateManyGroupMemberships", ctx, memberships)
+	ret0, _ := ret[0].(zendesk.JobStatus)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateSLAPolicy indicates an expected call of CreateSLAPolicy.
-func (mr *ClientMockRecorder) CreateSLAPolicy(ctx, slaPolicy any) *gomock.Call {
+// CreateManyGroupMemberships indicates an expected call of CreateManyGroupMemberships.
+func (mr *ClientMockRecorder) CreateManyGroupMemberships(ctx, memberships any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSLAPolicy", reflect.TypeOf((*Client)(nil).CreateSLAPolicy), ctx, slaPolicy)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateManyGroupMemberships", reflect.TypeOf((*Client)(nil).CreateManyGroupMemberships), ctx, memberships)
 }
 
-// CreateTarget mocks base method.
-func (m *Client) CreateTarget(ctx context.Context, ticketField zendesk.Target) (zendesk.Target, error) {
+// CreateManyOrganizationMemberships mocks base method.
+func (m *Client) CreateManyOrganizationMemberships(ctx context.Context, opts []zendesk.OrganizationMembershipOptions) (zendesk.JobStatus, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateTarget", ctx, ticketField)
-	ret0, _ := ret[0].(zendesk.Target)
+	ret := m.ctrl.Call(m, "CreateManyOrganizationMemberships", ctx, opts)
+	ret0, _ := ret[0].(zendesk.JobStatus)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateTarget indicates an expected call of CreateTarget.
-func (mr *ClientMockRecorder) CreateTarget(ctx, ticketField any) *gomock.Call {
+// CreateManyOrganizationMemberships indicates an expected call of CreateManyOrganizationMemberships.
+func (mr *ClientMockRecorder) CreateManyOrganizationMemberships(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTarget", reflect.TypeOf((*Client)(nil).CreateTarget), ctx, ticketField)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateManyOrganizationMemberships", reflect.TypeOf((*Client)(nil).CreateManyOrganizationMemberships), ctx, opts)
 }
 
-// CreateTicket mocks base method.
-func (m *Client) CreateTicket(ctx context.Context, ticket zendesk.Ticket) (zendesk.Ticket, error) {
+// CreateManyOrganizations mocks base method.
+func (m *Client) CreateManyOrganizations(ctx context.Context, orgs []zendesk.Organization) (zendesk.JobStatus, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateTicket", ctx, ticket)
-	ret0, _ := ret[0].(zendesk.Ticket)
+	ret := m.ctrl.Call(m, "CreateManyOrganizations", ctx, orgs)
+	ret0, _ := ret[0].(zendesk.JobStatus)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateTicket indicates an expected call of CreateTicket.
-func (mr *ClientMockRecorder) CreateTicket(ctx, ticket any) *gomock.Call {
+// CreateManyOrganizations indicates an expected call of CreateManyOrganizations.
+func (mr *ClientMockRecorder) CreateManyOrganizations(ctx, orgs any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTicket", reflect.TypeOf((*Client)(nil).CreateTicket), ctx, ticket)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateManyOrganizations", reflect.TypeOf((*Client)(nil).CreateManyOrganizations), ctx, orgs)
 }
 
-// CreateTicketComment mocks base method.
-func (m *Client) CreateTicketComment(ctx context.Context, ticketID int64, ticketComment zendesk.TicketComment) (zendesk.TicketComment, error) {
+// CreateManyUsers mocks base method.
+func (m *Client) CreateManyUsers(ctx context.Context, users []zendesk.User) (zendesk.JobStatus, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateTicketComment", ctx, ticketID, ticketComment)
-	ret0, _ := ret[0].(zendesk.TicketComment)
+	ret := m.ctrl.Call(m, "CreateManyUsers", ctx, users)
+	ret0, _ := ret[0].(zendesk.JobStatus)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateTicketComment indicates an expected call of CreateTicketComment.
-func (mr *ClientMockRecorder) CreateTicketComment(ctx, ticketID, ticketComment any) *gomock.Call {
+// CreateManyUsers indicates an expected call of CreateManyUsers.
+func (mr *ClientMockRecorder) CreateManyUsers(ctx, users any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTicketComment", reflect.TypeOf((*Client)(nil).CreateTicketComment), ctx, ticketID, ticketComment)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateManyUsers", reflect.TypeOf((*Client)(nil).CreateManyUsers), ctx, users)
 }
 
-// CreateTicketField mocks base method.
-func (m *Client) CreateTicketField(ctx context.Context, ticketField zendesk.TicketField) (zendesk.TicketField, error) {
+// CreateOrUpdateManyCustomObjectRecords mocks base method.
+func (m *Client) CreateOrUpdateManyCustomObjectRecords(ctx context.Context, customObjectKey string, records []zendesk.CustomObjectRecord) (zendesk.JobStatus, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateTicketField", ctx, ticketField)
-	ret0, _ := ret[0].(zendesk.TicketField)
+	ret := m.ctrl.Call(m, "CreateOrUpdateManyCustomObjectRecords", ctx, customObjectKey, records)
+	ret0, _ := ret[0].(zendesk.JobStatus)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateTicketField indicates an expected call of CreateTicketField.
-func (mr *ClientMockRecorder) CreateTicketField(ctx, ticketField any) *gomock.Call {
+// CreateOrUpdateManyCustomObjectRecords indicates an expected call of CreateOrUpdateManyCustomObjectRecords.
+func (mr *ClientMockRecorder) CreateOrUpdateManyCustomObjectRecords(ctx, customObjectKey, records any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTicketField", reflect.TypeOf((*Client)(nil).CreateTicketField), ctx, ticketField)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdateManyCustomObjectRecords", reflect.TypeOf((*Client)(nil).CreateOrUpdateManyCustomObjectRecords), ctx, customObjectKey, records)
 }
 
-// CreateTicketForm mocks base method.
-func (m *Client) CreateTicketForm(ctx context.Context, ticketForm zendesk.TicketForm) (zendesk.TicketForm, error) {
+// CreateOrUpdateManyUsers mocks base method.
+func (m *Client) CreateOrUpdateManyUsers(ctx context.Context, users []zendesk.User) (zendesk.JobStatus, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateTicketForm", ctx, ticketForm)
-	ret0, _ := ret[0].(zendesk.TicketForm)
+	ret := m.ctrl.Call(m, "CreateOrUpdateManyUsers", ctx, users)
+	ret0, _ := ret[0].(zendesk.JobStatus)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateTicketForm indicates an expected call of CreateTicketForm.
-func (mr *ClientMockRecorder) CreateTicketForm(ctx, ticketForm any) *gomock.Call {
+// CreateOrUpdateManyUsers indicates an expected call of CreateOrUpdateManyUsers.
+func (mr *ClientMockRecorder) CreateOrUpdateManyUsers(ctx, users any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTicketForm", reflect.TypeOf((*Client)(nil).CreateTicketForm), ctx, ticketForm)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdateManyUsers", reflect.TypeOf((*Client)(nil).CreateOrUpdateManyUsers), ctx, users)
 }
 
-// CreateTrigger mocks base method.
-func (m *Client) CreateTrigger(ctx context.Context, trigger zendesk.Trigger) (zendesk.Trigger, error) {
+// CreateOrUpdateOrganizationFieldOption mocks base method.
+func (m *Client) CreateOrUpdateOrganizationFieldOption(ctx context.Context, fieldID int64, option zendesk.CustomFieldOption) (zendesk.CustomFieldOption, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateTrigger", ctx, trigger)
-	ret0, _ := ret[0].(zendesk.Trigger)
+	ret := m.ctrl.Call(m, "CreateOrUpdateOrganizationFieldOption", ctx, fieldID, option)
+	ret0, _ := ret[0].(zendesk.CustomFieldOption)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateTrigger indicates an expected call of CreateTrigger.
-func (mr *ClientMockRecorder) CreateTrigger(ctx, trigger any) *gomock.Call {
+// CreateOrUpdateOrganizationFieldOption indicates an expected call of CreateOrUpdateOrganizationFieldOption.
+func (mr *ClientMockRecorder) CreateOrUpdateOrganizationFieldOption(ctx, fieldID, option any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTrigger", reflect.TypeOf((*Client)(nil).CreateTrigger), ctx, trigger)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdateOrganizationFieldOption", reflect.TypeOf((*Client)(nil).CreateOrUpdateOrganizationFieldOption), ctx, fieldID, option)
 }
 
-// CreateUser mocks base method.
-func (m *Client) CreateUser(ctx context.Context, user zendesk.User) (zendesk.User, error) {
+// CreateOrUpdateTicketFieldOption mocks base method.
+func (m *Client) CreateOrUpdateTicketFieldOption(ctx context.Context, fieldID int64, option zendesk.CustomFieldOption) (zendesk.CustomFieldOption, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateUser", ctx, user)
-	ret0, _ := ret[0].(zendesk.User)
+	ret := m.ctrl.Call(m, "CreateOrUpdateTicketFieldOption", ctx, fieldID, option)
+	ret0, _ := ret[0].(zendesk.CustomFieldOption)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateUser indicates an expected call of CreateUser.
-func (mr *ClientMockRecorder) CreateUser(ctx, user any) *gomock.Call {
+// CreateOrUpdateTicketFieldOption indicates an expected call of CreateOrUpdateTicketFieldOption.
+func (mr *ClientMockRecorder) CreateOrUpdateTicketFieldOption(ctx, fieldID, option any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*Client)(nil).CreateUser), ctx, user)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdateTicketFieldOption", reflect.TypeOf((*Client)(nil).CreateOrUpdateTicketFieldOption), ctx, fieldID, option)
 }
 
-// CreateUserField mocks base method.
-func (m *Client) CreateUserField(ctx context.Context, userField zendesk.UserField) (zendesk.UserField, error) {
+// CreateOrUpdateUser mocks base method.
+func (m *Client) CreateOrUpdateUser(ctx context.Context, user zendesk.User) (zendesk.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateUserField", ctx, userField)
-	ret0, _ := ret[0].(zendesk.UserField)
+	ret := m.ctrl.Call(m, "CreateOrUpdateUser", ctx, user)
+	ret0, _ := ret[0].(zendesk.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateUserField indicates an expected call of CreateUserField.
-func (mr *ClientMockRecorder) CreateUserField(ctx, userField any) *gomock.Call {
+// CreateOrUpdateUser indicates an expected call of CreateOrUpdateUser.
+func (mr *ClientMockRecorder) CreateOrUpdateUser(ctx, user any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserField", reflect.TypeOf((*Client)(nil).CreateUserField), ctx, userField)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdateUser", reflect.TypeOf((*Client)(nil).CreateOrUpdateUser), ctx, user)
 }
 
-// CreateWebhook mocks base method.
-func (m *Client) CreateWebhook(ctx context.Context, hook *zendesk.Webhook) (*zendesk.Webhook, error) {
+// CreateOrUpdateUserFieldOption mocks base method.
+func (m *Client) CreateOrUpdateUserFieldOption(ctx context.Context, fieldID int64, option zendesk.CustomFieldOption) (zendesk.CustomFieldOption, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateWebhook", ctx, hook)
-	ret0, _ := ret[0].(*zendesk.Webhook)
+	ret := m.ctrl.Call(m, "CreateOrUpdateUserFieldOption", ctx, fieldID, option)
+	ret0, _ := ret[0].(zendesk.CustomFieldOption)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateWebhook indicates an expected call of CreateWebhook.
-func (mr *ClientMockRecorder) CreateWebhook(ctx, hook any) *gomock.Call {
+// CreateOrUpdateUserFieldOption indicates an expected call of CreateOrUpdateUserFieldOption.
+func (mr *ClientMockRecorder) CreateOrUpdateUserFieldOption(ctx, fieldID, option any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhook", reflect.TypeOf((*Client)(nil).CreateWebhook), ctx, hook)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdateUserFieldOption", reflect.TypeOf((*Client)(nil).CreateOrUpdateUserFieldOption), ctx, fieldID, option)
 }
 
-// Delete mocks base method.
-func (m *Client) Delete(ctx context.Context, path string) error {
+// CreateOrganization mocks base method.
+func (m *Client) CreateOrganization(ctx context.Context, org zendesk.Organization) (zendesk.Organization, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, path)
-	ret0, _ := ret[0].(error)
+	ret := m.ctrl.Call(m, "CreateOrganization", ctx, org)
+	ret0, _ := ret[0].(zendesk.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrganization indicates an expected call of CreateOrganization.
+func (mr *ClientMockRecorder) CreateOrganization(ctx, org any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrganization", reflect.TypeOf((*Client)(nil).CreateOrganization), ctx, org)
+}
+
+// CreateOrganizationField mocks base method.
+func (m *Client) CreateOrganizationField(ctx context.Context, organizationField zendesk.OrganizationField) (zendesk.OrganizationField, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrganizationField", ctx, organizationField)
+	ret0, _ := ret[0].(zendesk.OrganizationField)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrganizationField indicates an expected call of CreateOrganizationField.
+func (mr *ClientMockRecorder) CreateOrganizationField(ctx, organizationField any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrganizationField", reflect.TypeOf((*Client)(nil).CreateOrganizationField), ctx, organizationField)
+}
+
+// CreateOrganizationMembership mocks base method.
+func (m *Client) CreateOrganizationMembership(arg0 context.Context, arg1 zendesk.OrganizationMembershipOptions) (zendesk.OrganizationMembership, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrganizationMembership", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.OrganizationMembership)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrganizationMembership indicates an expected call of CreateOrganizationMembership.
+func (mr *ClientMockRecorder) CreateOrganizationMembership(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrganizationMembership", reflect.TypeOf((*Client)(nil).CreateOrganizationMembership), arg0, arg1)
+}
+
+// CreateOrganizationSubscription mocks base method.
+func (m *Client) CreateOrganizationSubscription(ctx context.Context, subscription zendesk.OrganizationSubscription) (zendesk.OrganizationSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrganizationSubscription", ctx, subscription)
+	ret0, _ := ret[0].(zendesk.OrganizationSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrganizationSubscription indicates an expected call of CreateOrganizationSubscription.
+func (mr *ClientMockRecorder) CreateOrganizationSubscription(ctx, subscription any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrganizationSubscription", reflect.TypeOf((*Client)(nil).CreateOrganizationSubscription), ctx, subscription)
+}
+
+// CreateRoutingAttribute mocks base method.
+func (m *Client) CreateRoutingAttribute(ctx context.Context, attribute zendesk.RoutingAttribute) (zendesk.RoutingAttribute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRoutingAttribute", ctx, attribute)
+	ret0, _ := ret[0].(zendesk.RoutingAttribute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRoutingAttribute indicates an expected call of CreateRoutingAttribute.
+func (mr *ClientMockRecorder) CreateRoutingAttribute(ctx, attribute any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRoutingAttribute", reflect.TypeOf((*Client)(nil).CreateRoutingAttribute), ctx, attribute)
+}
+
+// CreateRoutingAttributeValue mocks base method.
+func (m *Client) CreateRoutingAttributeValue(ctx context.Context, attributeID string, value zendesk.RoutingAttributeValue) (zendesk.RoutingAttributeValue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRoutingAttributeValue", ctx, attributeID, value)
+	ret0, _ := ret[0].(zendesk.RoutingAttributeValue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRoutingAttributeValue indicates an expected call of CreateRoutingAttributeValue.
+func (mr *ClientMockRecorder) CreateRoutingAttributeValue(ctx, attributeID, value any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRoutingAttributeValue", reflect.TypeOf((*Client)(nil).CreateRoutingAttributeValue), ctx, attributeID, value)
+}
+
+// CreateRoutingQueue mocks base method.
+func (m *Client) CreateRoutingQueue(ctx context.Context, queue zendesk.RoutingQueue) (zendesk.RoutingQueue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRoutingQueue", ctx, queue)
+	ret0, _ := ret[0].(zendesk.RoutingQueue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRoutingQueue indicates an expected call of CreateRoutingQueue.
+func (mr *ClientMockRecorder) CreateRoutingQueue(ctx, queue any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRoutingQueue", reflect.TypeOf((*Client)(nil).CreateRoutingQueue), ctx, queue)
+}
+
+// CreateSLAPolicy mocks base method.
+func (m *Client) CreateSLAPolicy(ctx context.Context, slaPolicy zendesk.SLAPolicy) (zendesk.SLAPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSLAPolicy", ctx, slaPolicy)
+	ret0, _ := ret[0].(zendesk.SLAPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSLAPolicy indicates an expected call of CreateSLAPolicy.
+func (mr *ClientMockRecorder) CreateSLAPolicy(ctx, slaPolicy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSLAPolicy", reflect.TypeOf((*Client)(nil).CreateSLAPolicy), ctx, slaPolicy)
+}
+
+// CreateSchedule mocks base method.
+func (m *Client) CreateSchedule(ctx context.Context, schedule zendesk.Schedule) (zendesk.Schedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSchedule", ctx, schedule)
+	ret0, _ := ret[0].(zendesk.Schedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSchedule indicates an expected call of CreateSchedule.
+func (mr *ClientMockRecorder) CreateSchedule(ctx, schedule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSchedule", reflect.TypeOf((*Client)(nil).CreateSchedule), ctx, schedule)
+}
+
+// CreateSection mocks base method.
+func (m *Client) CreateSection(ctx context.Context, locale int, categoryID int64, section zendesk.Section) (zendesk.Section, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSection", ctx, locale, categoryID, section)
+	ret0, _ := ret[0].(zendesk.Section)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSection indicates an expected call of CreateSection.
+func (mr *ClientMockRecorder) CreateSection(ctx, locale, categoryID, section any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSection", reflect.TypeOf((*Client)(nil).CreateSection), ctx, locale, categoryID, section)
+}
+
+// CreateTarget mocks base method.
+func (m *Client) CreateTarget(ctx context.Context, ticketField zendesk.Target) (zendesk.Target, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTarget", ctx, ticketField)
+	ret0, _ := ret[0].(zendesk.Target)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTarget indicates an expected call of CreateTarget.
+func (mr *ClientMockRecorder) CreateTarget(ctx, ticketField any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTarget", reflect.TypeOf((*Client)(nil).CreateTarget), ctx, ticketField)
+}
+
+// CreateTicket mocks base method.
+func (m *Client) CreateTicket(ctx context.Context, ticket zendesk.Ticket) (zendesk.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTicket", ctx, ticket)
+	ret0, _ := ret[0].(zendesk.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTicket indicates an expected call of CreateTicket.
+func (mr *ClientMockRecorder) CreateTicket(ctx, ticket any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTicket", reflect.TypeOf((*Client)(nil).CreateTicket), ctx, ticket)
+}
+
+// CreateTicketComment mocks base method.
+func (m *Client) CreateTicketComment(ctx context.Context, ticketID int64, ticketComment zendesk.TicketComment) (zendesk.TicketComment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTicketComment", ctx, ticketID, ticketComment)
+	ret0, _ := ret[0].(zendesk.TicketComment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTicketComment indicates an expected call of CreateTicketComment.
+func (mr *ClientMockRecorder) CreateTicketComment(ctx, ticketID, ticketComment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTicketComment", reflect.TypeOf((*Client)(nil).CreateTicketComment), ctx, ticketID, ticketComment)
+}
+
+// CreateTicketField mocks base method.
+func (m *Client) CreateTicketField(ctx context.Context, ticketField zendesk.TicketField) (zendesk.TicketField, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTicketField", ctx, ticketField)
+	ret0, _ := ret[0].(zendesk.TicketField)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTicketField indicates an expected call of CreateTicketField.
+func (mr *ClientMockRecorder) CreateTicketField(ctx, ticketField any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTicketField", reflect.TypeOf((*Client)(nil).CreateTicketField), ctx, ticketField)
+}
+
+// CreateTicketForm mocks base method.
+func (m *Client) CreateTicketForm(ctx context.Context, ticketForm zendesk.TicketForm) (zendesk.TicketForm, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTicketForm", ctx, ticketForm)
+	ret0, _ := ret[0].(zendesk.TicketForm)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTicketForm indicates an expected call of CreateTicketForm.
+func (mr *ClientMockRecorder) CreateTicketForm(ctx, ticketForm any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTicketForm", reflect.TypeOf((*Client)(nil).CreateTicketForm), ctx, ticketForm)
+}
+
+// CreateTranslation mocks base method.
+func (m *Client) CreateTranslation(ctx context.Context, source zendesk.TranslationSource, sourceID int64, translation zendesk.Translation) (zendesk.Translation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTranslation", ctx, source, sourceID, translation)
+	ret0, _ := ret[0].(zendesk.Translation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTranslation indicates an expected call of CreateTranslation.
+func (mr *ClientMockRecorder) CreateTranslation(ctx, source, sourceID, translation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTranslation", reflect.TypeOf((*Client)(nil).CreateTranslation), ctx, source, sourceID, translation)
+}
+
+// CreateTrigger mocks base method.
+func (m *Client) CreateTrigger(ctx context.Context, trigger zendesk.Trigger) (zendesk.Trigger, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTrigger", ctx, trigger)
+	ret0, _ := ret[0].(zendesk.Trigger)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTrigger indicates an expected call of CreateTrigger.
+func (mr *ClientMockRecorder) CreateTrigger(ctx, trigger any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTrigger", reflect.TypeOf((*Client)(nil).CreateTrigger), ctx, trigger)
+}
+
+// CreateTriggerCategory mocks base method.
+func (m *Client) CreateTriggerCategory(ctx context.Context, category zendesk.TriggerCategory) (zendesk.TriggerCategory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTriggerCategory", ctx, category)
+	ret0, _ := ret[0].(zendesk.TriggerCategory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTriggerCategory indicates an expected call of CreateTriggerCategory.
+func (mr *ClientMockRecorder) CreateTriggerCategory(ctx, category any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTriggerCategory", reflect.TypeOf((*Client)(nil).CreateTriggerCategory), ctx, category)
+}
+
+// CreateUser mocks base method.
+func (m *Client) CreateUser(ctx context.Context, user zendesk.User) (zendesk.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUser", ctx, user)
+	ret0, _ := ret[0].(zendesk.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUser indicates an expected call of CreateUser.
+func (mr *ClientMockRecorder) CreateUser(ctx, user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*Client)(nil).CreateUser), ctx, user)
+}
+
+// CreateUserField mocks base method.
+func (m *Client) CreateUserField(ctx context.Context, userField zendesk.UserField) (zendesk.UserField, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUserField", ctx, userField)
+	ret0, _ := ret[0].(zendesk.UserField)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUserField indicates an expected call of CreateUserField.
+func (mr *ClientMockRecorder) CreateUserField(ctx, userField any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserField", reflect.TypeOf((*Client)(nil).CreateUserField), ctx, userField)
+}
+
+// CreateUserIdentity mocks base method.
+func (m *Client) CreateUserIdentity(ctx context.Context, userID int64, identity zendesk.UserIdentity) (zendesk.UserIdentity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUserIdentity", ctx, userID, identity)
+	ret0, _ := ret[0].(zendesk.UserIdentity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUserIdentity indicates an expected call of CreateUserIdentity.
+func (mr *ClientMockRecorder) CreateUserIdentity(ctx, userID, identity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserIdentity", reflect.TypeOf((*Client)(nil).CreateUserIdentity), ctx, userID, identity)
+}
+
+// CreateView mocks base method.
+func (m *Client) CreateView(ctx context.Context, view zendesk.View) (zendesk.View, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateView", ctx, view)
+	ret0, _ := ret[0].(zendesk.View)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateView indicates an expected call of CreateView.
+func (mr *ClientMockRecorder) CreateView(ctx, view any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateView", reflect.TypeOf((*Client)(nil).CreateView), ctx, view)
+}
+
+// CreateWebhook mocks base method.
+func (m *Client) CreateWebhook(ctx context.Context, hook *zendesk.Webhook) (*zendesk.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhook", ctx, hook)
+	ret0, _ := ret[0].(*zendesk.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWebhook indicates an expected call of CreateWebhook.
+func (mr *ClientMockRecorder) CreateWebhook(ctx, hook any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhook", reflect.TypeOf((*Client)(nil).CreateWebhook), ctx, hook)
+}
+
+// Delete mocks base method.
+func (m *Client) Delete(ctx context.Context, path string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, path)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *ClientMockRecorder) Delete(ctx, path any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*Client)(nil).Delete), ctx, path)
+}
+
+// DeleteArticle mocks base method.
+func (m *Client) DeleteArticle(ctx context.Context, articleID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteArticle", ctx, articleID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteArticle indicates an expected call of DeleteArticle.
+func (mr *ClientMockRecorder) DeleteArticle(ctx, articleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteArticle", reflect.TypeOf((*Client)(nil).DeleteArticle), ctx, articleID)
+}
+
+// DeleteArticleAttachment mocks base method.
+func (m *Client) DeleteArticleAttachment(ctx context.Context, attachmentID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteArticleAttachment", ctx, attachmentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteArticleAttachment indicates an expected call of DeleteArticleAttachment.
+func (mr *ClientMockRecorder) DeleteArticleAttachment(ctx, attachmentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteArticleAttachment", reflect.TypeOf((*Client)(nil).DeleteArticleAttachment), ctx, attachmentID)
+}
+
+// DeleteArticleLabel mocks base method.
+func (m *Client) DeleteArticleLabel(ctx context.Context, articleID, labelID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteArticleLabel", ctx, articleID, labelID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteArticleLabel indicates an expected call of DeleteArticleLabel.
+func (mr *ClientMockRecorder) DeleteArticleLabel(ctx, articleID, labelID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteArticleLabel", reflect.TypeOf((*Client)(nil).DeleteArticleLabel), ctx, articleID, labelID)
+}
+
+// DeleteAutomation mocks base method.
+func (m *Client) DeleteAutomation(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAutomation", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAutomation indicates an expected call of DeleteAutomation.
+func (mr *ClientMockRecorder) DeleteAutomation(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAutomation", reflect.TypeOf((*Client)(nil).DeleteAutomation), ctx, id)
+}
+
+// DeleteBrand mocks base method.
+func (m *Client) DeleteBrand(ctx context.Context, brandID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBrand", ctx, brandID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBrand indicates an expected call of DeleteBrand.
+func (mr *ClientMockRecorder) DeleteBrand(ctx, brandID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBrand", reflect.TypeOf((*Client)(nil).DeleteBrand), ctx, brandID)
+}
+
+// DeleteCapacityRule mocks base method.
+func (m *Client) DeleteCapacityRule(ctx context.Context, ruleID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCapacityRule", ctx, ruleID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCapacityRule indicates an expected call of DeleteCapacityRule.
+func (mr *ClientMockRecorder) DeleteCapacityRule(ctx, ruleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCapacityRule", reflect.TypeOf((*Client)(nil).DeleteCapacityRule), ctx, ruleID)
+}
+
+// DeleteCategory mocks base method.
+func (m *Client) DeleteCategory(ctx context.Context, categoryID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCategory", ctx, categoryID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCategory indicates an expected call of DeleteCategory.
+func (mr *ClientMockRecorder) DeleteCategory(ctx, categoryID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCategory", reflect.TypeOf((*Client)(nil).DeleteCategory), ctx, categoryID)
+}
+
+// DeleteCustomObject mocks base method.
+func (m *Client) DeleteCustomObject(ctx context.Context, customObjectKey string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCustomObject", ctx, customObjectKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCustomObject indicates an expected call of DeleteCustomObject.
+func (mr *ClientMockRecorder) DeleteCustomObject(ctx, customObjectKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCustomObject", reflect.TypeOf((*Client)(nil).DeleteCustomObject), ctx, customObjectKey)
+}
+
+// DeleteCustomObjectField mocks base method.
+func (m *Client) DeleteCustomObjectField(ctx context.Context, customObjectKey, fieldKeyOrID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCustomObjectField", ctx, customObjectKey, fieldKeyOrID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCustomObjectField indicates an expected call of DeleteCustomObjectField.
+func (mr *ClientMockRecorder) DeleteCustomObjectField(ctx, customObjectKey, fieldKeyOrID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCustomObjectField", reflect.TypeOf((*Client)(nil).DeleteCustomObjectField), ctx, customObjectKey, fieldKeyOrID)
+}
+
+// DeleteCustomObjectRecord mocks base method.
+func (m *Client) DeleteCustomObjectRecord(ctx context.Context, customObjectKey, customObjectRecordID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCustomObjectRecord", ctx, customObjectKey, customObjectRecordID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCustomObjectRecord indicates an expected call of DeleteCustomObjectRecord.
+func (mr *ClientMockRecorder) DeleteCustomObjectRecord(ctx, customObjectKey, customObjectRecordID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCustomObjectRecord", reflect.TypeOf((*Client)(nil).DeleteCustomObjectRecord), ctx, customObjectKey, customObjectRecordID)
+}
+
+// DeleteCustomObjectRecordByExternalID mocks base method.
+func (m *Client) DeleteCustomObjectRecordByExternalID(ctx context.Context, customObjectKey, externalID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCustomObjectRecordByExternalID", ctx, customObjectKey, externalID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCustomObjectRecordByExternalID indicates an expected call of DeleteCustomObjectRecordByExternalID.
+func (mr *ClientMockRecorder) DeleteCustomObjectRecordByExternalID(ctx, customObjectKey, externalID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCustomObjectRecordByExternalID", reflect.TypeOf((*Client)(nil).DeleteCustomObjectRecordByExternalID), ctx, customObjectKey, externalID)
+}
+
+// DeleteDynamicContentItem mocks base method.
+func (m *Client) DeleteDynamicContentItem(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDynamicContentItem", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDynamicContentItem indicates an expected call of DeleteDynamicContentItem.
+func (mr *ClientMockRecorder) DeleteDynamicContentItem(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDynamicContentItem", reflect.TypeOf((*Client)(nil).DeleteDynamicContentItem), ctx, id)
+}
+
+// DeleteGroup mocks base method.
+func (m *Client) DeleteGroup(ctx context.Context, groupID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGroup", ctx, groupID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteGroup indicates an expected call of DeleteGroup.
+func (mr *ClientMockRecorder) DeleteGroup(ctx, groupID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGroup", reflect.TypeOf((*Client)(nil).DeleteGroup), ctx, groupID)
+}
+
+// DeleteGroupMembership mocks base method.
+func (m *Client) DeleteGroupMembership(ctx context.Context, membershipID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGroupMembership", ctx, membershipID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteGroupMembership indicates an expected call of DeleteGroupMembership.
+func (mr *ClientMockRecorder) DeleteGroupMembership(ctx, membershipID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGroupMembership", reflect.TypeOf((*Client)(nil).DeleteGroupMembership), ctx, membershipID)
+}
+
+// DeleteHoliday mocks base method.
+func (m *Client) DeleteHoliday(ctx context.Context, scheduleID, holidayID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteHoliday", ctx, scheduleID, holidayID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteHoliday indicates an expected call of DeleteHoliday.
+func (mr *ClientMockRecorder) DeleteHoliday(ctx, scheduleID, holidayID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteHoliday", reflect.TypeOf((*Client)(nil).DeleteHoliday), ctx, scheduleID, holidayID)
+}
+
+// DeleteMacro mocks base method.
+func (m *Client) DeleteMacro(ctx context.Context, macroID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMacro", ctx, macroID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMacro indicates an expected call of DeleteMacro.
+func (mr *ClientMockRecorder) DeleteMacro(ctx, macroID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMacro", reflect.TypeOf((*Client)(nil).DeleteMacro), ctx, macroID)
+}
+
+// DeleteManyCustomObjectRecords mocks base method.
+func (m *Client) DeleteManyCustomObjectRecords(ctx context.Context, customObjectKey string, customObjectRecordIDs []string) (zendesk.JobStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteManyCustomObjectRecords", ctx, customObjectKey, customObjectRecordIDs)
+	ret0, _ := ret[0].(zendesk.JobStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteManyCustomObjectRecords indicates an expected call of DeleteManyCustomObjectRecords.
+func (mr *ClientMockRecorder) DeleteManyCustomObjectRecords(ctx, customObjectKey, customObjectRecordIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteManyCustomObjectRecords", reflect.TypeOf((*Client)(nil).DeleteManyCustomObjectRecords), ctx, customObjectKey, customObjectRecordIDs)
+}
+
+// DeleteManyCustomObjectRecordsByExternalID mocks base method.
+func (m *Client) DeleteManyCustomObjectRecordsByExternalID(ctx context.Context, customObjectKey string, externalIDs []string) (zendesk.JobStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteManyCustomObjectRecordsByExternalID", ctx, customObjectKey, externalIDs)
+	ret0, _ := ret[0].(zendesk.JobStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteManyCustomObjectRecordsByExternalID indicates an expected call of DeleteManyCustomObjectRecordsByExternalID.
+func (mr *ClientMockRecorder) DeleteManyCustomObjectRecordsByExternalID(ctx, customObjectKey, externalIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteManyCustomObjectRecordsByExternalID", reflect.TypeOf((*Client)(nil).DeleteManyCustomObjectRecordsByExternalID), ctx, customObjectKey, externalIDs)
+}
+
+// DeleteManyGroupMemberships mocks base method.
+func (m *Client) DeleteManyGroupMemberships(ctx context.Context, membershipIDs []int64) (zendesk.JobStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteManyGroupMemberships", ctx, membershipIDs)
+	ret0, _ := ret[0].(zendesk.JobStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteManyGroupMemberships indicates an expected call of DeleteManyGroupMemberships.
+func (mr *ClientMockRecorder) DeleteManyGroupMemberships(ctx, membershipIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteManyGroupMemberships", reflect.TypeOf((*Client)(nil).DeleteManyGroupMemberships), ctx, membershipIDs)
+}
+
+// DeleteManyOrganizationMemberships mocks base method.
+func (m *Client) DeleteManyOrganizationMemberships(ctx context.Context, membershipIDs []int64) (zendesk.JobStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteManyOrganizationMemberships", ctx, membershipIDs)
+	ret0, _ := ret[0].(zendesk.JobStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteManyOrganizationMemberships indicates an expected call of DeleteManyOrganizationMemberships.
+func (mr *ClientMockRecorder) DeleteManyOrganizationMemberships(ctx, membershipIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteManyOrganizationMemberships", reflect.TypeOf((*Client)(nil).DeleteManyOrganizationMemberships), ctx, membershipIDs)
+}
+
+// DeleteManyOrganizations mocks base method.
+func (m *Client) DeleteManyOrganizations(ctx context.Context, orgIDs []int64) (zendesk.JobStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteManyOrganizations", ctx, orgIDs)
+	ret0, _ := ret[0].(zendesk.JobStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteManyOrganizations indicates an expected call of DeleteManyOrganizations.
+func (mr *ClientMockRecorder) DeleteManyOrganizations(ctx, orgIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteManyOrganizations", reflect.TypeOf((*Client)(nil).DeleteManyOrganizations), ctx, orgIDs)
+}
+
+// DeleteManyTriggers mocks base method.
+func (m *Client) DeleteManyTriggers(ctx context.Context, ids []int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteManyTriggers", ctx, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteManyTriggers indicates an expected call of DeleteManyTriggers.
+func (mr *ClientMockRecorder) DeleteManyTriggers(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteManyTriggers", reflect.TypeOf((*Client)(nil).DeleteManyTriggers), ctx, ids)
+}
+
+// DeleteManyUsers mocks base method.
+func (m *Client) DeleteManyUsers(ctx context.Context, userIDs []int64) (zendesk.JobStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteManyUsers", ctx, userIDs)
+	ret0, _ := ret[0].(zendesk.JobStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteManyUsers indicates an expected call of DeleteManyUsers.
+func (mr *ClientMockRecorder) DeleteManyUsers(ctx, userIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteManyUsers", reflect.TypeOf((*Client)(nil).DeleteManyUsers), ctx, userIDs)
+}
+
+// DeleteOrganization mocks base method.
+func (m *Client) DeleteOrganization(ctx context.Context, orgID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOrganization", ctx, orgID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteOrganization indicates an expected call of DeleteOrganization.
+func (mr *ClientMockRecorder) DeleteOrganization(ctx, orgID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrganization", reflect.TypeOf((*Client)(nil).DeleteOrganization), ctx, orgID)
+}
+
+// DeleteOrganizationFieldOption mocks base method.
+func (m *Client) DeleteOrganizationFieldOption(ctx context.Context, fieldID, optionID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOrganizationFieldOption", ctx, fieldID, optionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteOrganizationFieldOption indicates an expected call of DeleteOrganizationFieldOption.
+func (mr *ClientMockRecorder) DeleteOrganizationFieldOption(ctx, fieldID, optionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrganizationFieldOption", reflect.TypeOf((*Client)(nil).DeleteOrganizationFieldOption), ctx, fieldID, optionID)
+}
+
+// DeleteOrganizationMembership mocks base method.
+func (m *Client) DeleteOrganizationMembership(ctx context.Context, membershipID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOrganizationMembership", ctx, membershipID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteOrganizationMembership indicates an expected call of DeleteOrganizationMembership.
+func (mr *ClientMockRecorder) DeleteOrganizationMembership(ctx, membershipID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrganizationMembership", reflect.TypeOf((*Client)(nil).DeleteOrganizationMembership), ctx, membershipID)
+}
+
+// DeleteOrganizationSubscription mocks base method.
+func (m *Client) DeleteOrganizationSubscription(ctx context.Context, subscriptionID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOrganizationSubscription", ctx, subscriptionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteOrganizationSubscription indicates an expected call of DeleteOrganizationSubscription.
+func (mr *ClientMockRecorder) DeleteOrganizationSubscription(ctx, subscriptionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrganizationSubscription", reflect.TypeOf((*Client)(nil).DeleteOrganizationSubscription), ctx, subscriptionID)
+}
+
+// DeleteRoutingAttribute mocks base method.
+func (m *Client) DeleteRoutingAttribute(ctx context.Context, attributeID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRoutingAttribute", ctx, attributeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRoutingAttribute indicates an expected call of DeleteRoutingAttribute.
+func (mr *ClientMockRecorder) DeleteRoutingAttribute(ctx, attributeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRoutingAttribute", reflect.TypeOf((*Client)(nil).DeleteRoutingAttribute), ctx, attributeID)
+}
+
+// DeleteRoutingAttributeValue mocks base method.
+func (m *Client) DeleteRoutingAttributeValue(ctx context.Context, attributeID, valueID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRoutingAttributeValue", ctx, attributeID, valueID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRoutingAttributeValue indicates an expected call of DeleteRoutingAttributeValue.
+func (mr *ClientMockRecorder) DeleteRoutingAttributeValue(ctx, attributeID, valueID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRoutingAttributeValue", reflect.TypeOf((*Client)(nil).DeleteRoutingAttributeValue), ctx, attributeID, valueID)
+}
+
+// DeleteRoutingQueue mocks base method.
+func (m *Client) DeleteRoutingQueue(ctx context.Context, queueID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRoutingQueue", ctx, queueID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRoutingQueue indicates an expected call of DeleteRoutingQueue.
+func (mr *ClientMockRecorder) DeleteRoutingQueue(ctx, queueID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRoutingQueue", reflect.TypeOf((*Client)(nil).DeleteRoutingQueue), ctx, queueID)
+}
+
+// DeleteSLAPolicy mocks base method.
+func (m *Client) DeleteSLAPolicy(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSLAPolicy", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSLAPolicy indicates an expected call of DeleteSLAPolicy.
+func (mr *ClientMockRecorder) DeleteSLAPolicy(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSLAPolicy", reflect.TypeOf((*Client)(nil).DeleteSLAPolicy), ctx, id)
+}
+
+// DeleteSchedule mocks base method.
+func (m *Client) DeleteSchedule(ctx context.Context, scheduleID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSchedule", ctx, scheduleID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSchedule indicates an expected call of DeleteSchedule.
+func (mr *ClientMockRecorder) DeleteSchedule(ctx, scheduleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSchedule", reflect.TypeOf((*Client)(nil).DeleteSchedule), ctx, scheduleID)
+}
+
+// DeleteSection mocks base method.
+func (m *Client) DeleteSection(ctx context.Context, sectionID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSection", ctx, sectionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSection indicates an expected call of DeleteSection.
+func (mr *ClientMockRecorder) DeleteSection(ctx, sectionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSection", reflect.TypeOf((*Client)(nil).DeleteSection), ctx, sectionID)
+}
+
+// DeleteTarget mocks base method.
+func (m *Client) DeleteTarget(ctx context.Context, ticketID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTarget", ctx, ticketID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTarget indicates an expected call of DeleteTarget.
+func (mr *ClientMockRecorder) DeleteTarget(ctx, ticketID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTarget", reflect.TypeOf((*Client)(nil).DeleteTarget), ctx, ticketID)
+}
+
+// DeleteTicket mocks base method.
+func (m *Client) DeleteTicket(ctx context.Context, ticketID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTicket", ctx, ticketID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTicket indicates an expected call of DeleteTicket.
+func (mr *ClientMockRecorder) DeleteTicket(ctx, ticketID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTicket", reflect.TypeOf((*Client)(nil).DeleteTicket), ctx, ticketID)
+}
+
+// DeleteTicketField mocks base method.
+func (m *Client) DeleteTicketField(ctx context.Context, ticketID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTicketField", ctx, ticketID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTicketField indicates an expected call of DeleteTicketField.
+func (mr *ClientMockRecorder) DeleteTicketField(ctx, ticketID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTicketField", reflect.TypeOf((*Client)(nil).DeleteTicketField), ctx, ticketID)
+}
+
+// DeleteTicketFieldOption mocks base method.
+func (m *Client) DeleteTicketFieldOption(ctx context.Context, fieldID, optionID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTicketFieldOption", ctx, fieldID, optionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTicketFieldOption indicates an expected call of DeleteTicketFieldOption.
+func (mr *ClientMockRecorder) DeleteTicketFieldOption(ctx, fieldID, optionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTicketFieldOption", reflect.TypeOf((*Client)(nil).DeleteTicketFieldOption), ctx, fieldID, optionID)
+}
+
+// DeleteTicketForm mocks base method.
+func (m *Client) DeleteTicketForm(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTicketForm", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTicketForm indicates an expected call of DeleteTicketForm.
+func (mr *ClientMockRecorder) DeleteTicketForm(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTicketForm", reflect.TypeOf((*Client)(nil).DeleteTicketForm), ctx, id)
+}
+
+// DeleteTranslation mocks base method.
+func (m *Client) DeleteTranslation(ctx context.Context, translationID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTranslation", ctx, translationID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTranslation indicates an expected call of DeleteTranslation.
+func (mr *ClientMockRecorder) DeleteTranslation(ctx, translationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTranslation", reflect.TypeOf((*Client)(nil).DeleteTranslation), ctx, translationID)
+}
+
+// DeleteTrigger mocks base method.
+func (m *Client) DeleteTrigger(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTrigger", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTrigger indicates an expected call of DeleteTrigger.
+func (mr *ClientMockRecorder) DeleteTrigger(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTrigger", reflect.TypeOf((*Client)(nil).DeleteTrigger), ctx, id)
+}
+
+// DeleteTriggerCategory mocks base method.
+func (m *Client) DeleteTriggerCategory(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTriggerCategory", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTriggerCategory indicates an expected call of DeleteTriggerCategory.
+func (mr *ClientMockRecorder) DeleteTriggerCategory(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTriggerCategory", reflect.TypeOf((*Client)(nil).DeleteTriggerCategory), ctx, id)
+}
+
+// DeleteUpload mocks base method.
+func (m *Client) DeleteUpload(ctx context.Context, token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUpload", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUpload indicates an expected call of DeleteUpload.
+func (mr *ClientMockRecorder) DeleteUpload(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUpload", reflect.TypeOf((*Client)(nil).DeleteUpload), ctx, token)
+}
+
+// DeleteUser mocks base method.
+func (m *Client) DeleteUser(ctx context.Context, userID int64) (zendesk.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUser", ctx, userID)
+	ret0, _ := ret[0].(zendesk.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUser indicates an expected call of DeleteUser.
+func (mr *ClientMockRecorder) DeleteUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*Client)(nil).DeleteUser), ctx, userID)
+}
+
+// DeleteUserFieldOption mocks base method.
+func (m *Client) DeleteUserFieldOption(ctx context.Context, fieldID, optionID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserFieldOption", ctx, fieldID, optionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUserFieldOption indicates an expected call of DeleteUserFieldOption.
+func (mr *ClientMockRecorder) DeleteUserFieldOption(ctx, fieldID, optionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserFieldOption", reflect.TypeOf((*Client)(nil).DeleteUserFieldOption), ctx, fieldID, optionID)
+}
+
+// DeleteUserIdentity mocks base method.
+func (m *Client) DeleteUserIdentity(ctx context.Context, userID, identityID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserIdentity", ctx, userID, identityID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUserIdentity indicates an expected call of DeleteUserIdentity.
+func (mr *ClientMockRecorder) DeleteUserIdentity(ctx, userID, identityID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserIdentity", reflect.TypeOf((*Client)(nil).DeleteUserIdentity), ctx, userID, identityID)
+}
+
+// DeleteView mocks base method.
+func (m *Client) DeleteView(ctx context.Context, viewID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteView", ctx, viewID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteView indicates an expected call of DeleteView.
+func (mr *ClientMockRecorder) DeleteView(ctx, viewID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteView", reflect.TypeOf((*Client)(nil).DeleteView), ctx, viewID)
+}
+
+// DeleteWebhook mocks base method.
+func (m *Client) DeleteWebhook(ctx context.Context, webhookID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWebhook", ctx, webhookID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWebhook indicates an expected call of DeleteWebhook.
+func (mr *ClientMockRecorder) DeleteWebhook(ctx, webhookID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhook", reflect.TypeOf((*Client)(nil).DeleteWebhook), ctx, webhookID)
+}
+
+// ExecuteView mocks base method.
+func (m *Client) ExecuteView(ctx context.Context, viewID int64, opts *zendesk.ViewExecuteOptions) (zendesk.ViewRows, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteView", ctx, viewID, opts)
+	ret0, _ := ret[0].(zendesk.ViewRows)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecuteView indicates an expected call of ExecuteView.
+func (mr *ClientMockRecorder) ExecuteView(ctx, viewID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteView", reflect.TypeOf((*Client)(nil).ExecuteView), ctx, viewID, opts)
+}
+
+// FilteredSearchCustomObjectRecords mocks base method.
+func (m *Client) FilteredSearchCustomObjectRecords(ctx context.Context, customObjectKey string, filter zendesk.CustomObjectRecordFilter, opts *zendesk.CBPOptions) ([]zendesk.CustomObjectRecord, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilteredSearchCustomObjectRecords", ctx, customObjectKey, filter, opts)
+	ret0, _ := ret[0].([]zendesk.CustomObjectRecord)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FilteredSearchCustomObjectRecords indicates an expected call of FilteredSearchCustomObjectRecords.
+func (mr *ClientMockRecorder) FilteredSearchCustomObjectRecords(ctx, customObjectKey, filter, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilteredSearchCustomObjectRecords", reflect.TypeOf((*Client)(nil).FilteredSearchCustomObjectRecords), ctx, customObjectKey, filter, opts)
+}
+
+// FilteredSearchCustomObjectRecordsIterator mocks base method.
+func (m *Client) FilteredSearchCustomObjectRecordsIterator(ctx context.Context, customObjectKey string, filter zendesk.CustomObjectRecordFilter, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.CustomObjectRecord] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilteredSearchCustomObjectRecordsIterator", ctx, customObjectKey, filter, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.CustomObjectRecord])
+	return ret0
+}
+
+// FilteredSearchCustomObjectRecordsIterator indicates an expected call of FilteredSearchCustomObjectRecordsIterator.
+func (mr *ClientMockRecorder) FilteredSearchCustomObjectRecordsIterator(ctx, customObjectKey, filter, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilteredSearchCustomObjectRecordsIterator", reflect.TypeOf((*Client)(nil).FilteredSearchCustomObjectRecordsIterator), ctx, customObjectKey, filter, opts)
+}
+
+// Get mocks base method.
+func (m *Client) Get(ctx context.Context, path string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, path)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *ClientMockRecorder) Get(ctx, path any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*Client)(nil).Get), ctx, path)
+}
+
+// GetActiveTriggers mocks base method.
+func (m *Client) GetActiveTriggers(ctx context.Context, opts *zendesk.TriggerListOptions) ([]zendesk.Trigger, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActiveTriggers", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Trigger)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetActiveTriggers indicates an expected call of GetActiveTriggers.
+func (mr *ClientMockRecorder) GetActiveTriggers(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveTriggers", reflect.TypeOf((*Client)(nil).GetActiveTriggers), ctx, opts)
+}
+
+// GetAgentAttributeValues mocks base method.
+func (m *Client) GetAgentAttributeValues(ctx context.Context, userID int64) ([]zendesk.RoutingAttributeValue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAgentAttributeValues", ctx, userID)
+	ret0, _ := ret[0].([]zendesk.RoutingAttributeValue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAgentAttributeValues indicates an expected call of GetAgentAttributeValues.
+func (mr *ClientMockRecorder) GetAgentAttributeValues(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAgentAttributeValues", reflect.TypeOf((*Client)(nil).GetAgentAttributeValues), ctx, userID)
+}
+
+// GetAgentAvailabilities mocks base method.
+func (m *Client) GetAgentAvailabilities(ctx context.Context, opts *zendesk.AgentAvailabilityListOptions) ([]zendesk.AgentAvailability, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAgentAvailabilities", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.AgentAvailability)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAgentAvailabilities indicates an expected call of GetAgentAvailabilities.
+func (mr *ClientMockRecorder) GetAgentAvailabilities(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAgentAvailabilities", reflect.TypeOf((*Client)(nil).GetAgentAvailabilities), ctx, opts)
+}
+
+// GetAgentAvailabilitiesCBP mocks base method.
+func (m *Client) GetAgentAvailabilitiesCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.AgentAvailability, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAgentAvailabilitiesCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.AgentAvailability)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAgentAvailabilitiesCBP indicates an expected call of GetAgentAvailabilitiesCBP.
+func (mr *ClientMockRecorder) GetAgentAvailabilitiesCBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAgentAvailabilitiesCBP", reflect.TypeOf((*Client)(nil).GetAgentAvailabilitiesCBP), ctx, opts)
+}
+
+// GetAgentAvailabilitiesIterator mocks base method.
+func (m *Client) GetAgentAvailabilitiesIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.AgentAvailability] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAgentAvailabilitiesIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.AgentAvailability])
+	return ret0
+}
+
+// GetAgentAvailabilitiesIterator indicates an expected call of GetAgentAvailabilitiesIterator.
+func (mr *ClientMockRecorder) GetAgentAvailabilitiesIterator(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAgentAvailabilitiesIterator", reflect.TypeOf((*Client)(nil).GetAgentAvailabilitiesIterator), ctx, opts)
+}
+
+// GetAgentAvailability mocks base method.
+func (m *Client) GetAgentAvailability(ctx context.Context, agentID int64) (zendesk.AgentAvailability, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAgentAvailability", ctx, agentID)
+	ret0, _ := ret[0].(zendesk.AgentAvailability)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAgentAvailability indicates an expected call of GetAgentAvailability.
+func (mr *ClientMockRecorder) GetAgentAvailability(ctx, agentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAgentAvailability", reflect.TypeOf((*Client)(nil).GetAgentAvailability), ctx, agentID)
+}
+
+// GetAllTicketAudits mocks base method.
+func (m *Client) GetAllTicketAudits(ctx context.Context, opts zendesk.CursorOption) ([]zendesk.TicketAudit, zendesk.Cursor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllTicketAudits", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.TicketAudit)
+	ret1, _ := ret[1].(zendesk.Cursor)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAllTicketAudits indicates an expected call of GetAllTicketAudits.
+func (mr *ClientMockRecorder) GetAllTicketAudits(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllTicketAudits", reflect.TypeOf((*Client)(nil).GetAllTicketAudits), ctx, opts)
+}
+
+// GetArticle mocks base method.
+func (m *Client) GetArticle(ctx context.Context, locale int, articleID int64) (zendesk.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArticle", ctx, locale, articleID)
+	ret0, _ := ret[0].(zendesk.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetArticle indicates an expected call of GetArticle.
+func (mr *ClientMockRecorder) GetArticle(ctx, locale, articleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArticle", reflect.TypeOf((*Client)(nil).GetArticle), ctx, locale, articleID)
+}
+
+// GetArticleAttachment mocks base method.
+func (m *Client) GetArticleAttachment(ctx context.Context, attachmentID int64) (zendesk.ArticleAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArticleAttachment", ctx, attachmentID)
+	ret0, _ := ret[0].(zendesk.ArticleAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetArticleAttachment indicates an expected call of GetArticleAttachment.
+func (mr *ClientMockRecorder) GetArticleAttachment(ctx, attachmentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArticleAttachment", reflect.TypeOf((*Client)(nil).GetArticleAttachment), ctx, attachmentID)
+}
+
+// GetArticleAttachments mocks base method.
+func (m *Client) GetArticleAttachments(ctx context.Context, articleID int64) ([]zendesk.ArticleAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArticleAttachments", ctx, articleID)
+	ret0, _ := ret[0].([]zendesk.ArticleAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetArticleAttachments indicates an expected call of GetArticleAttachments.
+func (mr *ClientMockRecorder) GetArticleAttachments(ctx, articleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArticleAttachments", reflect.TypeOf((*Client)(nil).GetArticleAttachments), ctx, articleID)
+}
+
+// GetArticleLabel mocks base method.
+func (m *Client) GetArticleLabel(ctx context.Context, labelID int64) (zendesk.ArticleLabel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArticleLabel", ctx, labelID)
+	ret0, _ := ret[0].(zendesk.ArticleLabel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetArticleLabel indicates an expected call of GetArticleLabel.
+func (mr *ClientMockRecorder) GetArticleLabel(ctx, labelID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArticleLabel", reflect.TypeOf((*Client)(nil).GetArticleLabel), ctx, labelID)
+}
+
+// GetArticleLabelsCBP mocks base method.
+func (m *Client) GetArticleLabelsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.ArticleLabel, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArticleLabelsCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.ArticleLabel)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetArticleLabelsCBP indicates an expected call of GetArticleLabelsCBP.
+func (mr *ClientMockRecorder) GetArticleLabelsCBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArticleLabelsCBP", reflect.TypeOf((*Client)(nil).GetArticleLabelsCBP), ctx, opts)
+}
+
+// GetArticleLabelsIterator mocks base method.
+func (m *Client) GetArticleLabelsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.ArticleLabel] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArticleLabelsIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.ArticleLabel])
+	return ret0
+}
+
+// GetArticleLabelsIterator indicates an expected call of GetArticleLabelsIterator.
+func (mr *ClientMockRecorder) GetArticleLabelsIterator(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArticleLabelsIterator", reflect.TypeOf((*Client)(nil).GetArticleLabelsIterator), ctx, opts)
+}
+
+// GetArticleLabelsOBP mocks base method.
+func (m *Client) GetArticleLabelsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.ArticleLabel, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArticleLabelsOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.ArticleLabel)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetArticleLabelsOBP indicates an expected call of GetArticleLabelsOBP.
+func (mr *ClientMockRecorder) GetArticleLabelsOBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArticleLabelsOBP", reflect.TypeOf((*Client)(nil).GetArticleLabelsOBP), ctx, opts)
+}
+
+// GetArticlesBySectionCBP mocks base method.
+func (m *Client) GetArticlesBySectionCBP(ctx context.Context, locale int, opts *zendesk.CBPOptions) ([]zendesk.Article, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArticlesBySectionCBP", ctx, locale, opts)
+	ret0, _ := ret[0].([]zendesk.Article)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetArticlesBySectionCBP indicates an expected call of GetArticlesBySectionCBP.
+func (mr *ClientMockRecorder) GetArticlesBySectionCBP(ctx, locale, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArticlesBySectionCBP", reflect.TypeOf((*Client)(nil).GetArticlesBySectionCBP), ctx, locale, opts)
+}
+
+// GetArticlesBySectionIterator mocks base method.
+func (m *Client) GetArticlesBySectionIterator(ctx context.Context, locale int, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.Article] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArticlesBySectionIterator", ctx, locale, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.Article])
+	return ret0
+}
+
+// GetArticlesBySectionIterator indicates an expected call of GetArticlesBySectionIterator.
+func (mr *ClientMockRecorder) GetArticlesBySectionIterator(ctx, locale, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArticlesBySectionIterator", reflect.TypeOf((*Client)(nil).GetArticlesBySectionIterator), ctx, locale, opts)
+}
+
+// GetArticlesBySectionOBP mocks base method.
+func (m *Client) GetArticlesBySectionOBP(ctx context.Context, locale int, opts *zendesk.OBPOptions) ([]zendesk.Article, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArticlesBySectionOBP", ctx, locale, opts)
+	ret0, _ := ret[0].([]zendesk.Article)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetArticlesBySectionOBP indicates an expected call of GetArticlesBySectionOBP.
+func (mr *ClientMockRecorder) GetArticlesBySectionOBP(ctx, locale, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArticlesBySectionOBP", reflect.TypeOf((*Client)(nil).GetArticlesBySectionOBP), ctx, locale, opts)
+}
+
+// GetArticlesCBP mocks base method.
+func (m *Client) GetArticlesCBP(ctx context.Context, locale int, opts *zendesk.CBPOptions) ([]zendesk.Article, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArticlesCBP", ctx, locale, opts)
+	ret0, _ := ret[0].([]zendesk.Article)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetArticlesCBP indicates an expected call of GetArticlesCBP.
+func (mr *ClientMockRecorder) GetArticlesCBP(ctx, locale, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArticlesCBP", reflect.TypeOf((*Client)(nil).GetArticlesCBP), ctx, locale, opts)
+}
+
+// GetArticlesIterator mocks base method.
+func (m *Client) GetArticlesIterator(ctx context.Context, locale int, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.Article] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArticlesIterator", ctx, locale, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.Article])
+	return ret0
+}
+
+// GetArticlesIterator indicates an expected call of GetArticlesIterator.
+func (mr *ClientMockRecorder) GetArticlesIterator(ctx, locale, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArticlesIterator", reflect.TypeOf((*Client)(nil).GetArticlesIterator), ctx, locale, opts)
+}
+
+// GetArticlesOBP mocks base method.
+func (m *Client) GetArticlesOBP(ctx context.Context, locale int, opts *zendesk.OBPOptions) ([]zendesk.Article, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArticlesOBP", ctx, locale, opts)
+	ret0, _ := ret[0].([]zendesk.Article)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetArticlesOBP indicates an expected call of GetArticlesOBP.
+func (mr *ClientMockRecorder) GetArticlesOBP(ctx, locale, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArticlesOBP", reflect.TypeOf((*Client)(nil).GetArticlesOBP), ctx, locale, opts)
+}
+
+// GetAssignableGroupMembershipsCBP mocks base method.
+func (m *Client) GetAssignableGroupMembershipsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.GroupMembership, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAssignableGroupMembershipsCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.GroupMembership)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAssignableGroupMembershipsCBP indicates an expected call of GetAssignableGroupMembershipsCBP.
+func (mr *ClientMockRecorder) GetAssignableGroupMembershipsCBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssignableGroupMembershipsCBP", reflect.TypeOf((*Client)(nil).GetAssignableGroupMembershipsCBP), ctx, opts)
+}
+
+// GetAssignableGroupMembershipsIterator mocks base method.
+func (m *Client) GetAssignableGroupMembershipsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.GroupMembership] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAssignableGroupMembershipsIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.GroupMembership])
+	return ret0
+}
+
+// GetAssignableGroupMembershipsIterator indicates an expected call of GetAssignableGroupMembershipsIterator.
+func (mr *ClientMockRecorder) GetAssignableGroupMembershipsIterator(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssignableGroupMembershipsIterator", reflect.TypeOf((*Client)(nil).GetAssignableGroupMembershipsIterator), ctx, opts)
+}
+
+// GetAssignableGroupMembershipsOBP mocks base method.
+func (m *Client) GetAssignableGroupMembershipsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.GroupMembership, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAssignableGroupMembershipsOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.GroupMembership)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAssignableGroupMembershipsOBP indicates an expected call of GetAssignableGroupMembershipsOBP.
+func (mr *ClientMockRecorder) GetAssignableGroupMembershipsOBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssignableGroupMembershipsOBP", reflect.TypeOf((*Client)(nil).GetAssignableGroupMembershipsOBP), ctx, opts)
+}
+
+// GetAssignableGroupsCBP mocks base method.
+func (m *Client) GetAssignableGroupsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.Group, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAssignableGroupsCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Group)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAssignableGroupsCBP indicates an expected call of GetAssignableGroupsCBP.
+func (mr *ClientMockRecorder) GetAssignableGroupsCBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssignableGroupsCBP", reflect.TypeOf((*Client)(nil).GetAssignableGroupsCBP), ctx, opts)
+}
+
+// GetAssignableGroupsIterator mocks base method.
+func (m *Client) GetAssignableGroupsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.Group] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAssignableGroupsIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.Group])
+	return ret0
+}
+
+// GetAssignableGroupsIterator indicates an expected call of GetAssignableGroupsIterator.
+func (mr *ClientMockRecorder) GetAssignableGroupsIterator(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssignableGroupsIterator", reflect.TypeOf((*Client)(nil).GetAssignableGroupsIterator), ctx, opts)
+}
+
+// GetAssignableGroupsOBP mocks base method.
+func (m *Client) GetAssignableGroupsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.Group, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAssignableGroupsOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Group)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAssignableGroupsOBP indicates an expected call of GetAssignableGroupsOBP.
+func (mr *ClientMockRecorder) GetAssignableGroupsOBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssignableGroupsOBP", reflect.TypeOf((*Client)(nil).GetAssignableGroupsOBP), ctx, opts)
+}
+
+// GetAttachment mocks base method.
+func (m *Client) GetAttachment(ctx context.Context, id int64) (zendesk.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttachment", ctx, id)
+	ret0, _ := ret[0].(zendesk.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttachment indicates an expected call of GetAttachment.
+func (mr *ClientMockRecorder) GetAttachment(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachment", reflect.TypeOf((*Client)(nil).GetAttachment), ctx, id)
+}
+
+// GetAutomation mocks base method.
+func (m *Client) GetAutomation(ctx context.Context, id int64) (zendesk.Automation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAutomation", ctx, id)
+	ret0, _ := ret[0].(zendesk.Automation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAutomation indicates an expected call of GetAutomation.
+func (mr *ClientMockRecorder) GetAutomation(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutomation", reflect.TypeOf((*Client)(nil).GetAutomation), ctx, id)
+}
+
+// GetAutomations mocks base method.
+func (m *Client) GetAutomations(ctx context.Context, opts *zendesk.AutomationListOptions) ([]zendesk.Automation, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAutomations", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Automation)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAutomations indicates an expected call of GetAutomations.
+func (mr *ClientMockRecorder) GetAutomations(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutomations", reflect.TypeOf((*Client)(nil).GetAutomations), ctx, opts)
+}
+
+// GetAutomationsCBP mocks base method.
+func (m *Client) GetAutomationsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.Automation, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAutomationsCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Automation)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAutomationsCBP indicates an expected call of GetAutomationsCBP.
+func (mr *ClientMockRecorder) GetAutomationsCBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutomationsCBP", reflect.TypeOf((*Client)(nil).GetAutomationsCBP), ctx, opts)
+}
+
+// GetAutomationsIterator mocks base method.
+func (m *Client) GetAutomationsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.Automation] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAutomationsIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.Automation])
+	return ret0
+}
+
+// GetAutomationsIterator indicates an expected call of GetAutomationsIterator.
+func (mr *ClientMockRecorder) GetAutomationsIterator(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutomationsIterator", reflect.TypeOf((*Client)(nil).GetAutomationsIterator), ctx, opts)
+}
+
+// GetAutomationsOBP mocks base method.
+func (m *Client) GetAutomationsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.Automation, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAutomationsOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Automation)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAutomationsOBP indicates an expected call of GetAutomationsOBP.
+func (mr *ClientMockRecorder) GetAutomationsOBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutomationsOBP", reflect.TypeOf((*Client)(nil).GetAutomationsOBP), ctx, opts)
+}
+
+// GetBrand mocks base method.
+func (m *Client) GetBrand(ctx context.Context, brandID int64) (zendesk.Brand, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBrand", ctx, brandID)
+	ret0, _ := ret[0].(zendesk.Brand)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBrand indicates an expected call of GetBrand.
+func (mr *ClientMockRecorder) GetBrand(ctx, brandID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBrand", reflect.TypeOf((*Client)(nil).GetBrand), ctx, brandID)
+}
+
+// GetBrandsCBP mocks base method.
+func (m *Client) GetBrandsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.Brand, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBrandsCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Brand)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetBrandsCBP indicates an expected call of GetBrandsCBP.
+func (mr *ClientMockRecorder) GetBrandsCBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBrandsCBP", reflect.TypeOf((*Client)(nil).GetBrandsCBP), ctx, opts)
+}
+
+// GetBrandsIterator mocks base method.
+func (m *Client) GetBrandsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.Brand] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBrandsIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.Brand])
+	return ret0
+}
+
+// GetBrandsIterator indicates an expected call of GetBrandsIterator.
+func (mr *ClientMockRecorder) GetBrandsIterator(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBrandsIterator", reflect.TypeOf((*Client)(nil).GetBrandsIterator), ctx, opts)
+}
+
+// GetBrandsOBP mocks base method.
+func (m *Client) GetBrandsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.Brand, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBrandsOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Brand)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetBrandsOBP indicates an expected call of GetBrandsOBP.
+func (mr *ClientMockRecorder) GetBrandsOBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBrandsOBP", reflect.TypeOf((*Client)(nil).GetBrandsOBP), ctx, opts)
+}
+
+// GetCapacityRule mocks base method.
+func (m *Client) GetCapacityRule(ctx context.Context, ruleID string) (zendesk.CapacityRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCapacityRule", ctx, ruleID)
+	ret0, _ := ret[0].(zendesk.CapacityRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCapacityRule indicates an expected call of GetCapacityRule.
+func (mr *ClientMockRecorder) GetCapacityRule(ctx, ruleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCapacityRule", reflect.TypeOf((*Client)(nil).GetCapacityRule), ctx, ruleID)
+}
+
+// GetCapacityRules mocks base method.
+func (m *Client) GetCapacityRules(ctx context.Context) ([]zendesk.CapacityRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCapacityRules", ctx)
+	ret0, _ := ret[0].([]zendesk.CapacityRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCapacityRules indicates an expected call of GetCapacityRules.
+func (mr *ClientMockRecorder) GetCapacityRules(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCapacityRules", reflect.TypeOf((*Client)(nil).GetCapacityRules), ctx)
+}
+
+// GetCategoriesCBP mocks base method.
+func (m *Client) GetCategoriesCBP(ctx context.Context, locale int, opts *zendesk.CBPOptions) ([]zendesk.Category, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoriesCBP", ctx, locale, opts)
+	ret0, _ := ret[0].([]zendesk.Category)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetCategoriesCBP indicates an expected call of GetCategoriesCBP.
+func (mr *ClientMockRecorder) GetCategoriesCBP(ctx, locale, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoriesCBP", reflect.TypeOf((*Client)(nil).GetCategoriesCBP), ctx, locale, opts)
+}
+
+// GetCategoriesIterator mocks base method.
+func (m *Client) GetCategoriesIterator(ctx context.Context, locale int, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.Category] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoriesIterator", ctx, locale, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.Category])
+	return ret0
+}
+
+// GetCategoriesIterator indicates an expected call of GetCategoriesIterator.
+func (mr *ClientMockRecorder) GetCategoriesIterator(ctx, locale, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoriesIterator", reflect.TypeOf((*Client)(nil).GetCategoriesIterator), ctx, locale, opts)
+}
+
+// GetCategoriesOBP mocks base method.
+func (m *Client) GetCategoriesOBP(ctx context.Context, locale int, opts *zendesk.OBPOptions) ([]zendesk.Category, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoriesOBP", ctx, locale, opts)
+	ret0, _ := ret[0].([]zendesk.Category)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetCategoriesOBP indicates an expected call of GetCategoriesOBP.
+func (mr *ClientMockRecorder) GetCategoriesOBP(ctx, locale, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoriesOBP", reflect.TypeOf((*Client)(nil).GetCategoriesOBP), ctx, locale, opts)
+}
+
+// GetCategory mocks base method.
+func (m *Client) GetCategory(ctx context.Context, locale int, categoryID int64) (zendesk.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategory", ctx, locale, categoryID)
+	ret0, _ := ret[0].(zendesk.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategory indicates an expected call of GetCategory.
+func (mr *ClientMockRecorder) GetCategory(ctx, locale, categoryID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategory", reflect.TypeOf((*Client)(nil).GetCategory), ctx, locale, categoryID)
+}
+
+// GetCountTicketsInView mocks base method.
+func (m *Client) GetCountTicketsInView(ctx context.Context, viewID int64) (zendesk.ViewCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCountTicketsInView", ctx, viewID)
+	ret0, _ := ret[0].(zendesk.ViewCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCountTicketsInView indicates an expected call of GetCountTicketsInView.
+func (mr *ClientMockRecorder) GetCountTicketsInView(ctx, viewID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCountTicketsInView", reflect.TypeOf((*Client)(nil).GetCountTicketsInView), ctx, viewID)
+}
+
+// GetCountTicketsInViews mocks base method.
+func (m *Client) GetCountTicketsInViews(ctx context.Context, ids []string) ([]zendesk.ViewCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCountTicketsInViews", ctx, ids)
+	ret0, _ := ret[0].([]zendesk.ViewCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCountTicketsInViews indicates an expected call of GetCountTicketsInViews.
+func (mr *ClientMockRecorder) GetCountTicketsInViews(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCountTicketsInViews", reflect.TypeOf((*Client)(nil).GetCountTicketsInViews), ctx, ids)
+}
+
+// GetCustomObject mocks base method.
+func (m *Client) GetCustomObject(ctx context.Context, customObjectKey string) (zendesk.CustomObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomObject", ctx, customObjectKey)
+	ret0, _ := ret[0].(zendesk.CustomObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustomObject indicates an expected call of GetCustomObject.
+func (mr *ClientMockRecorder) GetCustomObject(ctx, customObjectKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomObject", reflect.TypeOf((*Client)(nil).GetCustomObject), ctx, customObjectKey)
+}
+
+// GetCustomObjectField mocks base method.
+func (m *Client) GetCustomObjectField(ctx context.Context, customObjectKey, fieldKeyOrID string) (zendesk.CustomObjectField, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomObjectField", ctx, customObjectKey, fieldKeyOrID)
+	ret0, _ := ret[0].(zendesk.CustomObjectField)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustomObjectField indicates an expected call of GetCustomObjectField.
+func (mr *ClientMockRecorder) GetCustomObjectField(ctx, customObjectKey, fieldKeyOrID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomObjectField", reflect.TypeOf((*Client)(nil).GetCustomObjectField), ctx, customObjectKey, fieldKeyOrID)
+}
+
+// GetCustomObjectFieldLimit mocks base method.
+func (m *Client) GetCustomObjectFieldLimit(ctx context.Context, customObjectKey string) (zendesk.CustomObjectLimit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomObjectFieldLimit", ctx, customObjectKey)
+	ret0, _ := ret[0].(zendesk.CustomObjectLimit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustomObjectFieldLimit indicates an expected call of GetCustomObjectFieldLimit.
+func (mr *ClientMockRecorder) GetCustomObjectFieldLimit(ctx, customObjectKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomObjectFieldLimit", reflect.TypeOf((*Client)(nil).GetCustomObjectFieldLimit), ctx, customObjectKey)
+}
+
+// GetCustomObjectFields mocks base method.
+func (m *Client) GetCustomObjectFields(ctx context.Context, customObjectKey string, opts *zendesk.CustomObjectFieldListOptions) ([]zendesk.CustomObjectField, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomObjectFields", ctx, customObjectKey, opts)
+	ret0, _ := ret[0].([]zendesk.CustomObjectField)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustomObjectFields indicates an expected call of GetCustomObjectFields.
+func (mr *ClientMockRecorder) GetCustomObjectFields(ctx, customObjectKey, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomObjectFields", reflect.TypeOf((*Client)(nil).GetCustomObjectFields), ctx, customObjectKey, opts)
+}
+
+// GetCustomObjectLimit mocks base method.
+func (m *Client) GetCustomObjectLimit(ctx context.Context) (zendesk.CustomObjectLimit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomObjectLimit", ctx)
+	ret0, _ := ret[0].(zendesk.CustomObjectLimit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustomObjectLimit indicates an expected call of GetCustomObjectLimit.
+func (mr *ClientMockRecorder) GetCustomObjectLimit(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomObjectLimit", reflect.TypeOf((*Client)(nil).GetCustomObjectLimit), ctx)
+}
+
+// GetCustomObjectRecordsCBP mocks base method.
+func (m *Client) GetCustomObjectRecordsCBP(ctx context.Context, customObjectKey string, opts *zendesk.CBPOptions) ([]zendesk.CustomObjectRecord, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomObjectRecordsCBP", ctx, customObjectKey, opts)
+	ret0, _ := ret[0].([]zendesk.CustomObjectRecord)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetCustomObjectRecordsCBP indicates an expected call of GetCustomObjectRecordsCBP.
+func (mr *ClientMockRecorder) GetCustomObjectRecordsCBP(ctx, customObjectKey, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomObjectRecordsCBP", reflect.TypeOf((*Client)(nil).GetCustomObjectRecordsCBP), ctx, customObjectKey, opts)
+}
+
+// GetCustomObjectRecordsIterator mocks base method.
+func (m *Client) GetCustomObjectRecordsIterator(ctx context.Context, customObjectKey string, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.CustomObjectRecord] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomObjectRecordsIterator", ctx, customObjectKey, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.CustomObjectRecord])
+	return ret0
+}
+
+// GetCustomObjectRecordsIterator indicates an expected call of GetCustomObjectRecordsIterator.
+func (mr *ClientMockRecorder) GetCustomObjectRecordsIterator(ctx, customObjectKey, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomObjectRecordsIterator", reflect.TypeOf((*Client)(nil).GetCustomObjectRecordsIterator), ctx, customObjectKey, opts)
+}
+
+// GetCustomObjects mocks base method.
+func (m *Client) GetCustomObjects(ctx context.Context) ([]zendesk.CustomObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomObjects", ctx)
+	ret0, _ := ret[0].([]zendesk.CustomObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustomObjects indicates an expected call of GetCustomObjects.
+func (mr *ClientMockRecorder) GetCustomObjects(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomObjects", reflect.TypeOf((*Client)(nil).GetCustomObjects), ctx)
+}
+
+// GetCustomRoles mocks base method.
+func (m *Client) GetCustomRoles(ctx context.Context) ([]zendesk.CustomRole, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomRoles", ctx)
+	ret0, _ := ret[0].([]zendesk.CustomRole)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustomRoles indicates an expected call of GetCustomRoles.
+func (mr *ClientMockRecorder) GetCustomRoles(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomRoles", reflect.TypeOf((*Client)(nil).GetCustomRoles), ctx)
+}
+
+// GetCustomStatus mocks base method.
+func (m *Client) GetCustomStatus(ctx context.Context, id int64) (zendesk.CustomStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomStatus", ctx, id)
+	ret0, _ := ret[0].(zendesk.CustomStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustomStatus indicates an expected call of GetCustomStatus.
+func (mr *ClientMockRecorder) GetCustomStatus(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomStatus", reflect.TypeOf((*Client)(nil).GetCustomStatus), ctx, id)
+}
+
+// GetCustomStatuses mocks base method.
+func (m *Client) GetCustomStatuses(ctx context.Context, opts *zendesk.CustomStatusListOptions) ([]zendesk.CustomStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomStatuses", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.CustomStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustomStatuses indicates an expected call of GetCustomStatuses.
+func (mr *ClientMockRecorder) GetCustomStatuses(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomStatuses", reflect.TypeOf((*Client)(nil).GetCustomStatuses), ctx, opts)
+}
+
+// GetDeletedUser mocks base method.
+func (m *Client) GetDeletedUser(ctx context.Context, userID int64) (zendesk.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletedUser", ctx, userID)
+	ret0, _ := ret[0].(zendesk.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeletedUser indicates an expected call of GetDeletedUser.
+func (mr *ClientMockRecorder) GetDeletedUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedUser", reflect.TypeOf((*Client)(nil).GetDeletedUser), ctx, userID)
+}
+
+// GetDeletedUsers mocks base method.
+func (m *Client) GetDeletedUsers(ctx context.Context, opts *zendesk.DeletedUserListOptions) ([]zendesk.User, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletedUsers", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.User)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDeletedUsers indicates an expected call of GetDeletedUsers.
+func (mr *ClientMockRecorder) GetDeletedUsers(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedUsers", reflect.TypeOf((*Client)(nil).GetDeletedUsers), ctx, opts)
+}
+
+// GetDynamicContentItem mocks base method.
+func (m *Client) GetDynamicContentItem(ctx context.Context, id int64) (zendesk.DynamicContentItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDynamicContentItem", ctx, id)
+	ret0, _ := ret[0].(zendesk.DynamicContentItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDynamicContentItem indicates an expected call of GetDynamicContentItem.
+func (mr *ClientMockRecorder) GetDynamicContentItem(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicContentItem", reflect.TypeOf((*Client)(nil).GetDynamicContentItem), ctx, id)
+}
+
+// GetDynamicContentItems mocks base method.
+func (m *Client) GetDynamicContentItems(ctx context.Context) ([]zendesk.DynamicContentItem, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDynamicContentItems", ctx)
+	ret0, _ := ret[0].([]zendesk.DynamicContentItem)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDynamicContentItems indicates an expected call of GetDynamicContentItems.
+func (mr *ClientMockRecorder) GetDynamicContentItems(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicContentItems", reflect.TypeOf((*Client)(nil).GetDynamicContentItems), ctx)
+}
+
+// GetDynamicContentItemsCBP mocks base method.
+func (m *Client) GetDynamicContentItemsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.DynamicContentItem, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDynamicContentItemsCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.DynamicContentItem)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDynamicContentItemsCBP indicates an expected call of GetDynamicContentItemsCBP.
+func (mr *ClientMockRecorder) GetDynamicContentItemsCBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicContentItemsCBP", reflect.TypeOf((*Client)(nil).GetDynamicContentItemsCBP), ctx, opts)
+}
+
+// GetDynamicContentItemsIterator mocks base method.
+func (m *Client) GetDynamicContentItemsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.DynamicContentItem] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDynamicContentItemsIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.DynamicContentItem])
 	return ret0
 }
 
-// Delete indicates an expected call of Delete.
-func (mr *ClientMockRecorder) Delete(ctx, path any) *gomock.Call {
+// GetDynamicContentItemsIterator indicates an expected call of GetDynamicContentItemsIterator.
+func (mr *ClientMockRecorder) GetDynamicContentItemsIterator(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*Client)(nil).Delete), ctx, path)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicContentItemsIterator", reflect.TypeOf((*Client)(nil).GetDynamicContentItemsIterator), ctx, opts)
 }
 
-// DeleteAutomation mocks base method.
-func (m *Client) DeleteAutomation(ctx context.Context, id int64) error {
+// GetDynamicContentItemsOBP mocks base method.
+func (m *Client) GetDynamicContentItemsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.DynamicContentItem, zendesk.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteAutomation", ctx, id)
-	ret0, _ := ret[0].(error)
+	ret := m.ctrl.Call(m, "GetDynamicContentItemsOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.DynamicContentItem)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDynamicContentItemsOBP indicates an expected call of GetDynamicContentItemsOBP.
+func (mr *ClientMockRecorder) GetDynamicContentItemsOBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicContentItemsOBP", reflect.TypeOf((*Client)(nil).GetDynamicContentItemsOBP), ctx, opts)
+}
+
+// GetFilteredAgentAvailabilitiesIterator mocks base method.
+func (m *Client) GetFilteredAgentAvailabilitiesIterator(ctx context.Context, filter zendesk.AgentAvailabilityListOptions, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.AgentAvailability] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFilteredAgentAvailabilitiesIterator", ctx, filter, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.AgentAvailability])
+	return ret0
+}
+
+// GetFilteredAgentAvailabilitiesIterator indicates an expected call of GetFilteredAgentAvailabilitiesIterator.
+func (mr *ClientMockRecorder) GetFilteredAgentAvailabilitiesIterator(ctx, filter, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilteredAgentAvailabilitiesIterator", reflect.TypeOf((*Client)(nil).GetFilteredAgentAvailabilitiesIterator), ctx, filter, opts)
+}
+
+// GetGroup mocks base method.
+func (m *Client) GetGroup(ctx context.Context, groupID int64) (zendesk.Group, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroup", ctx, groupID)
+	ret0, _ := ret[0].(zendesk.Group)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGroup indicates an expected call of GetGroup.
+func (mr *ClientMockRecorder) GetGroup(ctx, groupID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroup", reflect.TypeOf((*Client)(nil).GetGroup), ctx, groupID)
+}
+
+// GetGroupMembership mocks base method.
+func (m *Client) GetGroupMembership(ctx context.Context, membershipID int64) (zendesk.GroupMembership, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupMembership", ctx, membershipID)
+	ret0, _ := ret[0].(zendesk.GroupMembership)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGroupMembership indicates an expected call of GetGroupMembership.
+func (mr *ClientMockRecorder) GetGroupMembership(ctx, membershipID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupMembership", reflect.TypeOf((*Client)(nil).GetGroupMembership), ctx, membershipID)
+}
+
+// GetGroupMemberships mocks base method.
+func (m *Client) GetGroupMemberships(arg0 context.Context, arg1 *zendesk.GroupMembershipListOptions) ([]zendesk.GroupMembership, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupMemberships", arg0, arg1)
+	ret0, _ := ret[0].([]zendesk.GroupMembership)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetGroupMemberships indicates an expected call of GetGroupMemberships.
+func (mr *ClientMockRecorder) GetGroupMemberships(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupMemberships", reflect.TypeOf((*Client)(nil).GetGroupMemberships), arg0, arg1)
+}
+
+// GetGroupMembershipsByGroupCBP mocks base method.
+func (m *Client) GetGroupMembershipsByGroupCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.GroupMembership, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupMembershipsByGroupCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.GroupMembership)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetGroupMembershipsByGroupCBP indicates an expected call of GetGroupMembershipsByGroupCBP.
+func (mr *ClientMockRecorder) GetGroupMembershipsByGroupCBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupMembershipsByGroupCBP", reflect.TypeOf((*Client)(nil).GetGroupMembershipsByGroupCBP), ctx, opts)
+}
+
+// GetGroupMembershipsByGroupIterator mocks base method.
+func (m *Client) GetGroupMembershipsByGroupIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.GroupMembership] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupMembershipsByGroupIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.GroupMembership])
+	return ret0
+}
+
+// GetGroupMembershipsByGroupIterator indicates an expected call of GetGroupMembershipsByGroupIterator.
+func (mr *ClientMockRecorder) GetGroupMembershipsByGroupIterator(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupMembershipsByGroupIterator", reflect.TypeOf((*Client)(nil).GetGroupMembershipsByGroupIterator), ctx, opts)
+}
+
+// GetGroupMembershipsByGroupOBP mocks base method.
+func (m *Client) GetGroupMembershipsByGroupOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.GroupMembership, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupMembershipsByGroupOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.GroupMembership)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetGroupMembershipsByGroupOBP indicates an expected call of GetGroupMembershipsByGroupOBP.
+func (mr *ClientMockRecorder) GetGroupMembershipsByGroupOBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupMembershipsByGroupOBP", reflect.TypeOf((*Client)(nil).GetGroupMembershipsByGroupOBP), ctx, opts)
+}
+
+// GetGroupMembershipsByUserCBP mocks base method.
+func (m *Client) GetGroupMembershipsByUserCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.GroupMembership, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupMembershipsByUserCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.GroupMembership)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetGroupMembershipsByUserCBP indicates an expected call of GetGroupMembershipsByUserCBP.
+func (mr *ClientMockRecorder) GetGroupMembershipsByUserCBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupMembershipsByUserCBP", reflect.TypeOf((*Client)(nil).GetGroupMembershipsByUserCBP), ctx, opts)
+}
+
+// GetGroupMembershipsByUserIterator mocks base method.
+func (m *Client) GetGroupMembershipsByUserIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.GroupMembership] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupMembershipsByUserIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.GroupMembership])
+	return ret0
+}
+
+// GetGroupMembershipsByUserIterator indicates an expected call of GetGroupMembershipsByUserIterator.
+func (mr *ClientMockRecorder) GetGroupMembershipsByUserIterator(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupMembershipsByUserIterator", reflect.TypeOf((*Client)(nil).GetGroupMembershipsByUserIterator), ctx, opts)
+}
+
+// GetGroupMembershipsByUserOBP mocks base method.
+func (m *Client) GetGroupMembershipsByUserOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.GroupMembership, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupMembershipsByUserOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.GroupMembership)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetGroupMembershipsByUserOBP indicates an expected call of GetGroupMembershipsByUserOBP.
+func (mr *ClientMockRecorder) GetGroupMembershipsByUserOBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupMembershipsByUserOBP", reflect.TypeOf((*Client)(nil).GetGroupMembershipsByUserOBP), ctx, opts)
+}
+
+// GetGroupMembershipsCBP mocks base method.
+func (m *Client) GetGroupMembershipsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.GroupMembership, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupMembershipsCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.GroupMembership)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetGroupMembershipsCBP indicates an expected call of GetGroupMembershipsCBP.
+func (mr *ClientMockRecorder) GetGroupMembershipsCBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupMembershipsCBP", reflect.TypeOf((*Client)(nil).GetGroupMembershipsCBP), ctx, opts)
+}
+
+// GetGroupMembershipsIterator mocks base method.
+func (m *Client) GetGroupMembershipsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.GroupMembership] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupMembershipsIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.GroupMembership])
+	return ret0
+}
+
+// GetGroupMembershipsIterator indicates an expected call of GetGroupMembershipsIterator.
+func (mr *ClientMockRecorder) GetGroupMembershipsIterator(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupMembershipsIterator", reflect.TypeOf((*Client)(nil).GetGroupMembershipsIterator), ctx, opts)
+}
+
+// GetGroupMembershipsOBP mocks base method.
+func (m *Client) GetGroupMembershipsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.GroupMembership, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupMembershipsOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.GroupMembership)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetGroupMembershipsOBP indicates an expected call of GetGroupMembershipsOBP.
+func (mr *ClientMockRecorder) GetGroupMembershipsOBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupMembershipsOBP", reflect.TypeOf((*Client)(nil).GetGroupMembershipsOBP), ctx, opts)
+}
+
+// GetGroupUsersCBP mocks base method.
+func (m *Client) GetGroupUsersCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.User, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupUsersCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.User)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetGroupUsersCBP indicates an expected call of GetGroupUsersCBP.
+func (mr *ClientMockRecorder) GetGroupUsersCBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupUsersCBP", reflect.TypeOf((*Client)(nil).GetGroupUsersCBP), ctx, opts)
+}
+
+// GetGroupUsersIterator mocks base method.
+func (m *Client) GetGroupUsersIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.User] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupUsersIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.User])
 	return ret0
 }
 
-// DeleteAutomation indicates an expected call of DeleteAutomation.
-func (mr *ClientMockRecorder) DeleteAutomation(ctx, id any) *gomock.Call {
+// GetGroupUsersIterator indicates an expected call of GetGroupUsersIterator.
+func (mr *ClientMockRecorder) GetGroupUsersIterator(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupUsersIterator", reflect.TypeOf((*Client)(nil).GetGroupUsersIterator), ctx, opts)
+}
+
+// GetGroupUsersOBP mocks base method.
+func (m *Client) GetGroupUsersOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.User, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupUsersOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.User)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetGroupUsersOBP indicates an expected call of GetGroupUsersOBP.
+func (mr *ClientMockRecorder) GetGroupUsersOBP(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAutomation", reflect.TypeOf((*Client)(nil).DeleteAutomation), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupUsersOBP", reflect.TypeOf((*Client)(nil).GetGroupUsersOBP), ctx, opts)
 }
 
-// DeleteBrand mocks base method.
-func (m *Client) DeleteBrand(ctx context.Context, brandID int64) error {
+// GetGroups mocks base method.
+func (m *Client) GetGroups(ctx context.Context, opts *zendesk.GroupListOptions) ([]zendesk.Group, zendesk.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteBrand", ctx, brandID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetGroups", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Group)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
-// DeleteBrand indicates an expected call of DeleteBrand.
-func (mr *ClientMockRecorder) DeleteBrand(ctx, brandID any) *gomock.Call {
+// GetGroups indicates an expected call of GetGroups.
+func (mr *ClientMockRecorder) GetGroups(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBrand", reflect.TypeOf((*Client)(nil).DeleteBrand), ctx, brandID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroups", reflect.TypeOf((*Client)(nil).GetGroups), ctx, opts)
 }
 
-// DeleteDynamicContentItem mocks base method.
-func (m *Client) DeleteDynamicContentItem(ctx context.Context, id int64) error {
+// GetGroupsCBP mocks base method.
+func (m *Client) GetGroupsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.Group, zendesk.CursorPaginationMeta, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteDynamicContentItem", ctx, id)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetGroupsCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Group)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
-// DeleteDynamicContentItem indicates an expected call of DeleteDynamicContentItem.
-func (mr *ClientMockRecorder) DeleteDynamicContentItem(ctx, id any) *gomock.Call {
+// GetGroupsCBP indicates an expected call of GetGroupsCBP.
+func (mr *ClientMockRecorder) GetGroupsCBP(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDynamicContentItem", reflect.TypeOf((*Client)(nil).DeleteDynamicContentItem), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupsCBP", reflect.TypeOf((*Client)(nil).GetGroupsCBP), ctx, opts)
 }
 
-// DeleteGroup mocks base method.
-func (m *Client) DeleteGroup(ctx context.Context, groupID int64) error {
+// GetGroupsIterator mocks base method.
+func (m *Client) GetGroupsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.Group] {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteGroup", ctx, groupID)
-	ret0, _ := ret[0].(error)
+	ret := m.ctrl.Call(m, "GetGroupsIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.Group])
 	return ret0
 }
 
-// DeleteGroup indicates an expected call of DeleteGroup.
-func (mr *ClientMockRecorder) DeleteGroup(ctx, groupID any) *gomock.Call {
+// GetGroupsIterator indicates an expected call of GetGroupsIterator.
+func (mr *ClientMockRecorder) GetGroupsIterator(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGroup", reflect.TypeOf((*Client)(nil).DeleteGroup), ctx, groupID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupsIterator", reflect.TypeOf((*Client)(nil).GetGroupsIterator), ctx, opts)
 }
 
-// DeleteMacro mocks base method.
-func (m *Client) DeleteMacro(ctx context.Context, macroID int64) error {
+// GetGroupsOBP mocks base method.
+func (m *Client) GetGroupsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.Group, zendesk.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteMacro", ctx, macroID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetGroupsOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Group)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
-// DeleteMacro indicates an expected call of DeleteMacro.
-func (mr *ClientMockRecorder) DeleteMacro(ctx, macroID any) *gomock.Call {
+// GetGroupsOBP indicates an expected call of GetGroupsOBP.
+func (mr *ClientMockRecorder) GetGroupsOBP(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMacro", reflect.TypeOf((*Client)(nil).DeleteMacro), ctx, macroID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupsOBP", reflect.TypeOf((*Client)(nil).GetGroupsOBP), ctx, opts)
 }
 
-// DeleteOrganization mocks base method.
-func (m *Client) DeleteOrganization(ctx context.Context, orgID int64) error {
+// GetHoliday mocks base method.
+func (m *Client) GetHoliday(ctx context.Context, scheduleID, holidayID int64) (zendesk.Holiday, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteOrganization", ctx, orgID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetHoliday", ctx, scheduleID, holidayID)
+	ret0, _ := ret[0].(zendesk.Holiday)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteOrganization indicates an expected call of DeleteOrganization.
-func (mr *ClientMockRecorder) DeleteOrganization(ctx, orgID any) *gomock.Call {
+// GetHoliday indicates an expected call of GetHoliday.
+func (mr *ClientMockRecorder) GetHoliday(ctx, scheduleID, holidayID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrganization", reflect.TypeOf((*Client)(nil).DeleteOrganization), ctx, orgID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHoliday", reflect.TypeOf((*Client)(nil).GetHoliday), ctx, scheduleID, holidayID)
 }
 
-// DeleteSLAPolicy mocks base method.
-func (m *Client) DeleteSLAPolicy(ctx context.Context, id int64) error {
+// GetHolidays mocks base method.
+func (m *Client) GetHolidays(ctx context.Context, scheduleID int64) ([]zendesk.Holiday, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteSLAPolicy", ctx, id)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetHolidays", ctx, scheduleID)
+	ret0, _ := ret[0].([]zendesk.Holiday)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteSLAPolicy indicates an expected call of DeleteSLAPolicy.
-func (mr *ClientMockRecorder) DeleteSLAPolicy(ctx, id any) *gomock.Call {
+// GetHolidays indicates an expected call of GetHolidays.
+func (mr *ClientMockRecorder) GetHolidays(ctx, scheduleID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSLAPolicy", reflect.TypeOf((*Client)(nil).DeleteSLAPolicy), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHolidays", reflect.TypeOf((*Client)(nil).GetHolidays), ctx, scheduleID)
 }
 
-// DeleteTarget mocks base method.
-func (m *Client) DeleteTarget(ctx context.Context, ticketID int64) error {
+// GetJobStatus mocks base method.
+func (m *Client) GetJobStatus(ctx context.Context, jobID string) (zendesk.JobStatus, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteTarget", ctx, ticketID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetJobStatus", ctx, jobID)
+	ret0, _ := ret[0].(zendesk.JobStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteTarget indicates an expected call of DeleteTarget.
-func (mr *ClientMockRecorder) DeleteTarget(ctx, ticketID any) *gomock.Call {
+// GetJobStatus indicates an expected call of GetJobStatus.
+func (mr *ClientMockRecorder) GetJobStatus(ctx, jobID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTarget", reflect.TypeOf((*Client)(nil).DeleteTarget), ctx, ticketID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJobStatus", reflect.TypeOf((*Client)(nil).GetJobStatus), ctx, jobID)
 }
 
-// DeleteTicket mocks base method.
-func (m *Client) DeleteTicket(ctx context.Context, ticketID int64) error {
+// GetLabelsOfArticle mocks base method.
+func (m *Client) GetLabelsOfArticle(ctx context.Context, articleID int64) ([]zendesk.ArticleLabel, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteTicket", ctx, ticketID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetLabelsOfArticle", ctx, articleID)
+	ret0, _ := ret[0].([]zendesk.ArticleLabel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteTicket indicates an expected call of DeleteTicket.
-func (mr *ClientMockRecorder) DeleteTicket(ctx, ticketID any) *gomock.Call {
+// GetLabelsOfArticle indicates an expected call of GetLabelsOfArticle.
+func (mr *ClientMockRecorder) GetLabelsOfArticle(ctx, articleID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTicket", reflect.TypeOf((*Client)(nil).DeleteTicket), ctx, ticketID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLabelsOfArticle", reflect.TypeOf((*Client)(nil).GetLabelsOfArticle), ctx, articleID)
 }
 
-// DeleteTicketField mocks base method.
-func (m *Client) DeleteTicketField(ctx context.Context, ticketID int64) error {
+// GetLocales mocks base method.
+func (m *Client) GetLocales(ctx context.Context) ([]zendesk.Locale, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteTicketField", ctx, ticketID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetLocales", ctx)
+	ret0, _ := ret[0].([]zendesk.Locale)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteTicketField indicates an expected call of DeleteTicketField.
-func (mr *ClientMockRecorder) DeleteTicketField(ctx, ticketID any) *gomock.Call {
+// GetLocales indicates an expected call of GetLocales.
+func (mr *ClientMockRecorder) GetLocales(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTicketField", reflect.TypeOf((*Client)(nil).DeleteTicketField), ctx, ticketID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLocales", reflect.TypeOf((*Client)(nil).GetLocales), ctx)
 }
 
-// DeleteTicketForm mocks base method.
-func (m *Client) DeleteTicketForm(ctx context.Context, id int64) error {
+// GetMacro mocks base method.
+func (m *Client) GetMacro(ctx context.Context, macroID int64) (zendesk.Macro, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteTicketForm", ctx, id)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetMacro", ctx, macroID)
+	ret0, _ := ret[0].(zendesk.Macro)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteTicketForm indicates an expected call of DeleteTicketForm.
-func (mr *ClientMockRecorder) DeleteTicketForm(ctx, id any) *gomock.Call {
+// GetMacro indicates an expected call of GetMacro.
+func (mr *ClientMockRecorder) GetMacro(ctx, macroID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTicketForm", reflect.TypeOf((*Client)(nil).DeleteTicketForm), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMacro", reflect.TypeOf((*Client)(nil).GetMacro), ctx, macroID)
 }
 
-// DeleteTrigger mocks base method.
-func (m *Client) DeleteTrigger(ctx context.Context, id int64) error {
+// GetMacroActionDefinitions mocks base method.
+func (m *Client) GetMacroActionDefinitions(ctx context.Context) ([]zendesk.MacroActionDefinition, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteTrigger", ctx, id)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetMacroActionDefinitions", ctx)
+	ret0, _ := ret[0].([]zendesk.MacroActionDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteTrigger indicates an expected call of DeleteTrigger.
-func (mr *ClientMockRecorder) DeleteTrigger(ctx, id any) *gomock.Call {
+// GetMacroActionDefinitions indicates an expected call of GetMacroActionDefinitions.
+func (mr *ClientMockRecorder) GetMacroActionDefinitions(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTrigger", reflect.TypeOf((*Client)(nil).DeleteTrigger), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMacroActionDefinitions", reflect.TypeOf((*Client)(nil).GetMacroActionDefinitions), ctx)
 }
 
-// DeleteUpload mocks base method.
-func (m *Client) DeleteUpload(ctx context.Context, token string) error {
+// GetMacroAttachment mocks base method.
+func (m *Client) GetMacroAttachment(ctx context.Context, attachmentID int64) (zendesk.MacroAttachment, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteUpload", ctx, token)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetMacroAttachment", ctx, attachmentID)
+	ret0, _ := ret[0].(zendesk.MacroAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteUpload indicates an expected call of DeleteUpload.
-func (mr *ClientMockRecorder) DeleteUpload(ctx, token any) *gomock.Call {
+// GetMacroAttachment indicates an expected call of GetMacroAttachment.
+func (mr *ClientMockRecorder) GetMacroAttachment(ctx, attachmentID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUpload", reflect.TypeOf((*Client)(nil).DeleteUpload), ctx, token)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMacroAttachment", reflect.TypeOf((*Client)(nil).GetMacroAttachment), ctx, attachmentID)
 }
 
-// DeleteWebhook mocks base method.
-func (m *Client) DeleteWebhook(ctx context.Context, webhookID string) error {
+// GetMacroAttachments mocks base method.
+func (m *Client) GetMacroAttachments(ctx context.Context, macroID int64) ([]zendesk.MacroAttachment, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteWebhook", ctx, webhookID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetMacroAttachments", ctx, macroID)
+	ret0, _ := ret[0].([]zendesk.MacroAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteWebhook indicates an expected call of DeleteWebhook.
-func (mr *ClientMockRecorder) DeleteWebhook(ctx, webhookID any) *gomock.Call {
+// GetMacroAttachments indicates an expected call of GetMacroAttachments.
+func (mr *ClientMockRecorder) GetMacroAttachments(ctx, macroID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhook", reflect.TypeOf((*Client)(nil).DeleteWebhook), ctx, webhookID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMacroAttachments", reflect.TypeOf((*Client)(nil).GetMacroAttachments), ctx, macroID)
 }
 
-// Get mocks base method.
-func (m *Client) Get(ctx context.Context, path string) ([]byte, error) {
+// GetMacroCategories mocks base method.
+func (m *Client) GetMacroCategories(ctx context.Context) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Get", ctx, path)
-	ret0, _ := ret[0].([]byte)
+	ret := m.ctrl.Call(m, "GetMacroCategories", ctx)
+	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Get indicates an expected call of Get.
-func (mr *ClientMockRecorder) Get(ctx, path any) *gomock.Call {
+// GetMacroCategories indicates an expected call of GetMacroCategories.
+func (mr *ClientMockRecorder) GetMacroCategories(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*Client)(nil).Get), ctx, path)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMacroCategories", reflect.TypeOf((*Client)(nil).GetMacroCategories), ctx)
 }
 
-// GetAllTicketAudits mocks base method.
-func (m *Client) GetAllTicketAudits(ctx context.Context, opts zendesk.CursorOption) ([]zendesk.TicketAudit, zendesk.Cursor, error) {
+// GetMacros mocks base method.
+func (m *Client) GetMacros(ctx context.Context, opts *zendesk.MacroListOptions) ([]zendesk.Macro, zendesk.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllTicketAudits", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.TicketAudit)
-	ret1, _ := ret[1].(zendesk.Cursor)
+	ret := m.ctrl.Call(m, "GetMacros", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Macro)
+	ret1, _ := ret[1].(zendesk.Page)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetAllTicketAudits indicates an expected call of GetAllTicketAudits.
-func (mr *ClientMockRecorder) GetAllTicketAudits(ctx, opts any) *gomock.Call {
+// GetMacros indicates an expected call of GetMacros.
+func (mr *ClientMockRecorder) GetMacros(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllTicketAudits", reflect.TypeOf((*Client)(nil).GetAllTicketAudits), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMacros", reflect.TypeOf((*Client)(nil).GetMacros), ctx, opts)
 }
 
-// GetAttachment mocks base method.
-func (m *Client) GetAttachment(ctx context.Context, id int64) (zendesk.Attachment, error) {
+// GetMacrosCBP mocks base method.
+func (m *Client) GetMacrosCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.Macro, zendesk.CursorPaginationMeta, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAttachment", ctx, id)
-	ret0, _ := ret[0].(zendesk.Attachment)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "GetMacrosCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Macro)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
-// GetAttachment indicates an expected call of GetAttachment.
-func (mr *ClientMockRecorder) GetAttachment(ctx, id any) *gomock.Call {
+// GetMacrosCBP indicates an expected call of GetMacrosCBP.
+func (mr *ClientMockRecorder) GetMacrosCBP(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachment", reflect.TypeOf((*Client)(nil).GetAttachment), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMacrosCBP", reflect.TypeOf((*Client)(nil).GetMacrosCBP), ctx, opts)
 }
 
-// GetAutomation mocks base method.
-func (m *Client) GetAutomation(ctx context.Context, id int64) (zendesk.Automation, error) {
+// GetMacrosIterator mocks base method.
+func (m *Client) GetMacrosIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.Macro] {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAutomation", ctx, id)
-	ret0, _ := ret[0].(zendesk.Automation)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "GetMacrosIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.Macro])
+	return ret0
 }
 
-// GetAutomation indicates an expected call of GetAutomation.
-func (mr *ClientMockRecorder) GetAutomation(ctx, id any) *gomock.Call {
+// GetMacrosIterator indicates an expected call of GetMacrosIterator.
+func (mr *ClientMockRecorder) GetMacrosIterator(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutomation", reflect.TypeOf((*Client)(nil).GetAutomation), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMacrosIterator", reflect.TypeOf((*Client)(nil).GetMacrosIterator), ctx, opts)
 }
 
-// GetAutomations mocks base method.
-func (m *Client) GetAutomations(ctx context.Context, opts *zendesk.AutomationListOptions) ([]zendesk.Automation, zendesk.Page, error) {
+// GetMacrosOBP mocks base method.
+func (m *Client) GetMacrosOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.Macro, zendesk.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAutomations", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.Automation)
+	ret := m.ctrl.Call(m, "GetMacrosOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Macro)
 	ret1, _ := ret[1].(zendesk.Page)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetAutomations indicates an expected call of GetAutomations.
-func (mr *ClientMockRecorder) GetAutomations(ctx, opts any) *gomock.Call {
+// GetMacrosOBP indicates an expected call of GetMacrosOBP.
+func (mr *ClientMockRecorder) GetMacrosOBP(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutomations", reflect.TypeOf((*Client)(nil).GetAutomations), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMacrosOBP", reflect.TypeOf((*Client)(nil).GetMacrosOBP), ctx, opts)
 }
 
-// GetAutomationsCBP mocks base method.
-func (m *Client) GetAutomationsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.Automation, zendesk.CursorPaginationMeta, error) {
+// GetManyJobStatuses mocks base method.
+func (m *Client) GetManyJobStatuses(ctx context.Context, jobIDs []string) ([]zendesk.JobStatus, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAutomationsCBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.Automation)
-	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "GetManyJobStatuses", ctx, jobIDs)
+	ret0, _ := ret[0].([]zendesk.JobStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetAutomationsCBP indicates an expected call of GetAutomationsCBP.
-func (mr *ClientMockRecorder) GetAutomationsCBP(ctx, opts any) *gomock.Call {
+// GetManyJobStatuses indicates an expected call of GetManyJobStatuses.
+func (mr *ClientMockRecorder) GetManyJobStatuses(ctx, jobIDs any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutomationsCBP", reflect.TypeOf((*Client)(nil).GetAutomationsCBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManyJobStatuses", reflect.TypeOf((*Client)(nil).GetManyJobStatuses), ctx, jobIDs)
 }
 
-// GetAutomationsIterator mocks base method.
-func (m *Client) GetAutomationsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.Automation] {
+// GetManyTicketForms mocks base method.
+func (m *Client) GetManyTicketForms(ctx context.Context, ids []int64) ([]zendesk.TicketForm, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAutomationsIterator", ctx, opts)
-	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.Automation])
-	return ret0
+	ret := m.ctrl.Call(m, "GetManyTicketForms", ctx, ids)
+	ret0, _ := ret[0].([]zendesk.TicketForm)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetAutomationsIterator indicates an expected call of GetAutomationsIterator.
-func (mr *ClientMockRecorder) GetAutomationsIterator(ctx, opts any) *gomock.Call {
+// GetManyTicketForms indicates an expected call of GetManyTicketForms.
+func (mr *ClientMockRecorder) GetManyTicketForms(ctx, ids any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutomationsIterator", reflect.TypeOf((*Client)(nil).GetAutomationsIterator), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManyTicketForms", reflect.TypeOf((*Client)(nil).GetManyTicketForms), ctx, ids)
 }
 
-// GetAutomationsOBP mocks base method.
-func (m *Client) GetAutomationsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.Automation, zendesk.Page, error) {
+// GetManyUsers mocks base method.
+func (m *Client) GetManyUsers(ctx context.Context, opts *zendesk.GetManyUsersOptions) ([]zendesk.User, zendesk.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAutomationsOBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.Automation)
+	ret := m.ctrl.Call(m, "GetManyUsers", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.User)
 	ret1, _ := ret[1].(zendesk.Page)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetAutomationsOBP indicates an expected call of GetAutomationsOBP.
-func (mr *ClientMockRecorder) GetAutomationsOBP(ctx, opts any) *gomock.Call {
+// GetManyUsers indicates an expected call of GetManyUsers.
+func (mr *ClientMockRecorder) GetManyUsers(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutomationsOBP", reflect.TypeOf((*Client)(nil).GetAutomationsOBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManyUsers", reflect.TypeOf((*Client)(nil).GetManyUsers), ctx, opts)
 }
 
-// GetBrand mocks base method.
-func (m *Client) GetBrand(ctx context.Context, brandID int64) (zendesk.Brand, error) {
+// GetMissingTranslations mocks base method.
+func (m *Client) GetMissingTranslations(ctx context.Context, source zendesk.TranslationSource, sourceID int64) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBrand", ctx, brandID)
-	ret0, _ := ret[0].(zendesk.Brand)
+	ret := m.ctrl.Call(m, "GetMissingTranslations", ctx, source, sourceID)
+	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetBrand indicates an expected call of GetBrand.
-func (mr *ClientMockRecorder) GetBrand(ctx, brandID any) *gomock.Call {
+// GetMissingTranslations indicates an expected call of GetMissingTranslations.
+func (mr *ClientMockRecorder) GetMissingTranslations(ctx, source, sourceID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBrand", reflect.TypeOf((*Client)(nil).GetBrand), ctx, brandID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMissingTranslations", reflect.TypeOf((*Client)(nil).GetMissingTranslations), ctx, source, sourceID)
 }
 
-// GetCountTicketsInViews mocks base method.
-func (m *Client) GetCountTicketsInViews(arg0 context.Context, arg1 []string) ([]zendesk.ViewCount, error) {
+// GetMultipleTickets mocks base method.
+func (m *Client) GetMultipleTickets(ctx context.Context, ticketIDs []int64) ([]zendesk.Ticket, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCountTicketsInViews", arg0, arg1)
-	ret0, _ := ret[0].([]zendesk.ViewCount)
+	ret := m.ctrl.Call(m, "GetMultipleTickets", ctx, ticketIDs)
+	ret0, _ := ret[0].([]zendesk.Ticket)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetCountTicketsInViews indicates an expected call of GetCountTicketsInViews.
-func (mr *ClientMockRecorder) GetCountTicketsInViews(arg0, arg1 interface{}) *gomock.Call {
+// GetMultipleTickets indicates an expected call of GetMultipleTickets.
+func (mr *ClientMockRecorder) GetMultipleTickets(ctx, ticketIDs any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCountTicketsInViews", reflect.TypeOf((*Client)(nil).GetCountTicketsInViews), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMultipleTickets", reflect.TypeOf((*Client)(nil).GetMultipleTickets), ctx, ticketIDs)
 }
 
-// GetCustomRoles mocks base method.
-func (m *Client) GetCustomRoles(ctx context.Context) ([]zendesk.CustomRole, error) {
+// GetOrganization mocks base method.
+func (m *Client) GetOrganization(ctx context.Context, orgID int64) (zendesk.Organization, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCustomRoles", ctx)
-	ret0, _ := ret[0].([]zendesk.CustomRole)
+	ret := m.ctrl.Call(m, "GetOrganization", ctx, orgID)
+	ret0, _ := ret[0].(zendesk.Organization)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetCustomRoles indicates an expected call of GetCustomRoles.
-func (mr *ClientMockRecorder) GetCustomRoles(ctx any) *gomock.Call {
+// GetOrganization indicates an expected call of GetOrganization.
+func (mr *ClientMockRecorder) GetOrganization(ctx, orgID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomRoles", reflect.TypeOf((*Client)(nil).GetCustomRoles), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganization", reflect.TypeOf((*Client)(nil).GetOrganization), ctx, orgID)
 }
 
-// GetDynamicContentItem mocks base method.
-func (m *Client) GetDynamicContentItem(ctx context.Context, id int64) (zendesk.DynamicContentItem, error) {
+// GetOrganizationByExternalID mocks base method.
+func (m *Client) GetOrganizationByExternalID(ctx context.Context, externalID string) ([]zendesk.Organization, zendesk.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDynamicContentItem", ctx, id)
-	ret0, _ := ret[0].(zendesk.DynamicContentItem)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "GetOrganizationByExternalID", ctx, externalID)
+	ret0, _ := ret[0].([]zendesk.Organization)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
-// GetDynamicContentItem indicates an expected call of GetDynamicContentItem.
-func (mr *ClientMockRecorder) GetDynamicContentItem(ctx, id any) *gomock.Call {
+// GetOrganizationByExternalID indicates an expected call of GetOrganizationByExternalID.
+func (mr *ClientMockRecorder) GetOrganizationByExternalID(ctx, externalID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicContentItem", reflect.TypeOf((*Client)(nil).GetDynamicContentItem), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationByExternalID", reflect.TypeOf((*Client)(nil).GetOrganizationByExternalID), ctx, externalID)
 }
 
-// GetDynamicContentItems mocks base method.
-func (m *Client) GetDynamicContentItems(ctx context.Context) ([]zendesk.DynamicContentItem, zendesk.Page, error) {
+// GetOrganizationFieldOption mocks base method.
+func (m *Client) GetOrganizationFieldOption(ctx context.Context, fieldID, optionID int64) (zendesk.CustomFieldOption, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDynamicContentItems", ctx)
-	ret0, _ := ret[0].([]zendesk.DynamicContentItem)
-	ret1, _ := ret[1].(zendesk.Page)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "GetOrganizationFieldOption", ctx, fieldID, optionID)
+	ret0, _ := ret[0].(zendesk.CustomFieldOption)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetDynamicContentItems indicates an expected call of GetDynamicContentItems.
-func (mr *ClientMockRecorder) GetDynamicContentItems(ctx any) *gomock.Call {
+// GetOrganizationFieldOption indicates an expected call of GetOrganizationFieldOption.
+func (mr *ClientMockRecorder) GetOrganizationFieldOption(ctx, fieldID, optionID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicContentItems", reflect.TypeOf((*Client)(nil).GetDynamicContentItems), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationFieldOption", reflect.TypeOf((*Client)(nil).GetOrganizationFieldOption), ctx, fieldID, optionID)
 }
 
-// GetDynamicContentItemsCBP mocks base method.
-func (m *Client) GetDynamicContentItemsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.DynamicContentItem, zendesk.CursorPaginationMeta, error) {
+// GetOrganizationFieldOptionsCBP mocks base method.
+func (m *Client) GetOrganizationFieldOptionsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.CustomFieldOption, zendesk.CursorPaginationMeta, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDynamicContentItemsCBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.DynamicContentItem)
+	ret := m.ctrl.Call(m, "GetOrganizationFieldOptionsCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.CustomFieldOption)
 	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetDynamicContentItemsCBP indicates an expected call of GetDynamicContentItemsCBP.
-func (mr *ClientMockRecorder) GetDynamicContentItemsCBP(ctx, opts any) *gomock.Call {
+// GetOrganizationFieldOptionsCBP indicates an expected call of GetOrganizationFieldOptionsCBP.
+func (mr *ClientMockRecorder) GetOrganizationFieldOptionsCBP(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicContentItemsCBP", reflect.TypeOf((*Client)(nil).GetDynamicContentItemsCBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationFieldOptionsCBP", reflect.TypeOf((*Client)(nil).GetOrganizationFieldOptionsCBP), ctx, opts)
 }
 
-// GetDynamicContentItemsIterator mocks base method.
-func (m *Client) GetDynamicContentItemsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.DynamicContentItem] {
+// GetOrganizationFieldOptionsIterator mocks base method.
+func (m *Client) GetOrganizationFieldOptionsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.CustomFieldOption] {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDynamicContentItemsIterator", ctx, opts)
-	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.DynamicContentItem])
+	ret := m.ctrl.Call(m, "GetOrganizationFieldOptionsIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.CustomFieldOption])
 	return ret0
 }
 
-// GetDynamicContentItemsIterator indicates an expected call of GetDynamicContentItemsIterator.
-func (mr *ClientMockRecorder) GetDynamicContentItemsIterator(ctx, opts any) *gomock.Call {
+// GetOrganizationFieldOptionsIterator indicates an expected call of GetOrganizationFieldOptionsIterator.
+func (mr *ClientMockRecorder) GetOrganizationFieldOptionsIterator(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicContentItemsIterator", reflect.TypeOf((*Client)(nil).GetDynamicContentItemsIterator), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationFieldOptionsIterator", reflect.TypeOf((*Client)(nil).GetOrganizationFieldOptionsIterator), ctx, opts)
 }
 
-// GetDynamicContentItemsOBP mocks base method.
-func (m *Client) GetDynamicContentItemsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.DynamicContentItem, zendesk.Page, error) {
+// GetOrganizationFieldOptionsOBP mocks base method.
+func (m *Client) GetOrganizationFieldOptionsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.CustomFieldOption, zendesk.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDynamicContentItemsOBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.DynamicContentItem)
+	ret := m.ctrl.Call(m, "GetOrganizationFieldOptionsOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.CustomFieldOption)
 	ret1, _ := ret[1].(zendesk.Page)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetDynamicContentItemsOBP indicates an expected call of GetDynamicContentItemsOBP.
-func (mr *ClientMockRecorder) GetDynamicContentItemsOBP(ctx, opts any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicContentItemsOBP", reflect.TypeOf((*Client)(nil).GetDynamicContentItemsOBP), ctx, opts)
-}
-
-// GetGroup mocks base method.
-func (m *Client) GetGroup(ctx context.Context, groupID int64) (zendesk.Group, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetGroup", ctx, groupID)
-	ret0, _ := ret[0].(zendesk.Group)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// GetGroup indicates an expected call of GetGroup.
-func (mr *ClientMockRecorder) GetGroup(ctx, groupID any) *gomock.Call {
+// GetOrganizationFieldOptionsOBP indicates an expected call of GetOrganizationFieldOptionsOBP.
+func (mr *ClientMockRecorder) GetOrganizationFieldOptionsOBP(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroup", reflect.TypeOf((*Client)(nil).GetGroup), ctx, groupID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationFieldOptionsOBP", reflect.TypeOf((*Client)(nil).GetOrganizationFieldOptionsOBP), ctx, opts)
 }
 
-// GetGroupMemberships mocks base method.
-func (m *Client) GetGroupMemberships(arg0 context.Context, arg1 *zendesk.GroupMembershipListOptions) ([]zendesk.GroupMembership, zendesk.Page, error) {
+// GetOrganizationFields mocks base method.
+func (m *Client) GetOrganizationFields(ctx context.Context) ([]zendesk.OrganizationField, zendesk.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetGroupMemberships", arg0, arg1)
-	ret0, _ := ret[0].([]zendesk.GroupMembership)
+	ret := m.ctrl.Call(m, "GetOrganizationFields", ctx)
+	ret0, _ := ret[0].([]zendesk.OrganizationField)
 	ret1, _ := ret[1].(zendesk.Page)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetGroupMemberships indicates an expected call of GetGroupMemberships.
-func (mr *ClientMockRecorder) GetGroupMemberships(arg0, arg1 any) *gomock.Call {
+// GetOrganizationFields indicates an expected call of GetOrganizationFields.
+func (mr *ClientMockRecorder) GetOrganizationFields(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupMemberships", reflect.TypeOf((*Client)(nil).GetGroupMemberships), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationFields", reflect.TypeOf((*Client)(nil).GetOrganizationFields), ctx)
 }
 
-// GetGroupMembershipsCBP mocks base method.
-func (m *Client) GetGroupMembershipsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.GroupMembership, zendesk.CursorPaginationMeta, error) {
+// GetOrganizationFieldsCBP mocks base method.
+func (m *Client) GetOrganizationFieldsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.OrganizationField, zendesk.CursorPaginationMeta, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetGroupMembershipsCBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.GroupMembership)
+	ret := m.ctrl.Call(m, "GetOrganizationFieldsCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.OrganizationField)
 	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetGroupMembershipsCBP indicates an expected call of GetGroupMembershipsCBP.
-func (mr *ClientMockRecorder) GetGroupMembershipsCBP(ctx, opts any) *gomock.Call {
+// GetOrganizationFieldsCBP indicates an expected call of GetOrganizationFieldsCBP.
+func (mr *ClientMockRecorder) GetOrganizationFieldsCBP(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupMembershipsCBP", reflect.TypeOf((*Client)(nil).GetGroupMembershipsCBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationFieldsCBP", reflect.TypeOf((*Client)(nil).GetOrganizationFieldsCBP), ctx, opts)
 }
 
-// GetGroupMembershipsIterator mocks base method.
-func (m *Client) GetGroupMembershipsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.GroupMembership] {
+// GetOrganizationFieldsIterator mocks base method.
+func (m *Client) GetOrganizationFieldsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.OrganizationField] {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetGroupMembershipsIterator", ctx, opts)
-	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.GroupMembership])
+	ret := m.ctrl.Call(m, "GetOrganizationFieldsIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.OrganizationField])
 	return ret0
 }
 
-// GetGroupMembershipsIterator indicates an expected call of GetGroupMembershipsIterator.
-func (mr *ClientMockRecorder) GetGroupMembershipsIterator(ctx, opts any) *gomock.Call {
+// GetOrganizationFieldsIterator indicates an expected call of GetOrganizationFieldsIterator.
+func (mr *ClientMockRecorder) GetOrganizationFieldsIterator(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupMembershipsIterator", reflect.TypeOf((*Client)(nil).GetGroupMembershipsIterator), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationFieldsIterator", reflect.TypeOf((*Client)(nil).GetOrganizationFieldsIterator), ctx, opts)
 }
 
-// GetGroupMembershipsOBP mocks base method.
-func (m *Client) GetGroupMembershipsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.GroupMembership, zendesk.Page, error) {
+// GetOrganizationFieldsOBP mocks base method.
+func (m *Client) GetOrganizationFieldsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.OrganizationField, zendesk.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetGroupMembershipsOBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.GroupMembership)
+	ret := m.ctrl.Call(m, "GetOrganizationFieldsOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.OrganizationField)
 	ret1, _ := ret[1].(zendesk.Page)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetGroupMembershipsOBP indicates an expected call of GetGroupMembershipsOBP.
-func (mr *ClientMockRecorder) GetGroupMembershipsOBP(ctx, opts any) *gomock.Call {
+// GetOrganizationFieldsOBP indicates an expected call of GetOrganizationFieldsOBP.
+func (mr *ClientMockRecorder) GetOrganizationFieldsOBP(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupMembershipsOBP", reflect.TypeOf((*Client)(nil).GetGroupMembershipsOBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationFieldsOBP", reflect.TypeOf((*Client)(nil).GetOrganizationFieldsOBP), ctx, opts)
 }
 
-// GetGroups mocks base method.
-func (m *Client) GetGroups(ctx context.Context, opts *zendesk.GroupListOptions) ([]zendesk.Group, zendesk.Page, error) {
+// GetOrganizationMemberships mocks base method.
+func (m *Client) GetOrganizationMemberships(arg0 context.Context, arg1 *zendesk.OrganizationMembershipListOptions) ([]zendesk.OrganizationMembership, zendesk.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetGroups", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.Group)
+	ret := m.ctrl.Call(m, "GetOrganizationMemberships", arg0, arg1)
+	ret0, _ := ret[0].([]zendesk.OrganizationMembership)
 	ret1, _ := ret[1].(zendesk.Page)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetGroups indicates an expected call of GetGroups.
-func (mr *ClientMockRecorder) GetGroups(ctx, opts any) *gomock.Call {
+// GetOrganizationMemberships indicates an expected call of GetOrganizationMemberships.
+func (mr *ClientMockRecorder) GetOrganizationMemberships(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroups", reflect.TypeOf((*Client)(nil).GetGroups), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationMemberships", reflect.TypeOf((*Client)(nil).GetOrganizationMemberships), arg0, arg1)
 }
 
-// GetGroupsCBP mocks base method.
-func (m *Client) GetGroupsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.Group, zendesk.CursorPaginationMeta, error) {
+// GetOrganizationMembershipsCBP mocks base method.
+func (m *Client) GetOrganizationMembershipsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.OrganizationMembership, zendesk.CursorPaginationMeta, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetGroupsCBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.Group)
+	ret := m.ctrl.Call(m, "GetOrganizationMembershipsCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.OrganizationMembership)
 	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetGroupsCBP indicates an expected call of GetGroupsCBP.
-func (mr *ClientMockRecorder) GetGroupsCBP(ctx, opts any) *gomock.Call {
+// GetOrganizationMembershipsCBP indicates an expected call of GetOrganizationMembershipsCBP.
+func (mr *ClientMockRecorder) GetOrganizationMembershipsCBP(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupsCBP", reflect.TypeOf((*Client)(nil).GetGroupsCBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationMembershipsCBP", reflect.TypeOf((*Client)(nil).GetOrganizationMembershipsCBP), ctx, opts)
 }
 
-// GetGroupsIterator mocks base method.
-func (m *Client) GetGroupsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.Group] {
+// GetOrganizationMembershipsIterator mocks base method.
+func (m *Client) GetOrganizationMembershipsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.OrganizationMembership] {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetGroupsIterator", ctx, opts)
-	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.Group])
+	ret := m.ctrl.Call(m, "GetOrganizationMembershipsIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.OrganizationMembership])
 	return ret0
 }
 
-// GetGroupsIterator indicates an expected call of GetGroupsIterator.
-func (mr *ClientMockRecorder) GetGroupsIterator(ctx, opts any) *gomock.Call {
+// GetOrganizationMembershipsIterator indicates an expected call of GetOrganizationMembershipsIterator.
+func (mr *ClientMockRecorder) GetOrganizationMembershipsIterator(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupsIterator", reflect.TypeOf((*Client)(nil).GetGroupsIterator), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationMembershipsIterator", reflect.TypeOf((*Client)(nil).GetOrganizationMembershipsIterator), ctx, opts)
 }
 
-// GetGroupsOBP mocks base method.
-func (m *Client) GetGroupsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.Group, zendesk.Page, error) {
+// GetOrganizationMembershipsOBP mocks base method.
+func (m *Client) GetOrganizationMembershipsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.OrganizationMembership, zendesk.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetGroupsOBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.Group)
+	ret := m.ctrl.Call(m, "GetOrganizationMembershipsOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.OrganizationMembership)
 	ret1, _ := ret[1].(zendesk.Page)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetGroupsOBP indicates an expected call of GetGroupsOBP.
-func (mr *ClientMockRecorder) GetGroupsOBP(ctx, opts any) *gomock.Call {
+// GetOrganizationMembershipsOBP indicates an expected call of GetOrganizationMembershipsOBP.
+func (mr *ClientMockRecorder) GetOrganizationMembershipsOBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationMembershipsOBP", reflect.TypeOf((*Client)(nil).GetOrganizationMembershipsOBP), ctx, opts)
+}
+
+// GetOrganizationMerge mocks base method.
+func (m *Client) GetOrganizationMerge(ctx context.Context, mergeID string) (zendesk.OrganizationMerge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationMerge", ctx, mergeID)
+	ret0, _ := ret[0].(zendesk.OrganizationMerge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganizationMerge indicates an expected call of GetOrganizationMerge.
+func (mr *ClientMockRecorder) GetOrganizationMerge(ctx, mergeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationMerge", reflect.TypeOf((*Client)(nil).GetOrganizationMerge), ctx, mergeID)
+}
+
+// GetOrganizationMerges mocks base method.
+func (m *Client) GetOrganizationMerges(ctx context.Context, orgID int64) ([]zendesk.OrganizationMerge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationMerges", ctx, orgID)
+	ret0, _ := ret[0].([]zendesk.OrganizationMerge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganizationMerges indicates an expected call of GetOrganizationMerges.
+func (mr *ClientMockRecorder) GetOrganizationMerges(ctx, orgID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupsOBP", reflect.TypeOf((*Client)(nil).GetGroupsOBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationMerges", reflect.TypeOf((*Client)(nil).GetOrganizationMerges), ctx, orgID)
 }
 
-// GetLocales mocks base method.
-func (m *Client) GetLocales(ctx context.Context) ([]zendesk.Locale, error) {
+// GetOrganizationSubscription mocks base method.
+func (m *Client) GetOrganizationSubscription(ctx context.Context, subscriptionID int64) (zendesk.OrganizationSubscription, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetLocales", ctx)
-	ret0, _ := ret[0].([]zendesk.Locale)
+	ret := m.ctrl.Call(m, "GetOrganizationSubscription", ctx, subscriptionID)
+	ret0, _ := ret[0].(zendesk.OrganizationSubscription)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetLocales indicates an expected call of GetLocales.
-func (mr *ClientMockRecorder) GetLocales(ctx any) *gomock.Call {
+// GetOrganizationSubscription indicates an expected call of GetOrganizationSubscription.
+func (mr *ClientMockRecorder) GetOrganizationSubscription(ctx, subscriptionID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLocales", reflect.TypeOf((*Client)(nil).GetLocales), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationSubscription", reflect.TypeOf((*Client)(nil).GetOrganizationSubscription), ctx, subscriptionID)
 }
 
-// GetMacro mocks base method.
-func (m *Client) GetMacro(ctx context.Context, macroID int64) (zendesk.Macro, error) {
+// GetOrganizationSubscriptions mocks base method.
+func (m *Client) GetOrganizationSubscriptions(ctx context.Context, opts *zendesk.OrganizationSubscriptionListOptions) ([]zendesk.OrganizationSubscription, zendesk.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMacro", ctx, macroID)
-	ret0, _ := ret[0].(zendesk.Macro)
+	ret := m.ctrl.Call(m, "GetOrganizationSubscriptions", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.OrganizationSubscription)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOrganizationSubscriptions indicates an expected call of GetOrganizationSubscriptions.
+func (mr *ClientMockRecorder) GetOrganizationSubscriptions(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationSubscriptions", reflect.TypeOf((*Client)(nil).GetOrganizationSubscriptions), ctx, opts)
+}
+
+// GetOrganizationTags mocks base method.
+func (m *Client) GetOrganizationTags(ctx context.Context, organizationID int64) ([]zendesk.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationTags", ctx, organizationID)
+	ret0, _ := ret[0].([]zendesk.Tag)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetMacro indicates an expected call of GetMacro.
-func (mr *ClientMockRecorder) GetMacro(ctx, macroID any) *gomock.Call {
+// GetOrganizationTags indicates an expected call of GetOrganizationTags.
+func (mr *ClientMockRecorder) GetOrganizationTags(ctx, organizationID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMacro", reflect.TypeOf((*Client)(nil).GetMacro), ctx, macroID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationTags", reflect.TypeOf((*Client)(nil).GetOrganizationTags), ctx, organizationID)
 }
 
-// GetMacros mocks base method.
-func (m *Client) GetMacros(ctx context.Context, opts *zendesk.MacroListOptions) ([]zendesk.Macro, zendesk.Page, error) {
+// GetOrganizationTickets mocks base method.
+func (m *Client) GetOrganizationTickets(ctx context.Context, organizationID int64, ops *zendesk.TicketListOptions) ([]zendesk.Ticket, zendesk.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMacros", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.Macro)
+	ret := m.ctrl.Call(m, "GetOrganizationTickets", ctx, organizationID, ops)
+	ret0, _ := ret[0].([]zendesk.Ticket)
 	ret1, _ := ret[1].(zendesk.Page)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetMacros indicates an expected call of GetMacros.
-func (mr *ClientMockRecorder) GetMacros(ctx, opts any) *gomock.Call {
+// GetOrganizationTickets indicates an expected call of GetOrganizationTickets.
+func (mr *ClientMockRecorder) GetOrganizationTickets(ctx, organizationID, ops any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMacros", reflect.TypeOf((*Client)(nil).GetMacros), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationTickets", reflect.TypeOf((*Client)(nil).GetOrganizationTickets), ctx, organizationID, ops)
 }
 
-// GetMacrosCBP mocks base method.
-func (m *Client) GetMacrosCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.Macro, zendesk.CursorPaginationMeta, error) {
+// GetOrganizationTicketsCBP mocks base method.
+func (m *Client) GetOrganizationTicketsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.Ticket, zendesk.CursorPaginationMeta, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMacrosCBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.Macro)
+	ret := m.ctrl.Call(m, "GetOrganizationTicketsCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Ticket)
 	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetMacrosCBP indicates an expected call of GetMacrosCBP.
-func (mr *ClientMockRecorder) GetMacrosCBP(ctx, opts any) *gomock.Call {
+// GetOrganizationTicketsCBP indicates an expected call of GetOrganizationTicketsCBP.
+func (mr *ClientMockRecorder) GetOrganizationTicketsCBP(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMacrosCBP", reflect.TypeOf((*Client)(nil).GetMacrosCBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationTicketsCBP", reflect.TypeOf((*Client)(nil).GetOrganizationTicketsCBP), ctx, opts)
 }
 
-// GetMacrosIterator mocks base method.
-func (m *Client) GetMacrosIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.Macro] {
+// GetOrganizationTicketsIterator mocks base method.
+func (m *Client) GetOrganizationTicketsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.Ticket] {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMacrosIterator", ctx, opts)
-	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.Macro])
+	ret := m.ctrl.Call(m, "GetOrganizationTicketsIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.Ticket])
 	return ret0
 }
 
-// GetMacrosIterator indicates an expected call of GetMacrosIterator.
-func (mr *ClientMockRecorder) GetMacrosIterator(ctx, opts any) *gomock.Call {
+// GetOrganizationTicketsIterator indicates an expected call of GetOrganizationTicketsIterator.
+func (mr *ClientMockRecorder) GetOrganizationTicketsIterator(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMacrosIterator", reflect.TypeOf((*Client)(nil).GetMacrosIterator), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationTicketsIterator", reflect.TypeOf((*Client)(nil).GetOrganizationTicketsIterator), ctx, opts)
 }
 
-// GetMacrosOBP mocks base method.
-func (m *Client) GetMacrosOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.Macro, zendesk.Page, error) {
+// GetOrganizationTicketsOBP mocks base method.
+func (m *Client) GetOrganizationTicketsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.Ticket, zendesk.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMacrosOBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.Macro)
+	ret := m.ctrl.Call(m, "GetOrganizationTicketsOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Ticket)
 	ret1, _ := ret[1].(zendesk.Page)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetMacrosOBP indicates an expected call of GetMacrosOBP.
-func (mr *ClientMockRecorder) GetMacrosOBP(ctx, opts any) *gomock.Call {
+// GetOrganizationTicketsOBP indicates an expected call of GetOrganizationTicketsOBP.
+func (mr *ClientMockRecorder) GetOrganizationTicketsOBP(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMacrosOBP", reflect.TypeOf((*Client)(nil).GetMacrosOBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationTicketsOBP", reflect.TypeOf((*Client)(nil).GetOrganizationTicketsOBP), ctx, opts)
 }
 
-// GetManyUsers mocks base method.
-func (m *Client) GetManyUsers(ctx context.Context, opts *zendesk.GetManyUsersOptions) ([]zendesk.User, zendesk.Page, error) {
+// GetOrganizationUsers mocks base method.
+func (m *Client) GetOrganizationUsers(ctx context.Context, orgID int64, opts *zendesk.UserListOptions) ([]zendesk.User, zendesk.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetManyUsers", ctx, opts)
+	ret := m.ctrl.Call(m, "GetOrganizationUsers", ctx, orgID, opts)
 	ret0, _ := ret[0].([]zendesk.User)
 	ret1, _ := ret[1].(zendesk.Page)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetManyUsers indicates an expected call of GetManyUsers.
-func (mr *ClientMockRecorder) GetManyUsers(ctx, opts any) *gomock.Call {
+// GetOrganizationUsers indicates an expected call of GetOrganizationUsers.
+func (mr *ClientMockRecorder) GetOrganizationUsers(ctx, orgID, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManyUsers", reflect.TypeOf((*Client)(nil).GetManyUsers), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationUsers", reflect.TypeOf((*Client)(nil).GetOrganizationUsers), ctx, orgID, opts)
 }
 
-// GetMultipleTickets mocks base method.
-func (m *Client) GetMultipleTickets(ctx context.Context, ticketIDs []int64) ([]zendesk.Ticket, error) {
+// GetOrganizationUsersCBP mocks base method.
+func (m *Client) GetOrganizationUsersCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.User, zendesk.CursorPaginationMeta, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMultipleTickets", ctx, ticketIDs)
-	ret0, _ := ret[0].([]zendesk.Ticket)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "GetOrganizationUsersCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.User)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
-// GetMultipleTickets indicates an expected call of GetMultipleTickets.
-func (mr *ClientMockRecorder) GetMultipleTickets(ctx, ticketIDs any) *gomock.Call {
+// GetOrganizationUsersCBP indicates an expected call of GetOrganizationUsersCBP.
+func (mr *ClientMockRecorder) GetOrganizationUsersCBP(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMultipleTickets", reflect.TypeOf((*Client)(nil).GetMultipleTickets), ctx, ticketIDs)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationUsersCBP", reflect.TypeOf((*Client)(nil).GetOrganizationUsersCBP), ctx, opts)
 }
 
-// GetOrganization mocks base method.
-func (m *Client) GetOrganization(ctx context.Context, orgID int64) (zendesk.Organization, error) {
+// GetOrganizationUsersIterator mocks base method.
+func (m *Client) GetOrganizationUsersIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.User] {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganization", ctx, orgID)
-	ret0, _ := ret[0].(zendesk.Organization)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "GetOrganizationUsersIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.User])
+	return ret0
 }
 
-// GetOrganization indicates an expected call of GetOrganization.
-func (mr *ClientMockRecorder) GetOrganization(ctx, orgID any) *gomock.Call {
+// GetOrganizationUsersIterator indicates an expected call of GetOrganizationUsersIterator.
+func (mr *ClientMockRecorder) GetOrganizationUsersIterator(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganization", reflect.TypeOf((*Client)(nil).GetOrganization), ctx, orgID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationUsersIterator", reflect.TypeOf((*Client)(nil).GetOrganizationUsersIterator), ctx, opts)
 }
 
-// GetOrganizationByExternalID mocks base method.
-func (m *Client) GetOrganizationByExternalID(ctx context.Context, externalID string) ([]zendesk.Organization, zendesk.Page, error) {
+// GetOrganizationUsersOBP mocks base method.
+func (m *Client) GetOrganizationUsersOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.User, zendesk.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationByExternalID", ctx, externalID)
-	ret0, _ := ret[0].([]zendesk.Organization)
+	ret := m.ctrl.Call(m, "GetOrganizationUsersOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.User)
 	ret1, _ := ret[1].(zendesk.Page)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetOrganizationByExternalID indicates an expected call of GetOrganizationByExternalID.
-func (mr *ClientMockRecorder) GetOrganizationByExternalID(ctx, externalID any) *gomock.Call {
+// GetOrganizationUsersOBP indicates an expected call of GetOrganizationUsersOBP.
+func (mr *ClientMockRecorder) GetOrganizationUsersOBP(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationByExternalID", reflect.TypeOf((*Client)(nil).GetOrganizationByExternalID), ctx, externalID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationUsersOBP", reflect.TypeOf((*Client)(nil).GetOrganizationUsersOBP), ctx, opts)
 }
 
-// GetOrganizationFields mocks base method.
-func (m *Client) GetOrganizationFields(ctx context.Context) ([]zendesk.OrganizationField, zendesk.Page, error) {
+// GetOrganizations mocks base method.
+func (m *Client) GetOrganizations(ctx context.Context, opts *zendesk.OrganizationListOptions) ([]zendesk.Organization, zendesk.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationFields", ctx)
-	ret0, _ := ret[0].([]zendesk.OrganizationField)
+	ret := m.ctrl.Call(m, "GetOrganizations", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Organization)
 	ret1, _ := ret[1].(zendesk.Page)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetOrganizationFields indicates an expected call of GetOrganizationFields.
-func (mr *ClientMockRecorder) GetOrganizationFields(ctx any) *gomock.Call {
+// GetOrganizations indicates an expected call of GetOrganizations.
+func (mr *ClientMockRecorder) GetOrganizations(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationFields", reflect.TypeOf((*Client)(nil).GetOrganizationFields), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizations", reflect.TypeOf((*Client)(nil).GetOrganizations), ctx, opts)
 }
 
-// GetOrganizationFieldsCBP mocks base method.
-func (m *Client) GetOrganizationFieldsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.OrganizationField, zendesk.CursorPaginationMeta, error) {
+// GetOrganizationsCBP mocks base method.
+func (m *Client) GetOrganizationsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.Organization, zendesk.CursorPaginationMeta, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationFieldsCBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.OrganizationField)
+	ret := m.ctrl.Call(m, "GetOrganizationsCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Organization)
 	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetOrganizationFieldsCBP indicates an expected call of GetOrganizationFieldsCBP.
-func (mr *ClientMockRecorder) GetOrganizationFieldsCBP(ctx, opts any) *gomock.Call {
+// GetOrganizationsCBP indicates an expected call of GetOrganizationsCBP.
+func (mr *ClientMockRecorder) GetOrganizationsCBP(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationFieldsCBP", reflect.TypeOf((*Client)(nil).GetOrganizationFieldsCBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationsCBP", reflect.TypeOf((*Client)(nil).GetOrganizationsCBP), ctx, opts)
 }
 
-// GetOrganizationFieldsIterator mocks base method.
-func (m *Client) GetOrganizationFieldsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.OrganizationField] {
+// GetOrganizationsIterator mocks base method.
+func (m *Client) GetOrganizationsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.Organization] {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationFieldsIterator", ctx, opts)
-	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.OrganizationField])
+	ret := m.ctrl.Call(m, "GetOrganizationsIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.Organization])
 	return ret0
 }
 
-// GetOrganizationFieldsIterator indicates an expected call of GetOrganizationFieldsIterator.
-func (mr *ClientMockRecorder) GetOrganizationFieldsIterator(ctx, opts any) *gomock.Call {
+// GetOrganizationsIterator indicates an expected call of GetOrganizationsIterator.
+func (mr *ClientMockRecorder) GetOrganizationsIterator(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationFieldsIterator", reflect.TypeOf((*Client)(nil).GetOrganizationFieldsIterator), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationsIterator", reflect.TypeOf((*Client)(nil).GetOrganizationsIterator), ctx, opts)
 }
 
-// GetOrganizationFieldsOBP mocks base method.
-func (m *Client) GetOrganizationFieldsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.OrganizationField, zendesk.Page, error) {
+// GetOrganizationsOBP mocks base method.
+func (m *Client) GetOrganizationsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.Organization, zendesk.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationFieldsOBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.OrganizationField)
+	ret := m.ctrl.Call(m, "GetOrganizationsOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Organization)
 	ret1, _ := ret[1].(zendesk.Page)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetOrganizationFieldsOBP indicates an expected call of GetOrganizationFieldsOBP.
-func (mr *ClientMockRecorder) GetOrganizationFieldsOBP(ctx, opts any) *gomock.Call {
+// GetOrganizationsOBP indicates an expected call of GetOrganizationsOBP.
+func (mr *ClientMockRecorder) GetOrganizationsOBP(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationFieldsOBP", reflect.TypeOf((*Client)(nil).GetOrganizationFieldsOBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationsOBP", reflect.TypeOf((*Client)(nil).GetOrganizationsOBP), ctx, opts)
 }
 
-// GetOrganizationMemberships mocks base method.
-func (m *Client) GetOrganizationMemberships(arg0 context.Context, arg1 *zendesk.OrganizationMembershipListOptions) ([]zendesk.OrganizationMembership, zendesk.Page, error) {
+// GetRelatedCustomObjectRecords mocks base method.
+func (m *Client) GetRelatedCustomObjectRecords(ctx context.Context, target zendesk.LookupRelationshipTarget, fieldID int64, customObjectKey string, opts *zendesk.CBPOptions) ([]zendesk.CustomObjectRecord, zendesk.CursorPaginationMeta, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationMemberships", arg0, arg1)
-	ret0, _ := ret[0].([]zendesk.OrganizationMembership)
-	ret1, _ := ret[1].(zendesk.Page)
+	ret := m.ctrl.Call(m, "GetRelatedCustomObjectRecords", ctx, target, fieldID, customObjectKey, opts)
+	ret0, _ := ret[0].([]zendesk.CustomObjectRecord)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetOrganizationMemberships indicates an expected call of GetOrganizationMemberships.
-func (mr *ClientMockRecorder) GetOrganizationMemberships(arg0, arg1 any) *gomock.Call {
+// GetRelatedCustomObjectRecords indicates an expected call of GetRelatedCustomObjectRecords.
+func (mr *ClientMockRecorder) GetRelatedCustomObjectRecords(ctx, target, fieldID, customObjectKey, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationMemberships", reflect.TypeOf((*Client)(nil).GetOrganizationMemberships), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRelatedCustomObjectRecords", reflect.TypeOf((*Client)(nil).GetRelatedCustomObjectRecords), ctx, target, fieldID, customObjectKey, opts)
 }
 
-// GetOrganizationMembershipsCBP mocks base method.
-func (m *Client) GetOrganizationMembershipsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.OrganizationMembership, zendesk.CursorPaginationMeta, error) {
+// GetRelatedCustomObjectRecordsIterator mocks base method.
+func (m *Client) GetRelatedCustomObjectRecordsIterator(ctx context.Context, target zendesk.LookupRelationshipTarget, fieldID int64, customObjectKey string, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.CustomObjectRecord] {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationMembershipsCBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.OrganizationMembership)
+	ret := m.ctrl.Call(m, "GetRelatedCustomObjectRecordsIterator", ctx, target, fieldID, customObjectKey, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.CustomObjectRecord])
+	return ret0
+}
+
+// GetRelatedCustomObjectRecordsIterator indicates an expected call of GetRelatedCustomObjectRecordsIterator.
+func (mr *ClientMockRecorder) GetRelatedCustomObjectRecordsIterator(ctx, target, fieldID, customObjectKey, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRelatedCustomObjectRecordsIterator", reflect.TypeOf((*Client)(nil).GetRelatedCustomObjectRecordsIterator), ctx, target, fieldID, customObjectKey, opts)
+}
+
+// GetRelatedOrganizations mocks base method.
+func (m *Client) GetRelatedOrganizations(ctx context.Context, target zendesk.LookupRelationshipTarget, fieldID int64, opts *zendesk.CBPOptions) ([]zendesk.Organization, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRelatedOrganizations", ctx, target, fieldID, opts)
+	ret0, _ := ret[0].([]zendesk.Organization)
 	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetOrganizationMembershipsCBP indicates an expected call of GetOrganizationMembershipsCBP.
-func (mr *ClientMockRecorder) GetOrganizationMembershipsCBP(ctx, opts any) *gomock.Call {
+// GetRelatedOrganizations indicates an expected call of GetRelatedOrganizations.
+func (mr *ClientMockRecorder) GetRelatedOrganizations(ctx, target, fieldID, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationMembershipsCBP", reflect.TypeOf((*Client)(nil).GetOrganizationMembershipsCBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRelatedOrganizations", reflect.TypeOf((*Client)(nil).GetRelatedOrganizations), ctx, target, fieldID, opts)
 }
 
-// GetOrganizationMembershipsIterator mocks base method.
-func (m *Client) GetOrganizationMembershipsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.OrganizationMembership] {
+// GetRelatedOrganizationsIterator mocks base method.
+func (m *Client) GetRelatedOrganizationsIterator(ctx context.Context, target zendesk.LookupRelationshipTarget, fieldID int64, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.Organization] {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationMembershipsIterator", ctx, opts)
-	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.OrganizationMembership])
+	ret := m.ctrl.Call(m, "GetRelatedOrganizationsIterator", ctx, target, fieldID, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.Organization])
+	return ret0
+}
+
+// GetRelatedOrganizationsIterator indicates an expected call of GetRelatedOrganizationsIterator.
+func (mr *ClientMockRecorder) GetRelatedOrganizationsIterator(ctx, target, fieldID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRelatedOrganizationsIterator", reflect.TypeOf((*Client)(nil).GetRelatedOrganizationsIterator), ctx, target, fieldID, opts)
+}
+
+// GetRelatedTickets mocks base method.
+func (m *Client) GetRelatedTickets(ctx context.Context, target zendesk.LookupRelationshipTarget, fieldID int64, opts *zendesk.CBPOptions) ([]zendesk.Ticket, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRelatedTickets", ctx, target, fieldID, opts)
+	ret0, _ := ret[0].([]zendesk.Ticket)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetRelatedTickets indicates an expected call of GetRelatedTickets.
+func (mr *ClientMockRecorder) GetRelatedTickets(ctx, target, fieldID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRelatedTickets", reflect.TypeOf((*Client)(nil).GetRelatedTickets), ctx, target, fieldID, opts)
+}
+
+// GetRelatedTicketsIterator mocks base method.
+func (m *Client) GetRelatedTicketsIterator(ctx context.Context, target zendesk.LookupRelationshipTarget, fieldID int64, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.Ticket] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRelatedTicketsIterator", ctx, target, fieldID, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.Ticket])
 	return ret0
 }
 
-// GetOrganizationMembershipsIterator indicates an expected call of GetOrganizationMembershipsIterator.
-func (mr *ClientMockRecorder) GetOrganizationMembershipsIterator(ctx, opts any) *gomock.Call {
+// GetRelatedTicketsIterator indicates an expected call of GetRelatedTicketsIterator.
+func (mr *ClientMockRecorder) GetRelatedTicketsIterator(ctx, target, fieldID, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationMembershipsIterator", reflect.TypeOf((*Client)(nil).GetOrganizationMembershipsIterator), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRelatedTicketsIterator", reflect.TypeOf((*Client)(nil).GetRelatedTicketsIterator), ctx, target, fieldID, opts)
 }
 
-// GetOrganizationMembershipsOBP mocks base method.
-func (m *Client) GetOrganizationMembershipsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.OrganizationMembership, zendesk.Page, error) {
+// GetRelatedUsers mocks base method.
+func (m *Client) GetRelatedUsers(ctx context.Context, target zendesk.LookupRelationshipTarget, fieldID int64, opts *zendesk.CBPOptions) ([]zendesk.User, zendesk.CursorPaginationMeta, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationMembershipsOBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.OrganizationMembership)
-	ret1, _ := ret[1].(zendesk.Page)
+	ret := m.ctrl.Call(m, "GetRelatedUsers", ctx, target, fieldID, opts)
+	ret0, _ := ret[0].([]zendesk.User)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetOrganizationMembershipsOBP indicates an expected call of GetOrganizationMembershipsOBP.
-func (mr *ClientMockRecorder) GetOrganizationMembershipsOBP(ctx, opts any) *gomock.Call {
+// GetRelatedUsers indicates an expected call of GetRelatedUsers.
+func (mr *ClientMockRecorder) GetRelatedUsers(ctx, target, fieldID, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationMembershipsOBP", reflect.TypeOf((*Client)(nil).GetOrganizationMembershipsOBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRelatedUsers", reflect.TypeOf((*Client)(nil).GetRelatedUsers), ctx, target, fieldID, opts)
 }
 
-// GetOrganizationTags mocks base method.
-func (m *Client) GetOrganizationTags(ctx context.Context, organizationID int64) ([]zendesk.Tag, error) {
+// GetRelatedUsersIterator mocks base method.
+func (m *Client) GetRelatedUsersIterator(ctx context.Context, target zendesk.LookupRelationshipTarget, fieldID int64, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.User] {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationTags", ctx, organizationID)
-	ret0, _ := ret[0].([]zendesk.Tag)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "GetRelatedUsersIterator", ctx, target, fieldID, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.User])
+	return ret0
 }
 
-// GetOrganizationTags indicates an expected call of GetOrganizationTags.
-func (mr *ClientMockRecorder) GetOrganizationTags(ctx, organizationID any) *gomock.Call {
+// GetRelatedUsersIterator indicates an expected call of GetRelatedUsersIterator.
+func (mr *ClientMockRecorder) GetRelatedUsersIterator(ctx, target, fieldID, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationTags", reflect.TypeOf((*Client)(nil).GetOrganizationTags), ctx, organizationID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRelatedUsersIterator", reflect.TypeOf((*Client)(nil).GetRelatedUsersIterator), ctx, target, fieldID, opts)
 }
 
-// GetOrganizationTickets mocks base method.
-func (m *Client) GetOrganizationTickets(ctx context.Context, organizationID int64, ops *zendesk.TicketListOptions) ([]zendesk.Ticket, zendesk.Page, error) {
+// GetRelationshipFilterDefinitions mocks base method.
+func (m *Client) GetRelationshipFilterDefinitions(ctx context.Context, targetType, sourceType string) (zendesk.RelationshipFilterDefinitions, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationTickets", ctx, organizationID, ops)
-	ret0, _ := ret[0].([]zendesk.Ticket)
-	ret1, _ := ret[1].(zendesk.Page)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "GetRelationshipFilterDefinitions", ctx, targetType, sourceType)
+	ret0, _ := ret[0].(zendesk.RelationshipFilterDefinitions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetOrganizationTickets indicates an expected call of GetOrganizationTickets.
-func (mr *ClientMockRecorder) GetOrganizationTickets(ctx, organizationID, ops any) *gomock.Call {
+// GetRelationshipFilterDefinitions indicates an expected call of GetRelationshipFilterDefinitions.
+func (mr *ClientMockRecorder) GetRelationshipFilterDefinitions(ctx, targetType, sourceType any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationTickets", reflect.TypeOf((*Client)(nil).GetOrganizationTickets), ctx, organizationID, ops)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRelationshipFilterDefinitions", reflect.TypeOf((*Client)(nil).GetRelationshipFilterDefinitions), ctx, targetType, sourceType)
 }
 
-// GetOrganizationTicketsCBP mocks base method.
-func (m *Client) GetOrganizationTicketsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.Ticket, zendesk.CursorPaginationMeta, error) {
+// GetRoutingAttribute mocks base method.
+func (m *Client) GetRoutingAttribute(ctx context.Context, attributeID string) (zendesk.RoutingAttribute, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationTicketsCBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.Ticket)
-	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "GetRoutingAttribute", ctx, attributeID)
+	ret0, _ := ret[0].(zendesk.RoutingAttribute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetOrganizationTicketsCBP indicates an expected call of GetOrganizationTicketsCBP.
-func (mr *ClientMockRecorder) GetOrganizationTicketsCBP(ctx, opts any) *gomock.Call {
+// GetRoutingAttribute indicates an expected call of GetRoutingAttribute.
+func (mr *ClientMockRecorder) GetRoutingAttribute(ctx, attributeID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationTicketsCBP", reflect.TypeOf((*Client)(nil).GetOrganizationTicketsCBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoutingAttribute", reflect.TypeOf((*Client)(nil).GetRoutingAttribute), ctx, attributeID)
 }
 
-// GetOrganizationTicketsIterator mocks base method.
-func (m *Client) GetOrganizationTicketsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.Ticket] {
+// GetRoutingAttributeDefinitions mocks base method.
+func (m *Client) GetRoutingAttributeDefinitions(ctx context.Context) (zendesk.RoutingAttributeDefinitions, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationTicketsIterator", ctx, opts)
-	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.Ticket])
-	return ret0
+	ret := m.ctrl.Call(m, "GetRoutingAttributeDefinitions", ctx)
+	ret0, _ := ret[0].(zendesk.RoutingAttributeDefinitions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetOrganizationTicketsIterator indicates an expected call of GetOrganizationTicketsIterator.
-func (mr *ClientMockRecorder) GetOrganizationTicketsIterator(ctx, opts any) *gomock.Call {
+// GetRoutingAttributeDefinitions indicates an expected call of GetRoutingAttributeDefinitions.
+func (mr *ClientMockRecorder) GetRoutingAttributeDefinitions(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationTicketsIterator", reflect.TypeOf((*Client)(nil).GetOrganizationTicketsIterator), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoutingAttributeDefinitions", reflect.TypeOf((*Client)(nil).GetRoutingAttributeDefinitions), ctx)
 }
 
-// GetOrganizationTicketsOBP mocks base method.
-func (m *Client) GetOrganizationTicketsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.Ticket, zendesk.Page, error) {
+// GetRoutingAttributeValue mocks base method.
+func (m *Client) GetRoutingAttributeValue(ctx context.Context, attributeID, valueID string) (zendesk.RoutingAttributeValue, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationTicketsOBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.Ticket)
-	ret1, _ := ret[1].(zendesk.Page)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "GetRoutingAttributeValue", ctx, attributeID, valueID)
+	ret0, _ := ret[0].(zendesk.RoutingAttributeValue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetOrganizationTicketsOBP indicates an expected call of GetOrganizationTicketsOBP.
-func (mr *ClientMockRecorder) GetOrganizationTicketsOBP(ctx, opts any) *gomock.Call {
+// GetRoutingAttributeValue indicates an expected call of GetRoutingAttributeValue.
+func (mr *ClientMockRecorder) GetRoutingAttributeValue(ctx, attributeID, valueID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationTicketsOBP", reflect.TypeOf((*Client)(nil).GetOrganizationTicketsOBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoutingAttributeValue", reflect.TypeOf((*Client)(nil).GetRoutingAttributeValue), ctx, attributeID, valueID)
 }
 
-// GetOrganizationUsers mocks base method.
-func (m *Client) GetOrganizationUsers(ctx context.Context, orgID int64, opts *zendesk.UserListOptions) ([]zendesk.User, zendesk.Page, error) {
+// GetRoutingAttributeValues mocks base method.
+func (m *Client) GetRoutingAttributeValues(ctx context.Context, attributeID string) ([]zendesk.RoutingAttributeValue, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationUsers", ctx, orgID, opts)
-	ret0, _ := ret[0].([]zendesk.User)
-	ret1, _ := ret[1].(zendesk.Page)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "GetRoutingAttributeValues", ctx, attributeID)
+	ret0, _ := ret[0].([]zendesk.RoutingAttributeValue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetOrganizationUsers indicates an expected call of GetOrganizationUsers.
-func (mr *ClientMockRecorder) GetOrganizationUsers(ctx, orgID, opts any) *gomock.Call {
+// GetRoutingAttributeValues indicates an expected call of GetRoutingAttributeValues.
+func (mr *ClientMockRecorder) GetRoutingAttributeValues(ctx, attributeID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationUsers", reflect.TypeOf((*Client)(nil).GetOrganizationUsers), ctx, orgID, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoutingAttributeValues", reflect.TypeOf((*Client)(nil).GetRoutingAttributeValues), ctx, attributeID)
 }
 
-// GetOrganizationUsersCBP mocks base method.
-func (m *Client) GetOrganizationUsersCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.User, zendesk.CursorPaginationMeta, error) {
+// GetRoutingAttributes mocks base method.
+func (m *Client) GetRoutingAttributes(ctx context.Context) ([]zendesk.RoutingAttribute, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationUsersCBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.User)
-	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "GetRoutingAttributes", ctx)
+	ret0, _ := ret[0].([]zendesk.RoutingAttribute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetOrganizationUsersCBP indicates an expected call of GetOrganizationUsersCBP.
-func (mr *ClientMockRecorder) GetOrganizationUsersCBP(ctx, opts any) *gomock.Call {
+// GetRoutingAttributes indicates an expected call of GetRoutingAttributes.
+func (mr *ClientMockRecorder) GetRoutingAttributes(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationUsersCBP", reflect.TypeOf((*Client)(nil).GetOrganizationUsersCBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoutingAttributes", reflect.TypeOf((*Client)(nil).GetRoutingAttributes), ctx)
 }
 
-// GetOrganizationUsersIterator mocks base method.
-func (m *Client) GetOrganizationUsersIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.User] {
+// GetRoutingQueue mocks base method.
+func (m *Client) GetRoutingQueue(ctx context.Context, queueID string) (zendesk.RoutingQueue, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationUsersIterator", ctx, opts)
-	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.User])
-	return ret0
+	ret := m.ctrl.Call(m, "GetRoutingQueue", ctx, queueID)
+	ret0, _ := ret[0].(zendesk.RoutingQueue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetOrganizationUsersIterator indicates an expected call of GetOrganizationUsersIterator.
-func (mr *ClientMockRecorder) GetOrganizationUsersIterator(ctx, opts any) *gomock.Call {
+// GetRoutingQueue indicates an expected call of GetRoutingQueue.
+func (mr *ClientMockRecorder) GetRoutingQueue(ctx, queueID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationUsersIterator", reflect.TypeOf((*Client)(nil).GetOrganizationUsersIterator), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoutingQueue", reflect.TypeOf((*Client)(nil).GetRoutingQueue), ctx, queueID)
 }
 
-// GetOrganizationUsersOBP mocks base method.
-func (m *Client) GetOrganizationUsersOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.User, zendesk.Page, error) {
+// GetRoutingQueueDefinitions mocks base method.
+func (m *Client) GetRoutingQueueDefinitions(ctx context.Context) (zendesk.RoutingQueueDefinitions, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationUsersOBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.User)
-	ret1, _ := ret[1].(zendesk.Page)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "GetRoutingQueueDefinitions", ctx)
+	ret0, _ := ret[0].(zendesk.RoutingQueueDefinitions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetOrganizationUsersOBP indicates an expected call of GetOrganizationUsersOBP.
-func (mr *ClientMockRecorder) GetOrganizationUsersOBP(ctx, opts any) *gomock.Call {
+// GetRoutingQueueDefinitions indicates an expected call of GetRoutingQueueDefinitions.
+func (mr *ClientMockRecorder) GetRoutingQueueDefinitions(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationUsersOBP", reflect.TypeOf((*Client)(nil).GetOrganizationUsersOBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoutingQueueDefinitions", reflect.TypeOf((*Client)(nil).GetRoutingQueueDefinitions), ctx)
 }
 
-// GetOrganizations mocks base method.
-func (m *Client) GetOrganizations(ctx context.Context, opts *zendesk.OrganizationListOptions) ([]zendesk.Organization, zendesk.Page, error) {
+// GetRoutingQueues mocks base method.
+func (m *Client) GetRoutingQueues(ctx context.Context) ([]zendesk.RoutingQueue, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizations", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.Organization)
-	ret1, _ := ret[1].(zendesk.Page)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "GetRoutingQueues", ctx)
+	ret0, _ := ret[0].([]zendesk.RoutingQueue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetOrganizations indicates an expected call of GetOrganizations.
-func (mr *ClientMockRecorder) GetOrganizations(ctx, opts any) *gomock.Call {
+// GetRoutingQueues indicates an expected call of GetRoutingQueues.
+func (mr *ClientMockRecorder) GetRoutingQueues(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizations", reflect.TypeOf((*Client)(nil).GetOrganizations), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoutingQueues", reflect.TypeOf((*Client)(nil).GetRoutingQueues), ctx)
 }
 
-// GetOrganizationsCBP mocks base method.
-func (m *Client) GetOrganizationsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.Organization, zendesk.CursorPaginationMeta, error) {
+// GetRoutingQueuesCBP mocks base method.
+func (m *Client) GetRoutingQueuesCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.RoutingQueue, zendesk.CursorPaginationMeta, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationsCBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.Organization)
+	ret := m.ctrl.Call(m, "GetRoutingQueuesCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.RoutingQueue)
 	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetOrganizationsCBP indicates an expected call of GetOrganizationsCBP.
-func (mr *ClientMockRecorder) GetOrganizationsCBP(ctx, opts any) *gomock.Call {
+// GetRoutingQueuesCBP indicates an expected call of GetRoutingQueuesCBP.
+func (mr *ClientMockRecorder) GetRoutingQueuesCBP(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationsCBP", reflect.TypeOf((*Client)(nil).GetOrganizationsCBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoutingQueuesCBP", reflect.TypeOf((*Client)(nil).GetRoutingQueuesCBP), ctx, opts)
 }
 
-// GetOrganizationsIterator mocks base method.
-func (m *Client) GetOrganizationsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.Organization] {
+// GetRoutingQueuesIterator mocks base method.
+func (m *Client) GetRoutingQueuesIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.RoutingQueue] {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationsIterator", ctx, opts)
-	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.Organization])
+	ret := m.ctrl.Call(m, "GetRoutingQueuesIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.RoutingQueue])
 	return ret0
 }
 
-// GetOrganizationsIterator indicates an expected call of GetOrganizationsIterator.
-func (mr *ClientMockRecorder) GetOrganizationsIterator(ctx, opts any) *gomock.Call {
+// GetRoutingQueuesIterator indicates an expected call of GetRoutingQueuesIterator.
+func (mr *ClientMockRecorder) GetRoutingQueuesIterator(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationsIterator", reflect.TypeOf((*Client)(nil).GetOrganizationsIterator), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoutingQueuesIterator", reflect.TypeOf((*Client)(nil).GetRoutingQueuesIterator), ctx, opts)
 }
 
-// GetOrganizationsOBP mocks base method.
-func (m *Client) GetOrganizationsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.Organization, zendesk.Page, error) {
+// GetRoutingQueuesOBP mocks base method.
+func (m *Client) GetRoutingQueuesOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.RoutingQueue, zendesk.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationsOBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.Organization)
+	ret := m.ctrl.Call(m, "GetRoutingQueuesOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.RoutingQueue)
 	ret1, _ := ret[1].(zendesk.Page)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetOrganizationsOBP indicates an expected call of GetOrganizationsOBP.
-func (mr *ClientMockRecorder) GetOrganizationsOBP(ctx, opts any) *gomock.Call {
+// GetRoutingQueuesOBP indicates an expected call of GetRoutingQueuesOBP.
+func (mr *ClientMockRecorder) GetRoutingQueuesOBP(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationsOBP", reflect.TypeOf((*Client)(nil).GetOrganizationsOBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoutingQueuesOBP", reflect.TypeOf((*Client)(nil).GetRoutingQueuesOBP), ctx, opts)
+}
+
+// GetRoutingRequirementsFulfilled mocks base method.
+func (m *Client) GetRoutingRequirementsFulfilled(ctx context.Context, ticketIDs []int64) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoutingRequirementsFulfilled", ctx, ticketIDs)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRoutingRequirementsFulfilled indicates an expected call of GetRoutingRequirementsFulfilled.
+func (mr *ClientMockRecorder) GetRoutingRequirementsFulfilled(ctx, ticketIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoutingRequirementsFulfilled", reflect.TypeOf((*Client)(nil).GetRoutingRequirementsFulfilled), ctx, ticketIDs)
 }
 
 // GetSLAPolicies mocks base method.
@@ -1544,56 +4130,193 @@ func (m *Client) GetSLAPolicy(ctx context.Context, id int64) (zendesk.SLAPolicy,
 	return ret0, ret1
 }
 
-// GetSLAPolicy indicates an expected call of GetSLAPolicy.
-func (mr *ClientMockRecorder) GetSLAPolicy(ctx, id any) *gomock.Call {
+// GetSLAPolicy indicates an expected call of GetSLAPolicy.
+func (mr *ClientMockRecorder) GetSLAPolicy(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSLAPolicy", reflect.TypeOf((*Client)(nil).GetSLAPolicy), ctx, id)
+}
+
+// GetSchedule mocks base method.
+func (m *Client) GetSchedule(ctx context.Context, scheduleID int64) (zendesk.Schedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSchedule", ctx, scheduleID)
+	ret0, _ := ret[0].(zendesk.Schedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSchedule indicates an expected call of GetSchedule.
+func (mr *ClientMockRecorder) GetSchedule(ctx, scheduleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSchedule", reflect.TypeOf((*Client)(nil).GetSchedule), ctx, scheduleID)
+}
+
+// GetSchedules mocks base method.
+func (m *Client) GetSchedules(ctx context.Context) ([]zendesk.Schedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSchedules", ctx)
+	ret0, _ := ret[0].([]zendesk.Schedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSchedules indicates an expected call of GetSchedules.
+func (mr *ClientMockRecorder) GetSchedules(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSchedules", reflect.TypeOf((*Client)(nil).GetSchedules), ctx)
+}
+
+// GetSearchCBP mocks base method.
+func (m *Client) GetSearchCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.SearchResults, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSearchCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.SearchResults)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSearchCBP indicates an expected call of GetSearchCBP.
+func (mr *ClientMockRecorder) GetSearchCBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSearchCBP", reflect.TypeOf((*Client)(nil).GetSearchCBP), ctx, opts)
+}
+
+// GetSearchIterator mocks base method.
+func (m *Client) GetSearchIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.SearchResults] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSearchIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.SearchResults])
+	return ret0
+}
+
+// GetSearchIterator indicates an expected call of GetSearchIterator.
+func (mr *ClientMockRecorder) GetSearchIterator(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSearchIterator", reflect.TypeOf((*Client)(nil).GetSearchIterator), ctx, opts)
+}
+
+// GetSearchOBP mocks base method.
+func (m *Client) GetSearchOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.SearchResults, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSearchOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.SearchResults)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSearchOBP indicates an expected call of GetSearchOBP.
+func (mr *ClientMockRecorder) GetSearchOBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSearchOBP", reflect.TypeOf((*Client)(nil).GetSearchOBP), ctx, opts)
+}
+
+// GetSection mocks base method.
+func (m *Client) GetSection(ctx context.Context, locale int, sectionID int64) (zendesk.Section, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSection", ctx, locale, sectionID)
+	ret0, _ := ret[0].(zendesk.Section)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSection indicates an expected call of GetSection.
+func (mr *ClientMockRecorder) GetSection(ctx, locale, sectionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSection", reflect.TypeOf((*Client)(nil).GetSection), ctx, locale, sectionID)
+}
+
+// GetSectionsByCategoryCBP mocks base method.
+func (m *Client) GetSectionsByCategoryCBP(ctx context.Context, locale int, opts *zendesk.CBPOptions) ([]zendesk.Section, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSectionsByCategoryCBP", ctx, locale, opts)
+	ret0, _ := ret[0].([]zendesk.Section)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSectionsByCategoryCBP indicates an expected call of GetSectionsByCategoryCBP.
+func (mr *ClientMockRecorder) GetSectionsByCategoryCBP(ctx, locale, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSectionsByCategoryCBP", reflect.TypeOf((*Client)(nil).GetSectionsByCategoryCBP), ctx, locale, opts)
+}
+
+// GetSectionsByCategoryIterator mocks base method.
+func (m *Client) GetSectionsByCategoryIterator(ctx context.Context, locale int, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.Section] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSectionsByCategoryIterator", ctx, locale, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.Section])
+	return ret0
+}
+
+// GetSectionsByCategoryIterator indicates an expected call of GetSectionsByCategoryIterator.
+func (mr *ClientMockRecorder) GetSectionsByCategoryIterator(ctx, locale, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSectionsByCategoryIterator", reflect.TypeOf((*Client)(nil).GetSectionsByCategoryIterator), ctx, locale, opts)
+}
+
+// GetSectionsByCategoryOBP mocks base method.
+func (m *Client) GetSectionsByCategoryOBP(ctx context.Context, locale int, opts *zendesk.OBPOptions) ([]zendesk.Section, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSectionsByCategoryOBP", ctx, locale, opts)
+	ret0, _ := ret[0].([]zendesk.Section)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSectionsByCategoryOBP indicates an expected call of GetSectionsByCategoryOBP.
+func (mr *ClientMockRecorder) GetSectionsByCategoryOBP(ctx, locale, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSLAPolicy", reflect.TypeOf((*Client)(nil).GetSLAPolicy), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSectionsByCategoryOBP", reflect.TypeOf((*Client)(nil).GetSectionsByCategoryOBP), ctx, locale, opts)
 }
 
-// GetSearchCBP mocks base method.
-func (m *Client) GetSearchCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.SearchResults, zendesk.CursorPaginationMeta, error) {
+// GetSectionsCBP mocks base method.
+func (m *Client) GetSectionsCBP(ctx context.Context, locale int, opts *zendesk.CBPOptions) ([]zendesk.Section, zendesk.CursorPaginationMeta, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSearchCBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.SearchResults)
+	ret := m.ctrl.Call(m, "GetSectionsCBP", ctx, locale, opts)
+	ret0, _ := ret[0].([]zendesk.Section)
 	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetSearchCBP indicates an expected call of GetSearchCBP.
-func (mr *ClientMockRecorder) GetSearchCBP(ctx, opts any) *gomock.Call {
+// GetSectionsCBP indicates an expected call of GetSectionsCBP.
+func (mr *ClientMockRecorder) GetSectionsCBP(ctx, locale, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSearchCBP", reflect.TypeOf((*Client)(nil).GetSearchCBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSectionsCBP", reflect.TypeOf((*Client)(nil).GetSectionsCBP), ctx, locale, opts)
 }
 
-// GetSearchIterator mocks base method.
-func (m *Client) GetSearchIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.SearchResults] {
+// GetSectionsIterator mocks base method.
+func (m *Client) GetSectionsIterator(ctx context.Context, locale int, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.Section] {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSearchIterator", ctx, opts)
-	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.SearchResults])
+	ret := m.ctrl.Call(m, "GetSectionsIterator", ctx, locale, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.Section])
 	return ret0
 }
 
-// GetSearchIterator indicates an expected call of GetSearchIterator.
-func (mr *ClientMockRecorder) GetSearchIterator(ctx, opts any) *gomock.Call {
+// GetSectionsIterator indicates an expected call of GetSectionsIterator.
+func (mr *ClientMockRecorder) GetSectionsIterator(ctx, locale, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSearchIterator", reflect.TypeOf((*Client)(nil).GetSearchIterator), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSectionsIterator", reflect.TypeOf((*Client)(nil).GetSectionsIterator), ctx, locale, opts)
 }
 
-// GetSearchOBP mocks base method.
-func (m *Client) GetSearchOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.SearchResults, zendesk.Page, error) {
+// GetSectionsOBP mocks base method.
+func (m *Client) GetSectionsOBP(ctx context.Context, locale int, opts *zendesk.OBPOptions) ([]zendesk.Section, zendesk.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSearchOBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.SearchResults)
+	ret := m.ctrl.Call(m, "GetSectionsOBP", ctx, locale, opts)
+	ret0, _ := ret[0].([]zendesk.Section)
 	ret1, _ := ret[1].(zendesk.Page)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetSearchOBP indicates an expected call of GetSearchOBP.
-func (mr *ClientMockRecorder) GetSearchOBP(ctx, opts any) *gomock.Call {
+// GetSectionsOBP indicates an expected call of GetSectionsOBP.
+func (mr *ClientMockRecorder) GetSectionsOBP(ctx, locale, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSearchOBP", reflect.TypeOf((*Client)(nil).GetSearchOBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSectionsOBP", reflect.TypeOf((*Client)(nil).GetSectionsOBP), ctx, locale, opts)
 }
 
 // GetTarget mocks base method.
@@ -1611,6 +4334,36 @@ func (mr *ClientMockRecorder) GetTarget(ctx, ticketID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTarget", reflect.TypeOf((*Client)(nil).GetTarget), ctx, ticketID)
 }
 
+// GetTargetFailure mocks base method.
+func (m *Client) GetTargetFailure(ctx context.Context, id int64) (zendesk.TargetFailure, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTargetFailure", ctx, id)
+	ret0, _ := ret[0].(zendesk.TargetFailure)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTargetFailure indicates an expected call of GetTargetFailure.
+func (mr *ClientMockRecorder) GetTargetFailure(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTargetFailure", reflect.TypeOf((*Client)(nil).GetTargetFailure), ctx, id)
+}
+
+// GetTargetFailures mocks base method.
+func (m *Client) GetTargetFailures(ctx context.Context) ([]zendesk.TargetFailure, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTargetFailures", ctx)
+	ret0, _ := ret[0].([]zendesk.TargetFailure)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTargetFailures indicates an expected call of GetTargetFailures.
+func (mr *ClientMockRecorder) GetTargetFailures(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTargetFailures", reflect.TypeOf((*Client)(nil).GetTargetFailures), ctx)
+}
+
 // GetTargets mocks base method.
 func (m *Client) GetTargets(ctx context.Context) ([]zendesk.Target, zendesk.Page, error) {
 	m.ctrl.T.Helper()
@@ -1642,6 +4395,21 @@ func (mr *ClientMockRecorder) GetTicket(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicket", reflect.TypeOf((*Client)(nil).GetTicket), ctx, id)
 }
 
+// GetTicketAttributeValues mocks base method.
+func (m *Client) GetTicketAttributeValues(ctx context.Context, ticketID int64) ([]zendesk.RoutingAttributeValue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketAttributeValues", ctx, ticketID)
+	ret0, _ := ret[0].([]zendesk.RoutingAttributeValue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTicketAttributeValues indicates an expected call of GetTicketAttributeValues.
+func (mr *ClientMockRecorder) GetTicketAttributeValues(ctx, ticketID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketAttributeValues", reflect.TypeOf((*Client)(nil).GetTicketAttributeValues), ctx, ticketID)
+}
+
 // GetTicketAudit mocks base method.
 func (m *Client) GetTicketAudit(ctx context.Context, TicketID, ID int64) (zendesk.TicketAudit, error) {
 	m.ctrl.T.Helper()
@@ -1780,6 +4548,67 @@ func (mr *ClientMockRecorder) GetTicketField(ctx, ticketID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketField", reflect.TypeOf((*Client)(nil).GetTicketField), ctx, ticketID)
 }
 
+// GetTicketFieldOption mocks base method.
+func (m *Client) GetTicketFieldOption(ctx context.Context, fieldID, optionID int64) (zendesk.CustomFieldOption, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketFieldOption", ctx, fieldID, optionID)
+	ret0, _ := ret[0].(zendesk.CustomFieldOption)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTicketFieldOption indicates an expected call of GetTicketFieldOption.
+func (mr *ClientMockRecorder) GetTicketFieldOption(ctx, fieldID, optionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketFieldOption", reflect.TypeOf((*Client)(nil).GetTicketFieldOption), ctx, fieldID, optionID)
+}
+
+// GetTicketFieldOptionsCBP mocks base method.
+func (m *Client) GetTicketFieldOptionsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.CustomFieldOption, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketFieldOptionsCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.CustomFieldOption)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTicketFieldOptionsCBP indicates an expected call of GetTicketFieldOptionsCBP.
+func (mr *ClientMockRecorder) GetTicketFieldOptionsCBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketFieldOptionsCBP", reflect.TypeOf((*Client)(nil).GetTicketFieldOptionsCBP), ctx, opts)
+}
+
+// GetTicketFieldOptionsIterator mocks base method.
+func (m *Client) GetTicketFieldOptionsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.CustomFieldOption] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketFieldOptionsIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.CustomFieldOption])
+	return ret0
+}
+
+// GetTicketFieldOptionsIterator indicates an expected call of GetTicketFieldOptionsIterator.
+func (mr *ClientMockRecorder) GetTicketFieldOptionsIterator(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketFieldOptionsIterator", reflect.TypeOf((*Client)(nil).GetTicketFieldOptionsIterator), ctx, opts)
+}
+
+// GetTicketFieldOptionsOBP mocks base method.
+func (m *Client) GetTicketFieldOptionsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.CustomFieldOption, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketFieldOptionsOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.CustomFieldOption)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTicketFieldOptionsOBP indicates an expected call of GetTicketFieldOptionsOBP.
+func (mr *ClientMockRecorder) GetTicketFieldOptionsOBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketFieldOptionsOBP", reflect.TypeOf((*Client)(nil).GetTicketFieldOptionsOBP), ctx, opts)
+}
+
 // GetTicketFields mocks base method.
 func (m *Client) GetTicketFields(ctx context.Context) ([]zendesk.TicketField, zendesk.Page, error) {
 	m.ctrl.T.Helper()
@@ -2058,6 +4887,36 @@ func (mr *ClientMockRecorder) GetTicketsOBP(ctx, opts any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketsOBP", reflect.TypeOf((*Client)(nil).GetTicketsOBP), ctx, opts)
 }
 
+// GetTranslation mocks base method.
+func (m *Client) GetTranslation(ctx context.Context, source zendesk.TranslationSource, sourceID int64, locale int) (zendesk.Translation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTranslation", ctx, source, sourceID, locale)
+	ret0, _ := ret[0].(zendesk.Translation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTranslation indicates an expected call of GetTranslation.
+func (mr *ClientMockRecorder) GetTranslation(ctx, source, sourceID, locale any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTranslation", reflect.TypeOf((*Client)(nil).GetTranslation), ctx, source, sourceID, locale)
+}
+
+// GetTranslations mocks base method.
+func (m *Client) GetTranslations(ctx context.Context, source zendesk.TranslationSource, sourceID int64) ([]zendesk.Translation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTranslations", ctx, source, sourceID)
+	ret0, _ := ret[0].([]zendesk.Translation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTranslations indicates an expected call of GetTranslations.
+func (mr *ClientMockRecorder) GetTranslations(ctx, source, sourceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTranslations", reflect.TypeOf((*Client)(nil).GetTranslations), ctx, source, sourceID)
+}
+
 // GetTrigger mocks base method.
 func (m *Client) GetTrigger(ctx context.Context, id int64) (zendesk.Trigger, error) {
 	m.ctrl.T.Helper()
@@ -2073,6 +4932,82 @@ func (mr *ClientMockRecorder) GetTrigger(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrigger", reflect.TypeOf((*Client)(nil).GetTrigger), ctx, id)
 }
 
+// GetTriggerCategories mocks base method.
+func (m *Client) GetTriggerCategories(ctx context.Context, opts *zendesk.TriggerCategoryListOptions) ([]zendesk.TriggerCategory, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTriggerCategories", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.TriggerCategory)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTriggerCategories indicates an expected call of GetTriggerCategories.
+func (mr *ClientMockRecorder) GetTriggerCategories(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTriggerCategories", reflect.TypeOf((*Client)(nil).GetTriggerCategories), ctx, opts)
+}
+
+// GetTriggerCategory mocks base method.
+func (m *Client) GetTriggerCategory(ctx context.Context, id string) (zendesk.TriggerCategory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTriggerCategory", ctx, id)
+	ret0, _ := ret[0].(zendesk.TriggerCategory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTriggerCategory indicates an expected call of GetTriggerCategory.
+func (mr *ClientMockRecorder) GetTriggerCategory(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTriggerCategory", reflect.TypeOf((*Client)(nil).GetTriggerCategory), ctx, id)
+}
+
+// GetTriggerRevision mocks base method.
+func (m *Client) GetTriggerRevision(ctx context.Context, triggerID, revisionID int64) (zendesk.TriggerRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTriggerRevision", ctx, triggerID, revisionID)
+	ret0, _ := ret[0].(zendesk.TriggerRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTriggerRevision indicates an expected call of GetTriggerRevision.
+func (mr *ClientMockRecorder) GetTriggerRevision(ctx, triggerID, revisionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTriggerRevision", reflect.TypeOf((*Client)(nil).GetTriggerRevision), ctx, triggerID, revisionID)
+}
+
+// GetTriggerRevisions mocks base method.
+func (m *Client) GetTriggerRevisions(ctx context.Context, triggerID int64, opts *zendesk.CBPOptions) ([]zendesk.TriggerRevision, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTriggerRevisions", ctx, triggerID, opts)
+	ret0, _ := ret[0].([]zendesk.TriggerRevision)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTriggerRevisions indicates an expected call of GetTriggerRevisions.
+func (mr *ClientMockRecorder) GetTriggerRevisions(ctx, triggerID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTriggerRevisions", reflect.TypeOf((*Client)(nil).GetTriggerRevisions), ctx, triggerID, opts)
+}
+
+// GetTriggerRevisionsIterator mocks base method.
+func (m *Client) GetTriggerRevisionsIterator(ctx context.Context, triggerID int64, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.TriggerRevision] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTriggerRevisionsIterator", ctx, triggerID, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.TriggerRevision])
+	return ret0
+}
+
+// GetTriggerRevisionsIterator indicates an expected call of GetTriggerRevisionsIterator.
+func (mr *ClientMockRecorder) GetTriggerRevisionsIterator(ctx, triggerID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTriggerRevisionsIterator", reflect.TypeOf((*Client)(nil).GetTriggerRevisionsIterator), ctx, triggerID, opts)
+}
+
 // GetTriggers mocks base method.
 func (m *Client) GetTriggers(ctx context.Context, opts *zendesk.TriggerListOptions) ([]zendesk.Trigger, zendesk.Page, error) {
 	m.ctrl.T.Helper()
@@ -2150,6 +5085,67 @@ func (mr *ClientMockRecorder) GetUser(ctx, userID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*Client)(nil).GetUser), ctx, userID)
 }
 
+// GetUserFieldOption mocks base method.
+func (m *Client) GetUserFieldOption(ctx context.Context, fieldID, optionID int64) (zendesk.CustomFieldOption, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserFieldOption", ctx, fieldID, optionID)
+	ret0, _ := ret[0].(zendesk.CustomFieldOption)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserFieldOption indicates an expected call of GetUserFieldOption.
+func (mr *ClientMockRecorder) GetUserFieldOption(ctx, fieldID, optionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserFieldOption", reflect.TypeOf((*Client)(nil).GetUserFieldOption), ctx, fieldID, optionID)
+}
+
+// GetUserFieldOptionsCBP mocks base method.
+func (m *Client) GetUserFieldOptionsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.CustomFieldOption, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserFieldOptionsCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.CustomFieldOption)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetUserFieldOptionsCBP indicates an expected call of GetUserFieldOptionsCBP.
+func (mr *ClientMockRecorder) GetUserFieldOptionsCBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserFieldOptionsCBP", reflect.TypeOf((*Client)(nil).GetUserFieldOptionsCBP), ctx, opts)
+}
+
+// GetUserFieldOptionsIterator mocks base method.
+func (m *Client) GetUserFieldOptionsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.CustomFieldOption] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserFieldOptionsIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.CustomFieldOption])
+	return ret0
+}
+
+// GetUserFieldOptionsIterator indicates an expected call of GetUserFieldOptionsIterator.
+func (mr *ClientMockRecorder) GetUserFieldOptionsIterator(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserFieldOptionsIterator", reflect.TypeOf((*Client)(nil).GetUserFieldOptionsIterator), ctx, opts)
+}
+
+// GetUserFieldOptionsOBP mocks base method.
+func (m *Client) GetUserFieldOptionsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.CustomFieldOption, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserFieldOptionsOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.CustomFieldOption)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetUserFieldOptionsOBP indicates an expected call of GetUserFieldOptionsOBP.
+func (mr *ClientMockRecorder) GetUserFieldOptionsOBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserFieldOptionsOBP", reflect.TypeOf((*Client)(nil).GetUserFieldOptionsOBP), ctx, opts)
+}
+
 // GetUserFields mocks base method.
 func (m *Client) GetUserFields(ctx context.Context, opts *zendesk.UserFieldListOptions) ([]zendesk.UserField, zendesk.Page, error) {
 	m.ctrl.T.Helper()
@@ -2160,56 +5156,194 @@ func (m *Client) GetUserFields(ctx context.Context, opts *zendesk.UserFieldListO
 	return ret0, ret1, ret2
 }
 
-// GetUserFields indicates an expected call of GetUserFields.
-func (mr *ClientMockRecorder) GetUserFields(ctx, opts any) *gomock.Call {
+// GetUserFields indicates an expected call of GetUserFields.
+func (mr *ClientMockRecorder) GetUserFields(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserFields", reflect.TypeOf((*Client)(nil).GetUserFields), ctx, opts)
+}
+
+// GetUserFieldsCBP mocks base method.
+func (m *Client) GetUserFieldsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.UserField, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserFieldsCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.UserField)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetUserFieldsCBP indicates an expected call of GetUserFieldsCBP.
+func (mr *ClientMockRecorder) GetUserFieldsCBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserFieldsCBP", reflect.TypeOf((*Client)(nil).GetUserFieldsCBP), ctx, opts)
+}
+
+// GetUserFieldsIterator mocks base method.
+func (m *Client) GetUserFieldsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.UserField] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserFieldsIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.UserField])
+	return ret0
+}
+
+// GetUserFieldsIterator indicates an expected call of GetUserFieldsIterator.
+func (mr *ClientMockRecorder) GetUserFieldsIterator(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserFieldsIterator", reflect.TypeOf((*Client)(nil).GetUserFieldsIterator), ctx, opts)
+}
+
+// GetUserFieldsOBP mocks base method.
+func (m *Client) GetUserFieldsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.UserField, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserFieldsOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.UserField)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetUserFieldsOBP indicates an expected call of GetUserFieldsOBP.
+func (mr *ClientMockRecorder) GetUserFieldsOBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserFieldsOBP", reflect.TypeOf((*Client)(nil).GetUserFieldsOBP), ctx, opts)
+}
+
+// GetUserGroupsCBP mocks base method.
+func (m *Client) GetUserGroupsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.Group, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserGroupsCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Group)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetUserGroupsCBP indicates an expected call of GetUserGroupsCBP.
+func (mr *ClientMockRecorder) GetUserGroupsCBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserGroupsCBP", reflect.TypeOf((*Client)(nil).GetUserGroupsCBP), ctx, opts)
+}
+
+// GetUserGroupsIterator mocks base method.
+func (m *Client) GetUserGroupsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.Group] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserGroupsIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.Group])
+	return ret0
+}
+
+// GetUserGroupsIterator indicates an expected call of GetUserGroupsIterator.
+func (mr *ClientMockRecorder) GetUserGroupsIterator(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserGroupsIterator", reflect.TypeOf((*Client)(nil).GetUserGroupsIterator), ctx, opts)
+}
+
+// GetUserGroupsOBP mocks base method.
+func (m *Client) GetUserGroupsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.Group, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserGroupsOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Group)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetUserGroupsOBP indicates an expected call of GetUserGroupsOBP.
+func (mr *ClientMockRecorder) GetUserGroupsOBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserGroupsOBP", reflect.TypeOf((*Client)(nil).GetUserGroupsOBP), ctx, opts)
+}
+
+// GetUserIdentities mocks base method.
+func (m *Client) GetUserIdentities(ctx context.Context, userID int64, opts *zendesk.UserIdentityListOptions) ([]zendesk.UserIdentity, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserIdentities", ctx, userID, opts)
+	ret0, _ := ret[0].([]zendesk.UserIdentity)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetUserIdentities indicates an expected call of GetUserIdentities.
+func (mr *ClientMockRecorder) GetUserIdentities(ctx, userID, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserFields", reflect.TypeOf((*Client)(nil).GetUserFields), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserIdentities", reflect.TypeOf((*Client)(nil).GetUserIdentities), ctx, userID, opts)
 }
 
-// GetUserFieldsCBP mocks base method.
-func (m *Client) GetUserFieldsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.UserField, zendesk.CursorPaginationMeta, error) {
+// GetUserIdentitiesCBP mocks base method.
+func (m *Client) GetUserIdentitiesCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.UserIdentity, zendesk.CursorPaginationMeta, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserFieldsCBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.UserField)
+	ret := m.ctrl.Call(m, "GetUserIdentitiesCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.UserIdentity)
 	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetUserFieldsCBP indicates an expected call of GetUserFieldsCBP.
-func (mr *ClientMockRecorder) GetUserFieldsCBP(ctx, opts any) *gomock.Call {
+// GetUserIdentitiesCBP indicates an expected call of GetUserIdentitiesCBP.
+func (mr *ClientMockRecorder) GetUserIdentitiesCBP(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserFieldsCBP", reflect.TypeOf((*Client)(nil).GetUserFieldsCBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserIdentitiesCBP", reflect.TypeOf((*Client)(nil).GetUserIdentitiesCBP), ctx, opts)
 }
 
-// GetUserFieldsIterator mocks base method.
-func (m *Client) GetUserFieldsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.UserField] {
+// GetUserIdentitiesIterator mocks base method.
+func (m *Client) GetUserIdentitiesIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.UserIdentity] {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserFieldsIterator", ctx, opts)
-	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.UserField])
+	ret := m.ctrl.Call(m, "GetUserIdentitiesIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.UserIdentity])
 	return ret0
 }
 
-// GetUserFieldsIterator indicates an expected call of GetUserFieldsIterator.
-func (mr *ClientMockRecorder) GetUserFieldsIterator(ctx, opts any) *gomock.Call {
+// GetUserIdentitiesIterator indicates an expected call of GetUserIdentitiesIterator.
+func (mr *ClientMockRecorder) GetUserIdentitiesIterator(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserFieldsIterator", reflect.TypeOf((*Client)(nil).GetUserFieldsIterator), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserIdentitiesIterator", reflect.TypeOf((*Client)(nil).GetUserIdentitiesIterator), ctx, opts)
 }
 
-// GetUserFieldsOBP mocks base method.
-func (m *Client) GetUserFieldsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.UserField, zendesk.Page, error) {
+// GetUserIdentitiesOBP mocks base method.
+func (m *Client) GetUserIdentitiesOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.UserIdentity, zendesk.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserFieldsOBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.UserField)
+	ret := m.ctrl.Call(m, "GetUserIdentitiesOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.UserIdentity)
 	ret1, _ := ret[1].(zendesk.Page)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetUserFieldsOBP indicates an expected call of GetUserFieldsOBP.
-func (mr *ClientMockRecorder) GetUserFieldsOBP(ctx, opts any) *gomock.Call {
+// GetUserIdentitiesOBP indicates an expected call of GetUserIdentitiesOBP.
+func (mr *ClientMockRecorder) GetUserIdentitiesOBP(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserFieldsOBP", reflect.TypeOf((*Client)(nil).GetUserFieldsOBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserIdentitiesOBP", reflect.TypeOf((*Client)(nil).GetUserIdentitiesOBP), ctx, opts)
+}
+
+// GetUserIdentity mocks base method.
+func (m *Client) GetUserIdentity(ctx context.Context, userID, identityID int64) (zendesk.UserIdentity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserIdentity", ctx, userID, identityID)
+	ret0, _ := ret[0].(zendesk.UserIdentity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserIdentity indicates an expected call of GetUserIdentity.
+func (mr *ClientMockRecorder) GetUserIdentity(ctx, userID, identityID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserIdentity", reflect.TypeOf((*Client)(nil).GetUserIdentity), ctx, userID, identityID)
+}
+
+// GetUserPasswordRequirements mocks base method.
+func (m *Client) GetUserPasswordRequirements(ctx context.Context, userID int64) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserPasswordRequirements", ctx, userID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserPasswordRequirements indicates an expected call of GetUserPasswordRequirements.
+func (mr *ClientMockRecorder) GetUserPasswordRequirements(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserPasswordRequirements", reflect.TypeOf((*Client)(nil).GetUserPasswordRequirements), ctx, userID)
 }
 
 // GetUserRelated mocks base method.
@@ -2396,6 +5530,37 @@ func (mr *ClientMockRecorder) GetWebhook(ctx, webhookID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhook", reflect.TypeOf((*Client)(nil).GetWebhook), ctx, webhookID)
 }
 
+// GetWebhookInvocationAttempts mocks base method.
+func (m *Client) GetWebhookInvocationAttempts(ctx context.Context, webhookID, invocationID string) ([]zendesk.WebhookInvocationAttempt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhookInvocationAttempts", ctx, webhookID, invocationID)
+	ret0, _ := ret[0].([]zendesk.WebhookInvocationAttempt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhookInvocationAttempts indicates an expected call of GetWebhookInvocationAttempts.
+func (mr *ClientMockRecorder) GetWebhookInvocationAttempts(ctx, webhookID, invocationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookInvocationAttempts", reflect.TypeOf((*Client)(nil).GetWebhookInvocationAttempts), ctx, webhookID, invocationID)
+}
+
+// GetWebhookInvocations mocks base method.
+func (m *Client) GetWebhookInvocations(ctx context.Context, webhookID string, opts *zendesk.WebhookInvocationListOptions) ([]zendesk.WebhookInvocation, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhookInvocations", ctx, webhookID, opts)
+	ret0, _ := ret[0].([]zendesk.WebhookInvocation)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetWebhookInvocations indicates an expected call of GetWebhookInvocations.
+func (mr *ClientMockRecorder) GetWebhookInvocations(ctx, webhookID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookInvocations", reflect.TypeOf((*Client)(nil).GetWebhookInvocations), ctx, webhookID, opts)
+}
+
 // GetWebhookSigningSecret mocks base method.
 func (m *Client) GetWebhookSigningSecret(ctx context.Context, webhookID string) (*zendesk.WebhookSigningSecret, error) {
 	m.ctrl.T.Helper()
@@ -2411,6 +5576,22 @@ func (mr *ClientMockRecorder) GetWebhookSigningSecret(ctx, webhookID any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookSigningSecret", reflect.TypeOf((*Client)(nil).GetWebhookSigningSecret), ctx, webhookID)
 }
 
+// GetWebhooks mocks base method.
+func (m *Client) GetWebhooks(ctx context.Context, opts *zendesk.WebhookListOptions) ([]zendesk.Webhook, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhooks", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Webhook)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetWebhooks indicates an expected call of GetWebhooks.
+func (mr *ClientMockRecorder) GetWebhooks(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhooks", reflect.TypeOf((*Client)(nil).GetWebhooks), ctx, opts)
+}
+
 // ListCustomObjectRecords mocks base method.
 func (m *Client) ListCustomObjectRecords(ctx context.Context, customObjectKey string, opts *zendesk.CustomObjectListOptions) ([]zendesk.CustomObjectRecord, zendesk.Page, error) {
 	m.ctrl.T.Helper()
@@ -2471,6 +5652,95 @@ func (mr *ClientMockRecorder) MakeCommentPrivate(ctx, ticketID, ticketCommentID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MakeCommentPrivate", reflect.TypeOf((*Client)(nil).MakeCommentPrivate), ctx, ticketID, ticketCommentID)
 }
 
+// MakeUserIdentityPrimary mocks base method.
+func (m *Client) MakeUserIdentityPrimary(ctx context.Context, userID, identityID int64) ([]zendesk.UserIdentity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MakeUserIdentityPrimary", ctx, userID, identityID)
+	ret0, _ := ret[0].([]zendesk.UserIdentity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MakeUserIdentityPrimary indicates an expected call of MakeUserIdentityPrimary.
+func (mr *ClientMockRecorder) MakeUserIdentityPrimary(ctx, userID, identityID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MakeUserIdentityPrimary", reflect.TypeOf((*Client)(nil).MakeUserIdentityPrimary), ctx, userID, identityID)
+}
+
+// MergeOrganization mocks base method.
+func (m *Client) MergeOrganization(ctx context.Context, loserID, winnerID int64) (zendesk.OrganizationMerge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeOrganization", ctx, loserID, winnerID)
+	ret0, _ := ret[0].(zendesk.OrganizationMerge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeOrganization indicates an expected call of MergeOrganization.
+func (mr *ClientMockRecorder) MergeOrganization(ctx, loserID, winnerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeOrganization", reflect.TypeOf((*Client)(nil).MergeOrganization), ctx, loserID, winnerID)
+}
+
+// MergeUser mocks base method.
+func (m *Client) MergeUser(ctx context.Context, userID, targetUserID int64) (zendesk.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeUser", ctx, userID, targetUserID)
+	ret0, _ := ret[0].(zendesk.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeUser indicates an expected call of MergeUser.
+func (mr *ClientMockRecorder) MergeUser(ctx, userID, targetUserID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeUser", reflect.TypeOf((*Client)(nil).MergeUser), ctx, userID, targetUserID)
+}
+
+// MigrateTarget mocks base method.
+func (m *Client) MigrateTarget(ctx context.Context, targetID int64, opts zendesk.TargetMigrationOptions) (*zendesk.TargetMigration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateTarget", ctx, targetID, opts)
+	ret0, _ := ret[0].(*zendesk.TargetMigration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateTarget indicates an expected call of MigrateTarget.
+func (mr *ClientMockRecorder) MigrateTarget(ctx, targetID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateTarget", reflect.TypeOf((*Client)(nil).MigrateTarget), ctx, targetID, opts)
+}
+
+// PatchWebhook mocks base method.
+func (m *Client) PatchWebhook(ctx context.Context, webhookID string, patch *zendesk.WebhookPatch) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchWebhook", ctx, webhookID, patch)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PatchWebhook indicates an expected call of PatchWebhook.
+func (mr *ClientMockRecorder) PatchWebhook(ctx, webhookID, patch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchWebhook", reflect.TypeOf((*Client)(nil).PatchWebhook), ctx, webhookID, patch)
+}
+
+// PermanentlyDeleteUser mocks base method.
+func (m *Client) PermanentlyDeleteUser(ctx context.Context, userID int64) (zendesk.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PermanentlyDeleteUser", ctx, userID)
+	ret0, _ := ret[0].(zendesk.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PermanentlyDeleteUser indicates an expected call of PermanentlyDeleteUser.
+func (mr *ClientMockRecorder) PermanentlyDeleteUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PermanentlyDeleteUser", reflect.TypeOf((*Client)(nil).PermanentlyDeleteUser), ctx, userID)
+}
+
 // Post mocks base method.
 func (m *Client) Post(ctx context.Context, path string, data any) ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -2486,6 +5756,21 @@ func (mr *ClientMockRecorder) Post(ctx, path, data any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Post", reflect.TypeOf((*Client)(nil).Post), ctx, path, data)
 }
 
+// PreviewView mocks base method.
+func (m *Client) PreviewView(ctx context.Context, view zendesk.View, opts *zendesk.PageOptions) (zendesk.ViewRows, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewView", ctx, view, opts)
+	ret0, _ := ret[0].(zendesk.ViewRows)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviewView indicates an expected call of PreviewView.
+func (mr *ClientMockRecorder) PreviewView(ctx, view, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewView", reflect.TypeOf((*Client)(nil).PreviewView), ctx, view, opts)
+}
+
 // Put mocks base method.
 func (m *Client) Put(ctx context.Context, path string, data any) ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -2501,6 +5786,107 @@ func (mr *ClientMockRecorder) Put(ctx, path, data any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*Client)(nil).Put), ctx, path, data)
 }
 
+// ReorderCustomObjectFields mocks base method.
+func (m *Client) ReorderCustomObjectFields(ctx context.Context, customObjectKey string, fieldIDs []int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderCustomObjectFields", ctx, customObjectKey, fieldIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReorderCustomObjectFields indicates an expected call of ReorderCustomObjectFields.
+func (mr *ClientMockRecorder) ReorderCustomObjectFields(ctx, customObjectKey, fieldIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderCustomObjectFields", reflect.TypeOf((*Client)(nil).ReorderCustomObjectFields), ctx, customObjectKey, fieldIDs)
+}
+
+// ReorderOrganizationFields mocks base method.
+func (m *Client) ReorderOrganizationFields(ctx context.Context, fieldIDs []int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderOrganizationFields", ctx, fieldIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReorderOrganizationFields indicates an expected call of ReorderOrganizationFields.
+func (mr *ClientMockRecorder) ReorderOrganizationFields(ctx, fieldIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderOrganizationFields", reflect.TypeOf((*Client)(nil).ReorderOrganizationFields), ctx, fieldIDs)
+}
+
+// ReorderTicketForms mocks base method.
+func (m *Client) ReorderTicketForms(ctx context.Context, ids []int64) ([]zendesk.TicketForm, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderTicketForms", ctx, ids)
+	ret0, _ := ret[0].([]zendesk.TicketForm)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReorderTicketForms indicates an expected call of ReorderTicketForms.
+func (mr *ClientMockRecorder) ReorderTicketForms(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderTicketForms", reflect.TypeOf((*Client)(nil).ReorderTicketForms), ctx, ids)
+}
+
+// ReorderTriggers mocks base method.
+func (m *Client) ReorderTriggers(ctx context.Context, triggerIDs []int64) ([]zendesk.Trigger, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderTriggers", ctx, triggerIDs)
+	ret0, _ := ret[0].([]zendesk.Trigger)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReorderTriggers indicates an expected call of ReorderTriggers.
+func (mr *ClientMockRecorder) ReorderTriggers(ctx, triggerIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderTriggers", reflect.TypeOf((*Client)(nil).ReorderTriggers), ctx, triggerIDs)
+}
+
+// ReorderUserFields mocks base method.
+func (m *Client) ReorderUserFields(ctx context.Context, fieldIDs []int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderUserFields", ctx, fieldIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReorderUserFields indicates an expected call of ReorderUserFields.
+func (mr *ClientMockRecorder) ReorderUserFields(ctx, fieldIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderUserFields", reflect.TypeOf((*Client)(nil).ReorderUserFields), ctx, fieldIDs)
+}
+
+// RequestUserIdentityVerification mocks base method.
+func (m *Client) RequestUserIdentityVerification(ctx context.Context, userID, identityID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestUserIdentityVerification", ctx, userID, identityID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequestUserIdentityVerification indicates an expected call of RequestUserIdentityVerification.
+func (mr *ClientMockRecorder) RequestUserIdentityVerification(ctx, userID, identityID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestUserIdentityVerification", reflect.TypeOf((*Client)(nil).RequestUserIdentityVerification), ctx, userID, identityID)
+}
+
+// ResetWebhookSigningSecret mocks base method.
+func (m *Client) ResetWebhookSigningSecret(ctx context.Context, webhookID string) (*zendesk.WebhookSigningSecret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetWebhookSigningSecret", ctx, webhookID)
+	ret0, _ := ret[0].(*zendesk.WebhookSigningSecret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetWebhookSigningSecret indicates an expected call of ResetWebhookSigningSecret.
+func (mr *ClientMockRecorder) ResetWebhookSigningSecret(ctx, webhookID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetWebhookSigningSecret", reflect.TypeOf((*Client)(nil).ResetWebhookSigningSecret), ctx, webhookID)
+}
+
 // Search mocks base method.
 func (m *Client) Search(ctx context.Context, opts *zendesk.SearchOptions) (zendesk.SearchResults, zendesk.Page, error) {
 	m.ctrl.T.Helper()
@@ -2517,6 +5903,22 @@ func (mr *ClientMockRecorder) Search(ctx, opts any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*Client)(nil).Search), ctx, opts)
 }
 
+// SearchArticles mocks base method.
+func (m *Client) SearchArticles(ctx context.Context, opts *zendesk.ArticleSearchOptions) ([]zendesk.ArticleSearchResult, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchArticles", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.ArticleSearchResult)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SearchArticles indicates an expected call of SearchArticles.
+func (mr *ClientMockRecorder) SearchArticles(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchArticles", reflect.TypeOf((*Client)(nil).SearchArticles), ctx, opts)
+}
+
 // SearchCount mocks base method.
 func (m *Client) SearchCount(ctx context.Context, opts *zendesk.CountOptions) (int, error) {
 	m.ctrl.T.Helper()
@@ -2542,26 +5944,119 @@ func (m *Client) SearchCustomObjectRecords(ctx context.Context, customObjectKey
 	return ret0, ret1, ret2
 }
 
-// SearchCustomObjectRecords indicates an expected call of SearchCustomObjectRecords.
-func (mr *ClientMockRecorder) SearchCustomObjectRecords(ctx, customObjectKey, opts any) *gomock.Call {
+// SearchCustomObjectRecords indicates an expected call of SearchCustomObjectRecords.
+func (mr *ClientMockRecorder) SearchCustomObjectRecords(ctx, customObjectKey, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchCustomObjectRecords", reflect.TypeOf((*Client)(nil).SearchCustomObjectRecords), ctx, customObjectKey, opts)
+}
+
+// SearchMacros mocks base method.
+func (m *Client) SearchMacros(ctx context.Context, opts *zendesk.MacroSearchOptions) ([]zendesk.Macro, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchMacros", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Macro)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SearchMacros indicates an expected call of SearchMacros.
+func (mr *ClientMockRecorder) SearchMacros(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchMacros", reflect.TypeOf((*Client)(nil).SearchMacros), ctx, opts)
+}
+
+// SearchOrganizationsByName mocks base method.
+func (m *Client) SearchOrganizationsByName(ctx context.Context, name string) ([]zendesk.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchOrganizationsByName", ctx, name)
+	ret0, _ := ret[0].([]zendesk.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchOrganizationsByName indicates an expected call of SearchOrganizationsByName.
+func (mr *ClientMockRecorder) SearchOrganizationsByName(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchOrganizationsByName", reflect.TypeOf((*Client)(nil).SearchOrganizationsByName), ctx, name)
+}
+
+// SearchTriggers mocks base method.
+func (m *Client) SearchTriggers(ctx context.Context, opts *zendesk.TriggerSearchOptions) ([]zendesk.Trigger, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchTriggers", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Trigger)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SearchTriggers indicates an expected call of SearchTriggers.
+func (mr *ClientMockRecorder) SearchTriggers(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchTriggers", reflect.TypeOf((*Client)(nil).SearchTriggers), ctx, opts)
+}
+
+// SearchUsers mocks base method.
+func (m *Client) SearchUsers(ctx context.Context, opts *zendesk.SearchUsersOptions) ([]zendesk.User, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchUsers", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.User)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SearchUsers indicates an expected call of SearchUsers.
+func (mr *ClientMockRecorder) SearchUsers(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchUsers", reflect.TypeOf((*Client)(nil).SearchUsers), ctx, opts)
+}
+
+// SearchViews mocks base method.
+func (m *Client) SearchViews(ctx context.Context, opts *zendesk.ViewSearchOptions) ([]zendesk.View, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchViews", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.View)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SearchViews indicates an expected call of SearchViews.
+func (mr *ClientMockRecorder) SearchViews(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchViews", reflect.TypeOf((*Client)(nil).SearchViews), ctx, opts)
+}
+
+// SetAgentAttributeValues mocks base method.
+func (m *Client) SetAgentAttributeValues(ctx context.Context, userID int64, valueIDs []string) ([]zendesk.RoutingAttributeValue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAgentAttributeValues", ctx, userID, valueIDs)
+	ret0, _ := ret[0].([]zendesk.RoutingAttributeValue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetAgentAttributeValues indicates an expected call of SetAgentAttributeValues.
+func (mr *ClientMockRecorder) SetAgentAttributeValues(ctx, userID, valueIDs any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchCustomObjectRecords", reflect.TypeOf((*Client)(nil).SearchCustomObjectRecords), ctx, customObjectKey, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAgentAttributeValues", reflect.TypeOf((*Client)(nil).SetAgentAttributeValues), ctx, userID, valueIDs)
 }
 
-// SearchUsers mocks base method.
-func (m *Client) SearchUsers(ctx context.Context, opts *zendesk.SearchUsersOptions) ([]zendesk.User, zendesk.Page, error) {
+// SetDefaultGroupMembership mocks base method.
+func (m *Client) SetDefaultGroupMembership(ctx context.Context, userID, membershipID int64) ([]zendesk.GroupMembership, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchUsers", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.User)
-	ret1, _ := ret[1].(zendesk.Page)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "SetDefaultGroupMembership", ctx, userID, membershipID)
+	ret0, _ := ret[0].([]zendesk.GroupMembership)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// SearchUsers indicates an expected call of SearchUsers.
-func (mr *ClientMockRecorder) SearchUsers(ctx, opts any) *gomock.Call {
+// SetDefaultGroupMembership indicates an expected call of SetDefaultGroupMembership.
+func (mr *ClientMockRecorder) SetDefaultGroupMembership(ctx, userID, membershipID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchUsers", reflect.TypeOf((*Client)(nil).SearchUsers), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDefaultGroupMembership", reflect.TypeOf((*Client)(nil).SetDefaultGroupMembership), ctx, userID, membershipID)
 }
 
 // SetDefaultOrganization mocks base method.
@@ -2579,6 +6074,35 @@ func (mr *ClientMockRecorder) SetDefaultOrganization(arg0, arg1 any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDefaultOrganization", reflect.TypeOf((*Client)(nil).SetDefaultOrganization), arg0, arg1)
 }
 
+// SetTicketAttributeValues mocks base method.
+func (m *Client) SetTicketAttributeValues(ctx context.Context, ticketID int64, valueIDs []string) ([]zendesk.RoutingAttributeValue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTicketAttributeValues", ctx, ticketID, valueIDs)
+	ret0, _ := ret[0].([]zendesk.RoutingAttributeValue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetTicketAttributeValues indicates an expected call of SetTicketAttributeValues.
+func (mr *ClientMockRecorder) SetTicketAttributeValues(ctx, ticketID, valueIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTicketAttributeValues", reflect.TypeOf((*Client)(nil).SetTicketAttributeValues), ctx, ticketID, valueIDs)
+}
+
+// SetUserPassword mocks base method.
+func (m *Client) SetUserPassword(ctx context.Context, userID int64, password string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUserPassword", ctx, userID, password)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetUserPassword indicates an expected call of SetUserPassword.
+func (mr *ClientMockRecorder) SetUserPassword(ctx, userID, password any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserPassword", reflect.TypeOf((*Client)(nil).SetUserPassword), ctx, userID, password)
+}
+
 // ShowCustomObjectRecord mocks base method.
 func (m *Client) ShowCustomObjectRecord(ctx context.Context, customObjectKey, customObjectRecordID string) (*zendesk.CustomObjectRecord, error) {
 	m.ctrl.T.Helper()
@@ -2594,6 +6118,36 @@ func (mr *ClientMockRecorder) ShowCustomObjectRecord(ctx, customObjectKey, custo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowCustomObjectRecord", reflect.TypeOf((*Client)(nil).ShowCustomObjectRecord), ctx, customObjectKey, customObjectRecordID)
 }
 
+// TestWebhook mocks base method.
+func (m *Client) TestWebhook(ctx context.Context, webhookID string, req *zendesk.WebhookTestRequest) (*zendesk.WebhookTestResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TestWebhook", ctx, webhookID, req)
+	ret0, _ := ret[0].(*zendesk.WebhookTestResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TestWebhook indicates an expected call of TestWebhook.
+func (mr *ClientMockRecorder) TestWebhook(ctx, webhookID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TestWebhook", reflect.TypeOf((*Client)(nil).TestWebhook), ctx, webhookID, req)
+}
+
+// UpdateArticle mocks base method.
+func (m *Client) UpdateArticle(ctx context.Context, locale int, articleID int64, article zendesk.Article) (zendesk.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateArticle", ctx, locale, articleID, article)
+	ret0, _ := ret[0].(zendesk.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateArticle indicates an expected call of UpdateArticle.
+func (mr *ClientMockRecorder) UpdateArticle(ctx, locale, articleID, article any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateArticle", reflect.TypeOf((*Client)(nil).UpdateArticle), ctx, locale, articleID, article)
+}
+
 // UpdateAutomation mocks base method.
 func (m *Client) UpdateAutomation(ctx context.Context, id int64, automation zendesk.Automation) (zendesk.Automation, error) {
 	m.ctrl.T.Helper()
@@ -2624,6 +6178,66 @@ func (mr *ClientMockRecorder) UpdateBrand(ctx, brandID, brand any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBrand", reflect.TypeOf((*Client)(nil).UpdateBrand), ctx, brandID, brand)
 }
 
+// UpdateCapacityRule mocks base method.
+func (m *Client) UpdateCapacityRule(ctx context.Context, ruleID string, rule zendesk.CapacityRule) (zendesk.CapacityRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCapacityRule", ctx, ruleID, rule)
+	ret0, _ := ret[0].(zendesk.CapacityRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCapacityRule indicates an expected call of UpdateCapacityRule.
+func (mr *ClientMockRecorder) UpdateCapacityRule(ctx, ruleID, rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCapacityRule", reflect.TypeOf((*Client)(nil).UpdateCapacityRule), ctx, ruleID, rule)
+}
+
+// UpdateCategory mocks base method.
+func (m *Client) UpdateCategory(ctx context.Context, locale int, categoryID int64, category zendesk.Category) (zendesk.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCategory", ctx, locale, categoryID, category)
+	ret0, _ := ret[0].(zendesk.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCategory indicates an expected call of UpdateCategory.
+func (mr *ClientMockRecorder) UpdateCategory(ctx, locale, categoryID, category any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCategory", reflect.TypeOf((*Client)(nil).UpdateCategory), ctx, locale, categoryID, category)
+}
+
+// UpdateCustomObject mocks base method.
+func (m *Client) UpdateCustomObject(ctx context.Context, customObjectKey string, customObject zendesk.CustomObject) (zendesk.CustomObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCustomObject", ctx, customObjectKey, customObject)
+	ret0, _ := ret[0].(zendesk.CustomObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCustomObject indicates an expected call of UpdateCustomObject.
+func (mr *ClientMockRecorder) UpdateCustomObject(ctx, customObjectKey, customObject any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCustomObject", reflect.TypeOf((*Client)(nil).UpdateCustomObject), ctx, customObjectKey, customObject)
+}
+
+// UpdateCustomObjectField mocks base method.
+func (m *Client) UpdateCustomObjectField(ctx context.Context, customObjectKey, fieldKeyOrID string, field zendesk.CustomObjectField) (zendesk.CustomObjectField, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCustomObjectField", ctx, customObjectKey, fieldKeyOrID, field)
+	ret0, _ := ret[0].(zendesk.CustomObjectField)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCustomObjectField indicates an expected call of UpdateCustomObjectField.
+func (mr *ClientMockRecorder) UpdateCustomObjectField(ctx, customObjectKey, fieldKeyOrID, field any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCustomObjectField", reflect.TypeOf((*Client)(nil).UpdateCustomObjectField), ctx, customObjectKey, fieldKeyOrID, field)
+}
+
 // UpdateCustomObjectRecord mocks base method.
 func (m *Client) UpdateCustomObjectRecord(ctx context.Context, customObjectKey, customObjectRecordID string, record zendesk.CustomObjectRecord) (*zendesk.CustomObjectRecord, error) {
 	m.ctrl.T.Helper()
@@ -2669,6 +6283,21 @@ func (mr *ClientMockRecorder) UpdateGroup(ctx, groupID, group any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGroup", reflect.TypeOf((*Client)(nil).UpdateGroup), ctx, groupID, group)
 }
 
+// UpdateHoliday mocks base method.
+func (m *Client) UpdateHoliday(ctx context.Context, scheduleID, holidayID int64, holiday zendesk.Holiday) (zendesk.Holiday, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateHoliday", ctx, scheduleID, holidayID, holiday)
+	ret0, _ := ret[0].(zendesk.Holiday)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateHoliday indicates an expected call of UpdateHoliday.
+func (mr *ClientMockRecorder) UpdateHoliday(ctx, scheduleID, holidayID, holiday any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHoliday", reflect.TypeOf((*Client)(nil).UpdateHoliday), ctx, scheduleID, holidayID, holiday)
+}
+
 // UpdateMacro mocks base method.
 func (m *Client) UpdateMacro(ctx context.Context, macroID int64, macro zendesk.Macro) (zendesk.Macro, error) {
 	m.ctrl.T.Helper()
@@ -2684,6 +6313,66 @@ func (mr *ClientMockRecorder) UpdateMacro(ctx, macroID, macro any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMacro", reflect.TypeOf((*Client)(nil).UpdateMacro), ctx, macroID, macro)
 }
 
+// UpdateManyCustomObjectRecords mocks base method.
+func (m *Client) UpdateManyCustomObjectRecords(ctx context.Context, customObjectKey string, records []zendesk.CustomObjectRecord) (zendesk.JobStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateManyCustomObjectRecords", ctx, customObjectKey, records)
+	ret0, _ := ret[0].(zendesk.JobStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateManyCustomObjectRecords indicates an expected call of UpdateManyCustomObjectRecords.
+func (mr *ClientMockRecorder) UpdateManyCustomObjectRecords(ctx, customObjectKey, records any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateManyCustomObjectRecords", reflect.TypeOf((*Client)(nil).UpdateManyCustomObjectRecords), ctx, customObjectKey, records)
+}
+
+// UpdateManyOrganizations mocks base method.
+func (m *Client) UpdateManyOrganizations(ctx context.Context, orgs []zendesk.Organization) (zendesk.JobStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateManyOrganizations", ctx, orgs)
+	ret0, _ := ret[0].(zendesk.JobStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateManyOrganizations indicates an expected call of UpdateManyOrganizations.
+func (mr *ClientMockRecorder) UpdateManyOrganizations(ctx, orgs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateManyOrganizations", reflect.TypeOf((*Client)(nil).UpdateManyOrganizations), ctx, orgs)
+}
+
+// UpdateManyTriggers mocks base method.
+func (m *Client) UpdateManyTriggers(ctx context.Context, triggers []zendesk.TriggerBulkUpdate) ([]zendesk.Trigger, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateManyTriggers", ctx, triggers)
+	ret0, _ := ret[0].([]zendesk.Trigger)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateManyTriggers indicates an expected call of UpdateManyTriggers.
+func (mr *ClientMockRecorder) UpdateManyTriggers(ctx, triggers any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateManyTriggers", reflect.TypeOf((*Client)(nil).UpdateManyTriggers), ctx, triggers)
+}
+
+// UpdateManyUsers mocks base method.
+func (m *Client) UpdateManyUsers(ctx context.Context, users []zendesk.User) (zendesk.JobStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateManyUsers", ctx, users)
+	ret0, _ := ret[0].(zendesk.JobStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateManyUsers indicates an expected call of UpdateManyUsers.
+func (mr *ClientMockRecorder) UpdateManyUsers(ctx, users any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateManyUsers", reflect.TypeOf((*Client)(nil).UpdateManyUsers), ctx, users)
+}
+
 // UpdateOrganization mocks base method.
 func (m *Client) UpdateOrganization(ctx context.Context, orgID int64, org zendesk.Organization) (zendesk.Organization, error) {
 	m.ctrl.T.Helper()
@@ -2699,6 +6388,51 @@ func (mr *ClientMockRecorder) UpdateOrganization(ctx, orgID, org any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrganization", reflect.TypeOf((*Client)(nil).UpdateOrganization), ctx, orgID, org)
 }
 
+// UpdateRoutingAttribute mocks base method.
+func (m *Client) UpdateRoutingAttribute(ctx context.Context, attributeID string, attribute zendesk.RoutingAttribute) (zendesk.RoutingAttribute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRoutingAttribute", ctx, attributeID, attribute)
+	ret0, _ := ret[0].(zendesk.RoutingAttribute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRoutingAttribute indicates an expected call of UpdateRoutingAttribute.
+func (mr *ClientMockRecorder) UpdateRoutingAttribute(ctx, attributeID, attribute any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRoutingAttribute", reflect.TypeOf((*Client)(nil).UpdateRoutingAttribute), ctx, attributeID, attribute)
+}
+
+// UpdateRoutingAttributeValue mocks base method.
+func (m *Client) UpdateRoutingAttributeValue(ctx context.Context, attributeID, valueID string, value zendesk.RoutingAttributeValue) (zendesk.RoutingAttributeValue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRoutingAttributeValue", ctx, attributeID, valueID, value)
+	ret0, _ := ret[0].(zendesk.RoutingAttributeValue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRoutingAttributeValue indicates an expected call of UpdateRoutingAttributeValue.
+func (mr *ClientMockRecorder) UpdateRoutingAttributeValue(ctx, attributeID, valueID, value any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRoutingAttributeValue", reflect.TypeOf((*Client)(nil).UpdateRoutingAttributeValue), ctx, attributeID, valueID, value)
+}
+
+// UpdateRoutingQueue mocks base method.
+func (m *Client) UpdateRoutingQueue(ctx context.Context, queueID string, queue zendesk.RoutingQueue) (zendesk.RoutingQueue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRoutingQueue", ctx, queueID, queue)
+	ret0, _ := ret[0].(zendesk.RoutingQueue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRoutingQueue indicates an expected call of UpdateRoutingQueue.
+func (mr *ClientMockRecorder) UpdateRoutingQueue(ctx, queueID, queue any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRoutingQueue", reflect.TypeOf((*Client)(nil).UpdateRoutingQueue), ctx, queueID, queue)
+}
+
 // UpdateSLAPolicy mocks base method.
 func (m *Client) UpdateSLAPolicy(ctx context.Context, id int64, slaPolicy zendesk.SLAPolicy) (zendesk.SLAPolicy, error) {
 	m.ctrl.T.Helper()
@@ -2714,6 +6448,51 @@ func (mr *ClientMockRecorder) UpdateSLAPolicy(ctx, id, slaPolicy any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSLAPolicy", reflect.TypeOf((*Client)(nil).UpdateSLAPolicy), ctx, id, slaPolicy)
 }
 
+// UpdateSchedule mocks base method.
+func (m *Client) UpdateSchedule(ctx context.Context, scheduleID int64, schedule zendesk.Schedule) (zendesk.Schedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSchedule", ctx, scheduleID, schedule)
+	ret0, _ := ret[0].(zendesk.Schedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSchedule indicates an expected call of UpdateSchedule.
+func (mr *ClientMockRecorder) UpdateSchedule(ctx, scheduleID, schedule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSchedule", reflect.TypeOf((*Client)(nil).UpdateSchedule), ctx, scheduleID, schedule)
+}
+
+// UpdateScheduleIntervals mocks base method.
+func (m *Client) UpdateScheduleIntervals(ctx context.Context, scheduleID int64, intervals []zendesk.ScheduleInterval) ([]zendesk.ScheduleInterval, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateScheduleIntervals", ctx, scheduleID, intervals)
+	ret0, _ := ret[0].([]zendesk.ScheduleInterval)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateScheduleIntervals indicates an expected call of UpdateScheduleIntervals.
+func (mr *ClientMockRecorder) UpdateScheduleIntervals(ctx, scheduleID, intervals any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateScheduleIntervals", reflect.TypeOf((*Client)(nil).UpdateScheduleIntervals), ctx, scheduleID, intervals)
+}
+
+// UpdateSection mocks base method.
+func (m *Client) UpdateSection(ctx context.Context, locale int, sectionID int64, section zendesk.Section) (zendesk.Section, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSection", ctx, locale, sectionID, section)
+	ret0, _ := ret[0].(zendesk.Section)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSection indicates an expected call of UpdateSection.
+func (mr *ClientMockRecorder) UpdateSection(ctx, locale, sectionID, section any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSection", reflect.TypeOf((*Client)(nil).UpdateSection), ctx, locale, sectionID, section)
+}
+
 // UpdateTarget mocks base method.
 func (m *Client) UpdateTarget(ctx context.Context, ticketID int64, field zendesk.Target) (zendesk.Target, error) {
 	m.ctrl.T.Helper()
@@ -2774,6 +6553,36 @@ func (mr *ClientMockRecorder) UpdateTicketForm(ctx, id, form any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTicketForm", reflect.TypeOf((*Client)(nil).UpdateTicketForm), ctx, id, form)
 }
 
+// UpdateTicketFormConditions mocks base method.
+func (m *Client) UpdateTicketFormConditions(ctx context.Context, id int64, agentConditions, endUserConditions []zendesk.TicketFormCondition) (zendesk.TicketForm, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTicketFormConditions", ctx, id, agentConditions, endUserConditions)
+	ret0, _ := ret[0].(zendesk.TicketForm)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTicketFormConditions indicates an expected call of UpdateTicketFormConditions.
+func (mr *ClientMockRecorder) UpdateTicketFormConditions(ctx, id, agentConditions, endUserConditions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTicketFormConditions", reflect.TypeOf((*Client)(nil).UpdateTicketFormConditions), ctx, id, agentConditions, endUserConditions)
+}
+
+// UpdateTranslation mocks base method.
+func (m *Client) UpdateTranslation(ctx context.Context, source zendesk.TranslationSource, sourceID int64, locale int, translation zendesk.Translation) (zendesk.Translation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTranslation", ctx, source, sourceID, locale, translation)
+	ret0, _ := ret[0].(zendesk.Translation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTranslation indicates an expected call of UpdateTranslation.
+func (mr *ClientMockRecorder) UpdateTranslation(ctx, source, sourceID, locale, translation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTranslation", reflect.TypeOf((*Client)(nil).UpdateTranslation), ctx, source, sourceID, locale, translation)
+}
+
 // UpdateTrigger mocks base method.
 func (m *Client) UpdateTrigger(ctx context.Context, id int64, trigger zendesk.Trigger) (zendesk.Trigger, error) {
 	m.ctrl.T.Helper()
@@ -2789,6 +6598,21 @@ func (mr *ClientMockRecorder) UpdateTrigger(ctx, id, trigger any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTrigger", reflect.TypeOf((*Client)(nil).UpdateTrigger), ctx, id, trigger)
 }
 
+// UpdateTriggerCategory mocks base method.
+func (m *Client) UpdateTriggerCategory(ctx context.Context, id string, category zendesk.TriggerCategory) (zendesk.TriggerCategory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTriggerCategory", ctx, id, category)
+	ret0, _ := ret[0].(zendesk.TriggerCategory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTriggerCategory indicates an expected call of UpdateTriggerCategory.
+func (mr *ClientMockRecorder) UpdateTriggerCategory(ctx, id, category any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTriggerCategory", reflect.TypeOf((*Client)(nil).UpdateTriggerCategory), ctx, id, category)
+}
+
 // UpdateUser mocks base method.
 func (m *Client) UpdateUser(ctx context.Context, userID int64, user zendesk.User) (zendesk.User, error) {
 	m.ctrl.T.Helper()
@@ -2804,6 +6628,36 @@ func (mr *ClientMockRecorder) UpdateUser(ctx, userID, user any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*Client)(nil).UpdateUser), ctx, userID, user)
 }
 
+// UpdateUserIdentity mocks base method.
+func (m *Client) UpdateUserIdentity(ctx context.Context, userID, identityID int64, identity zendesk.UserIdentity) (zendesk.UserIdentity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserIdentity", ctx, userID, identityID, identity)
+	ret0, _ := ret[0].(zendesk.UserIdentity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserIdentity indicates an expected call of UpdateUserIdentity.
+func (mr *ClientMockRecorder) UpdateUserIdentity(ctx, userID, identityID, identity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserIdentity", reflect.TypeOf((*Client)(nil).UpdateUserIdentity), ctx, userID, identityID, identity)
+}
+
+// UpdateView mocks base method.
+func (m *Client) UpdateView(ctx context.Context, viewID int64, view zendesk.View) (zendesk.View, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateView", ctx, viewID, view)
+	ret0, _ := ret[0].(zendesk.View)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateView indicates an expected call of UpdateView.
+func (mr *ClientMockRecorder) UpdateView(ctx, viewID, view any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateView", reflect.TypeOf((*Client)(nil).UpdateView), ctx, viewID, view)
+}
+
 // UpdateWebhook mocks base method.
 func (m *Client) UpdateWebhook(ctx context.Context, webhookID string, hook *zendesk.Webhook) error {
 	m.ctrl.T.Helper()
@@ -2831,3 +6685,78 @@ func (mr *ClientMockRecorder) UploadAttachment(ctx, filename, token any) *gomock
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadAttachment", reflect.TypeOf((*Client)(nil).UploadAttachment), ctx, filename, token)
 }
+
+// UploadMacroAttachment mocks base method.
+func (m *Client) UploadMacroAttachment(ctx context.Context, filename string, file io.Reader) (zendesk.MacroAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadMacroAttachment", ctx, filename, file)
+	ret0, _ := ret[0].(zendesk.MacroAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadMacroAttachment indicates an expected call of UploadMacroAttachment.
+func (mr *ClientMockRecorder) UploadMacroAttachment(ctx, filename, file any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadMacroAttachment", reflect.TypeOf((*Client)(nil).UploadMacroAttachment), ctx, filename, file)
+}
+
+// UpsertCustomObjectRecord mocks base method.
+func (m *Client) UpsertCustomObjectRecord(ctx context.Context, customObjectKey, externalID string, record zendesk.CustomObjectRecord) (*zendesk.CustomObjectRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertCustomObjectRecord", ctx, customObjectKey, externalID, record)
+	ret0, _ := ret[0].(*zendesk.CustomObjectRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertCustomObjectRecord indicates an expected call of UpsertCustomObjectRecord.
+func (mr *ClientMockRecorder) UpsertCustomObjectRecord(ctx, customObjectKey, externalID, record any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertCustomObjectRecord", reflect.TypeOf((*Client)(nil).UpsertCustomObjectRecord), ctx, customObjectKey, externalID, record)
+}
+
+// VerifyUserIdentity mocks base method.
+func (m *Client) VerifyUserIdentity(ctx context.Context, userID, identityID int64) (zendesk.UserIdentity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyUserIdentity", ctx, userID, identityID)
+	ret0, _ := ret[0].(zendesk.UserIdentity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyUserIdentity indicates an expected call of VerifyUserIdentity.
+func (mr *ClientMockRecorder) VerifyUserIdentity(ctx, userID, identityID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyUserIdentity", reflect.TypeOf((*Client)(nil).VerifyUserIdentity), ctx, userID, identityID)
+}
+
+// WaitCountTicketsInViews mocks base method.
+func (m *Client) WaitCountTicketsInViews(ctx context.Context, ids []string, interval time.Duration) ([]zendesk.ViewCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitCountTicketsInViews", ctx, ids, interval)
+	ret0, _ := ret[0].([]zendesk.ViewCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WaitCountTicketsInViews indicates an expected call of WaitCountTicketsInViews.
+func (mr *ClientMockRecorder) WaitCountTicketsInViews(ctx, ids, interval any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitCountTicketsInViews", reflect.TypeOf((*Client)(nil).WaitCountTicketsInViews), ctx, ids, interval)
+}
+
+// WaitJobStatus mocks base method.
+func (m *Client) WaitJobStatus(ctx context.Context, jobID string, interval time.Duration) (zendesk.JobStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitJobStatus", ctx, jobID, interval)
+	ret0, _ := ret[0].(zendesk.JobStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WaitJobStatus indicates an expected call of WaitJobStatus.
+func (mr *ClientMockRecorder) WaitJobStatus(ctx, jobID, interval any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitJobStatus", reflect.TypeOf((*Client)(nil).WaitJobStatus), ctx, jobID, interval)
+}
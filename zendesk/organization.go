@@ -33,6 +33,18 @@ type OrganizationListOptions struct {
 	PageOptions
 }
 
+// OrganizationMerge is struct for organization merge payload.
+// Merging moves the users, tickets and memberships of the loser organization into the winner.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/#merge-organization-with-another-organization
+type OrganizationMerge struct {
+	ID       string `json:"id,omitempty"`
+	URL      string `json:"url,omitempty"`
+	LoserID  int64  `json:"loser_id,omitempty"`
+	WinnerID int64  `json:"winner_id"`
+	Status   string `json:"status,omitempty"`
+}
+
 // OrganizationAPI an interface containing all methods associated with zendesk organizations
 type OrganizationAPI interface {
 	GetOrganizations(ctx context.Context, opts *OrganizationListOptions) ([]Organization, Page, error)
@@ -44,6 +56,14 @@ type OrganizationAPI interface {
 	GetOrganizationsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[Organization]
 	GetOrganizationsOBP(ctx context.Context, opts *OBPOptions) ([]Organization, Page, error)
 	GetOrganizationsCBP(ctx context.Context, opts *CBPOptions) ([]Organization, CursorPaginationMeta, error)
+	CreateManyOrganizations(ctx context.Context, orgs []Organization) (JobStatus, error)
+	UpdateManyOrganizations(ctx context.Context, orgs []Organization) (JobStatus, error)
+	DeleteManyOrganizations(ctx context.Context, orgIDs []int64) (JobStatus, error)
+	AutocompleteOrganizations(ctx context.Context, name string) ([]Organization, error)
+	SearchOrganizationsByName(ctx context.Context, name string) ([]Organization, error)
+	MergeOrganization(ctx context.Context, loserID, winnerID int64) (OrganizationMerge, error)
+	GetOrganizationMerge(ctx context.Context, mergeID string) (OrganizationMerge, error)
+	GetOrganizationMerges(ctx context.Context, orgID int64) ([]OrganizationMerge, error)
 }
 
 // GetOrganizations fetch organization list
@@ -172,3 +192,154 @@ func (z *Client) DeleteOrganization(ctx context.Context, orgID int64) error {
 
 	return nil
 }
+
+// CreateManyOrganizations enqueues a job to create up to 100 organizations
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/#create-many-organizations
+func (z *Client) CreateManyOrganizations(ctx context.Context, orgs []Organization) (JobStatus, error) {
+	var data struct {
+		Organizations []Organization `json:"organizations"`
+	}
+	data.Organizations = orgs
+
+	body, err := z.post(ctx, "/organizations/create_many.json", data)
+	if err != nil {
+		return JobStatus{}, err
+	}
+
+	return jobStatusResponse(body)
+}
+
+// UpdateManyOrganizations enqueues a job to update up to 100 organizations.
+// Each organization must have its ID set.
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/#update-many-organizations
+func (z *Client) UpdateManyOrganizations(ctx context.Context, orgs []Organization) (JobStatus, error) {
+	var data struct {
+		Organizations []Organization `json:"organizations"`
+	}
+	data.Organizations = orgs
+
+	body, err := z.put(ctx, "/organizations/update_many.json", data)
+	if err != nil {
+		return JobStatus{}, err
+	}
+
+	return jobStatusResponse(body)
+}
+
+// DeleteManyOrganizations enqueues a job to delete up to 100 organizations
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/#bulk-delete-organizations
+func (z *Client) DeleteManyOrganizations(ctx context.Context, orgIDs []int64) (JobStatus, error) {
+	u, err := idsOptions("/organizations/destroy_many.json", orgIDs)
+	if err != nil {
+		return JobStatus{}, err
+	}
+
+	body, err := z.deleteWithResponse(ctx, u)
+	if err != nil {
+		return JobStatus{}, err
+	}
+
+	return jobStatusResponse(body)
+}
+
+// AutocompleteOrganizations returns organizations whose name starts with the specified value
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/#autocomplete-organizations
+func (z *Client) AutocompleteOrganizations(ctx context.Context, name string) ([]Organization, error) {
+	return z.getOrganizationsByName(ctx, "/organizations/autocomplete.json", name)
+}
+
+// SearchOrganizationsByName returns the organization whose name exactly matches the specified value
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/#search-organizations-by-name
+func (z *Client) SearchOrganizationsByName(ctx context.Context, name string) ([]Organization, error) {
+	return z.getOrganizationsByName(ctx, "/organizations/search.json", name)
+}
+
+func (z *Client) getOrganizationsByName(ctx context.Context, path, name string) ([]Organization, error) {
+	var result struct {
+		Organizations []Organization `json:"organizations"`
+	}
+
+	var req struct {
+		Name string `url:"name"`
+	}
+	req.Name = name
+
+	u, err := addOptions(path, req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Organizations, nil
+}
+
+// MergeOrganization merges the loser organization into the winner organization.
+// The merge runs asynchronously; use GetOrganizationMerge to follow its status.
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/#merge-organization-with-another-organization
+func (z *Client) MergeOrganization(ctx context.Context, loserID, winnerID int64) (OrganizationMerge, error) {
+	var data, result struct {
+		OrganizationMerge OrganizationMerge `json:"organization_merge"`
+	}
+	data.OrganizationMerge.WinnerID = winnerID
+
+	body, err := z.post(ctx, fmt.Sprintf("/organizations/%d/merge.json", loserID), data)
+	if err != nil {
+		return OrganizationMerge{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return OrganizationMerge{}, err
+	}
+
+	return result.OrganizationMerge, nil
+}
+
+// GetOrganizationMerge gets the specified organization merge
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/#show-organization-merge
+func (z *Client) GetOrganizationMerge(ctx context.Context, mergeID string) (OrganizationMerge, error) {
+	var result struct {
+		OrganizationMerge OrganizationMerge `json:"organization_merge"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/organization_merges/%s.json", mergeID))
+	if err != nil {
+		return OrganizationMerge{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return OrganizationMerge{}, err
+	}
+
+	return result.OrganizationMerge, nil
+}
+
+// GetOrganizationMerges lists the merges of the specified organization
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/#list-organization-merges
+func (z *Client) GetOrganizationMerges(ctx context.Context, orgID int64) ([]OrganizationMerge, error) {
+	var result struct {
+		OrganizationMerges []OrganizationMerge `json:"organization_merges"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/organizations/%d/merges.json", orgID))
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.OrganizationMerges, nil
+}
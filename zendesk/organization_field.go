@@ -3,6 +3,7 @@ package zendesk
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"time"
 )
 
@@ -35,6 +36,13 @@ type OrganizationFieldAPI interface {
 	GetOrganizationFieldsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[OrganizationField]
 	GetOrganizationFieldsOBP(ctx context.Context, opts *OBPOptions) ([]OrganizationField, Page, error)
 	GetOrganizationFieldsCBP(ctx context.Context, opts *CBPOptions) ([]OrganizationField, CursorPaginationMeta, error)
+	GetOrganizationFieldOptionsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[CustomFieldOption]
+	GetOrganizationFieldOptionsOBP(ctx context.Context, opts *OBPOptions) ([]CustomFieldOption, Page, error)
+	GetOrganizationFieldOptionsCBP(ctx context.Context, opts *CBPOptions) ([]CustomFieldOption, CursorPaginationMeta, error)
+	GetOrganizationFieldOption(ctx context.Context, fieldID, optionID int64) (CustomFieldOption, error)
+	CreateOrUpdateOrganizationFieldOption(ctx context.Context, fieldID int64, option CustomFieldOption) (CustomFieldOption, error)
+	DeleteOrganizationFieldOption(ctx context.Context, fieldID, optionID int64) error
+	ReorderOrganizationFields(ctx context.Context, fieldIDs []int64) error
 }
 
 // GetOrganizationFields fetches organization field list
@@ -76,3 +84,43 @@ func (z *Client) CreateOrganizationField(ctx context.Context, organizationField
 	}
 	return result.OrganizationField, nil
 }
+
+// GetOrganizationFieldOption gets the specified option of a organization field
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organization_fields/#show-organization-field-option
+func (z *Client) GetOrganizationFieldOption(ctx context.Context, fieldID, optionID int64) (CustomFieldOption, error) {
+	return z.getCustomFieldOption(ctx, fmt.Sprintf("/organization_fields/%d/options/%d.json", fieldID, optionID))
+}
+
+// CreateOrUpdateOrganizationFieldOption creates an option of a dropdown or multiselect organization field.
+// The option is updated instead when its ID is set.
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organization_fields/#create-or-update-organization-field-option
+func (z *Client) CreateOrUpdateOrganizationFieldOption(ctx context.Context, fieldID int64, option CustomFieldOption) (CustomFieldOption, error) {
+	return z.createOrUpdateCustomFieldOption(ctx, fmt.Sprintf("/organization_fields/%d/options.json", fieldID), option)
+}
+
+// DeleteOrganizationFieldOption deletes the specified option of a organization field
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organization_fields/#delete-organization-field-option
+func (z *Client) DeleteOrganizationFieldOption(ctx context.Context, fieldID, optionID int64) error {
+	err := z.delete(ctx, fmt.Sprintf("/organization_fields/%d/options/%d.json", fieldID, optionID))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ReorderOrganizationFields sets the order of organization fields
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organization_fields/#reorder-organization-field
+func (z *Client) ReorderOrganizationFields(ctx context.Context, fieldIDs []int64) error {
+	var data struct {
+		IDs []int64 `json:"organization_field_ids"`
+	}
+	data.IDs = fieldIDs
+
+	_, err := z.put(ctx, "/organization_fields/reorder.json", data)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
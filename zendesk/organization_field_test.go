@@ -1,7 +1,10 @@
 package zendesk
 
 import (
+	"encoding/json"
 	"net/http"
+	"net/http/httptest"
+	"reflect"
 	"testing"
 )
 
@@ -30,3 +33,43 @@ func TestOrganizationField(t *testing.T) {
 		t.Fatalf("Failed to send request to create organization field: %s", err)
 	}
 }
+
+func TestCreateOrUpdateOrganizationFieldOption(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodPost, "custom_field_option.json", http.StatusCreated)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	option, err := client.CreateOrUpdateOrganizationFieldOption(ctx, 1, CustomFieldOption{
+		Name:  "Banana Pie",
+		Value: "banana",
+	})
+	if err != nil {
+		t.Fatalf("Failed to create organization field option: %s", err)
+	}
+
+	if option.ID != 10001 {
+		t.Fatalf("expected option id is 10001, but got %d", option.ID)
+	}
+}
+
+func TestReorderOrganizationFields(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data struct {
+			IDs []int64 `json:"organization_field_ids"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+			t.Errorf("Failed to decode request body: %s", err)
+		}
+		if !reflect.DeepEqual(data.IDs, []int64{3, 1, 2}) {
+			t.Errorf("unexpected organization field ids: %v", data.IDs)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	err := client.ReorderOrganizationFields(ctx, []int64{3, 1, 2})
+	if err != nil {
+		t.Fatalf("Failed to reorder organization fields: %s", err)
+	}
+}
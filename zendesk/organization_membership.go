@@ -44,6 +44,9 @@ type (
 		GetOrganizationMembershipsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[OrganizationMembership]
 		GetOrganizationMembershipsOBP(ctx context.Context, opts *OBPOptions) ([]OrganizationMembership, Page, error)
 		GetOrganizationMembershipsCBP(ctx context.Context, opts *CBPOptions) ([]OrganizationMembership, CursorPaginationMeta, error)
+		DeleteOrganizationMembership(ctx context.Context, membershipID int64) error
+		CreateManyOrganizationMemberships(ctx context.Context, opts []OrganizationMembershipOptions) (JobStatus, error)
+		DeleteManyOrganizationMemberships(ctx context.Context, membershipIDs []int64) (JobStatus, error)
 	}
 )
 
@@ -121,3 +124,46 @@ func (z *Client) SetDefaultOrganization(ctx context.Context, opts OrganizationMe
 
 	return result.OrganizationMembership, nil
 }
+
+// DeleteOrganizationMembership removes a user from an organization
+// https://developer.zendesk.com/api-reference/ticketing/organizations/organization_memberships/#delete-membership
+func (z *Client) DeleteOrganizationMembership(ctx context.Context, membershipID int64) error {
+	err := z.delete(ctx, fmt.Sprintf("/organization_memberships/%d.json", membershipID))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// CreateManyOrganizationMemberships enqueues a job to create up to 100 organization memberships
+// https://developer.zendesk.com/api-reference/ticketing/organizations/organization_memberships/#create-many-memberships
+func (z *Client) CreateManyOrganizationMemberships(ctx context.Context, opts []OrganizationMembershipOptions) (JobStatus, error) {
+	var data struct {
+		OrganizationMemberships []OrganizationMembershipOptions `json:"organization_memberships"`
+	}
+	data.OrganizationMemberships = opts
+
+	body, err := z.post(ctx, "/organization_memberships/create_many.json", data)
+	if err != nil {
+		return JobStatus{}, err
+	}
+
+	return jobStatusResponse(body)
+}
+
+// DeleteManyOrganizationMemberships enqueues a job to delete up to 100 organization memberships
+// https://developer.zendesk.com/api-reference/ticketing/organizations/organization_memberships/#bulk-delete-memberships
+func (z *Client) DeleteManyOrganizationMemberships(ctx context.Context, membershipIDs []int64) (JobStatus, error) {
+	u, err := idsOptions("/organization_memberships/destroy_many.json", membershipIDs)
+	if err != nil {
+		return JobStatus{}, err
+	}
+
+	body, err := z.deleteWithResponse(ctx, u)
+	if err != nil {
+		return JobStatus{}, err
+	}
+
+	return jobStatusResponse(body)
+}
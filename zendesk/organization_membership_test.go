@@ -2,6 +2,8 @@ package zendesk
 
 import (
 	"net/http"
+	"net/http/httptest"
+	"path/filepath"
 	"testing"
 )
 
@@ -48,3 +50,53 @@ func TestSetDefaultOrganization(t *testing.T) {
 		t.Fatalf("Returned org membership does not have the expected default status %v. It is %v", expectedDefault, orgMembership.Default)
 	}
 }
+
+func TestDeleteOrganizationMembership(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+		w.Write(nil)
+	}))
+
+	c := newTestClient(mockAPI)
+	err := c.DeleteOrganizationMembership(ctx, 4567)
+	if err != nil {
+		t.Fatalf("Failed to delete organization membership: %s", err)
+	}
+}
+
+func TestCreateManyOrganizationMemberships(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodPost, "job_status.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	status, err := client.CreateManyOrganizationMemberships(ctx, []OrganizationMembershipOptions{
+		{OrganizationID: 361898904439, UserID: 369531345753},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create many organization memberships: %s", err)
+	}
+
+	if status.Status != JobStatusQueued {
+		t.Fatalf("expected job status is queued, but got %s", status.Status)
+	}
+}
+
+func TestDeleteManyOrganizationMemberships(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if ids := r.URL.Query().Get("ids"); ids != "4567,4568" {
+			t.Errorf("unexpected ids query: %s", ids)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodDelete, "job_status.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	status, err := client.DeleteManyOrganizationMemberships(ctx, []int64{4567, 4568})
+	if err != nil {
+		t.Fatalf("Failed to delete many organization memberships: %s", err)
+	}
+
+	if status.Status != JobStatusQueued {
+		t.Fatalf("expected job status is queued, but got %s", status.Status)
+	}
+}
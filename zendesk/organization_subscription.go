@@ -0,0 +1,124 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+type (
+	// OrganizationSubscription is struct for organization subscription payload.
+	// A subscribed user is notified of updates to the tickets of the organization.
+	// https://developer.zendesk.com/api-reference/ticketing/organizations/organization_subscriptions/
+	OrganizationSubscription struct {
+		ID             int64     `json:"id,omitempty"`
+		OrganizationID int64     `json:"organization_id"`
+		UserID         int64     `json:"user_id"`
+		CreatedAt      time.Time `json:"created_at,omitempty"`
+	}
+
+	// OrganizationSubscriptionListOptions is a struct for options for organization subscription list.
+	// When OrganizationID or UserID is set, only the subscriptions of that organization or user are listed.
+	// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organization_subscriptions/#list-organization-subscriptions
+	OrganizationSubscriptionListOptions struct {
+		PageOptions
+		OrganizationID int64 `url:"-"`
+		UserID         int64 `url:"-"`
+	}
+
+	// OrganizationSubscriptionAPI is an interface containing organization subscription related methods
+	OrganizationSubscriptionAPI interface {
+		GetOrganizationSubscriptions(ctx context.Context, opts *OrganizationSubscriptionListOptions) ([]OrganizationSubscription, Page, error)
+		GetOrganizationSubscription(ctx context.Context, subscriptionID int64) (OrganizationSubscription, error)
+		CreateOrganizationSubscription(ctx context.Context, subscription OrganizationSubscription) (OrganizationSubscription, error)
+		DeleteOrganizationSubscription(ctx context.Context, subscriptionID int64) error
+	}
+)
+
+// GetOrganizationSubscriptions lists organization subscriptions
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organization_subscriptions/#list-organization-subscriptions
+func (z *Client) GetOrganizationSubscriptions(ctx context.Context, opts *OrganizationSubscriptionListOptions) ([]OrganizationSubscription, Page, error) {
+	var result struct {
+		OrganizationSubscriptions []OrganizationSubscription `json:"organization_subscriptions"`
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = new(OrganizationSubscriptionListOptions)
+	}
+
+	path := "/organization_subscriptions.json"
+	if tmp.OrganizationID != 0 {
+		path = fmt.Sprintf("/organizations/%d/subscriptions.json", tmp.OrganizationID)
+	} else if tmp.UserID != 0 {
+		path = fmt.Sprintf("/users/%d/organization_subscriptions.json", tmp.UserID)
+	}
+
+	u, err := addOptions(path, tmp)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, Page{}, err
+	}
+
+	return result.OrganizationSubscriptions, result.Page, nil
+}
+
+// GetOrganizationSubscription gets the specified organization subscription
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organization_subscriptions/#show-organization-subscription
+func (z *Client) GetOrganizationSubscription(ctx context.Context, subscriptionID int64) (OrganizationSubscription, error) {
+	var result struct {
+		OrganizationSubscription OrganizationSubscription `json:"organization_subscription"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/organization_subscriptions/%d.json", subscriptionID))
+	if err != nil {
+		return OrganizationSubscription{}, err
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return OrganizationSubscription{}, err
+	}
+
+	return result.OrganizationSubscription, nil
+}
+
+// CreateOrganizationSubscription subscribes a user to an organization
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organization_subscriptions/#create-organization-subscription
+func (z *Client) CreateOrganizationSubscription(ctx context.Context, subscription OrganizationSubscription) (OrganizationSubscription, error) {
+	var data, result struct {
+		OrganizationSubscription OrganizationSubscription `json:"organization_subscription"`
+	}
+	data.OrganizationSubscription = subscription
+
+	body, err := z.post(ctx, "/organization_subscriptions.json", data)
+	if err != nil {
+		return OrganizationSubscription{}, err
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return OrganizationSubscription{}, err
+	}
+
+	return result.OrganizationSubscription, nil
+}
+
+// DeleteOrganizationSubscription unsubscribes a user from an organization
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organization_subscriptions/#delete-organization-subscription
+func (z *Client) DeleteOrganizationSubscription(ctx context.Context, subscriptionID int64) error {
+	err := z.delete(ctx, fmt.Sprintf("/organization_subscriptions/%d.json", subscriptionID))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
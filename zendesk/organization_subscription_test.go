@@ -0,0 +1,85 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+)
+
+func TestGetOrganizationSubscriptions(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "organization_subscriptions.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	subscriptions, _, err := client.GetOrganizationSubscriptions(ctx, nil)
+	if err != nil {
+		t.Fatalf("Failed to get organization subscriptions: %s", err)
+	}
+
+	if len(subscriptions) != 2 {
+		t.Fatalf("expected length of organization subscriptions is 2, but got %d", len(subscriptions))
+	}
+}
+
+func TestGetOrganizationSubscriptionsOfOrganization(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/organizations/361898904439/subscriptions.json" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "organization_subscriptions.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, _, err := client.GetOrganizationSubscriptions(ctx, &OrganizationSubscriptionListOptions{OrganizationID: 361898904439})
+	if err != nil {
+		t.Fatalf("Failed to get organization subscriptions: %s", err)
+	}
+}
+
+func TestGetOrganizationSubscription(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "organization_subscription.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	subscription, err := client.GetOrganizationSubscription(ctx, 1234)
+	if err != nil {
+		t.Fatalf("Failed to get organization subscription: %s", err)
+	}
+
+	if subscription.ID != 1234 {
+		t.Fatalf("expected organization subscription id is 1234, but got %d", subscription.ID)
+	}
+}
+
+func TestCreateOrganizationSubscription(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodPost, "organization_subscription.json", http.StatusCreated)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	subscription, err := client.CreateOrganizationSubscription(ctx, OrganizationSubscription{
+		OrganizationID: 361898904439,
+		UserID:         369531345753,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create organization subscription: %s", err)
+	}
+
+	if subscription.UserID != 369531345753 {
+		t.Fatalf("expected user id is 369531345753, but got %d", subscription.UserID)
+	}
+}
+
+func TestDeleteOrganizationSubscription(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+		w.Write(nil)
+	}))
+
+	c := newTestClient(mockAPI)
+	err := c.DeleteOrganizationSubscription(ctx, 1234)
+	if err != nil {
+		t.Fatalf("Failed to delete organization subscription: %s", err)
+	}
+}
@@ -3,6 +3,7 @@ package zendesk
 import (
 	"net/http"
 	"net/http/httptest"
+	"path/filepath"
 	"testing"
 )
 
@@ -76,3 +77,140 @@ func TestDeleteOrganization(t *testing.T) {
 		t.Fatalf("Failed to delete organization: %s", err)
 	}
 }
+
+func TestCreateManyOrganizations(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodPost, "job_status.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	status, err := client.CreateManyOrganizations(ctx, []Organization{{Name: "Rebel Alliance"}, {Name: "Galactic Empire"}})
+	if err != nil {
+		t.Fatalf("Failed to create many organizations: %s", err)
+	}
+
+	if status.Status != JobStatusQueued {
+		t.Fatalf("expected job status is queued, but got %s", status.Status)
+	}
+}
+
+func TestUpdateManyOrganizations(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodPut, "job_status.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	status, err := client.UpdateManyOrganizations(ctx, []Organization{{ID: 361898904439, Name: "Rebel Alliance"}})
+	if err != nil {
+		t.Fatalf("Failed to update many organizations: %s", err)
+	}
+
+	if status.Status != JobStatusQueued {
+		t.Fatalf("expected job status is queued, but got %s", status.Status)
+	}
+}
+
+func TestDeleteManyOrganizations(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if ids := r.URL.Query().Get("ids"); ids != "361898904439,361898904440" {
+			t.Errorf("unexpected ids query: %s", ids)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodDelete, "job_status.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	status, err := client.DeleteManyOrganizations(ctx, []int64{361898904439, 361898904440})
+	if err != nil {
+		t.Fatalf("Failed to delete many organizations: %s", err)
+	}
+
+	if status.Status != JobStatusQueued {
+		t.Fatalf("expected job status is queued, but got %s", status.Status)
+	}
+}
+
+func TestAutocompleteOrganizations(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/organizations/autocomplete.json" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if name := r.URL.Query().Get("name"); name != "Reb" {
+			t.Errorf("unexpected name query: %s", name)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "organizations.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	orgs, err := client.AutocompleteOrganizations(ctx, "Reb")
+	if err != nil {
+		t.Fatalf("Failed to autocomplete organizations: %s", err)
+	}
+
+	if len(orgs) != 2 {
+		t.Fatalf("expected length of organizations is 2, but got %d", len(orgs))
+	}
+}
+
+func TestSearchOrganizationsByName(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/organizations/search.json" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if name := r.URL.Query().Get("name"); name != "Rebel Alliance" {
+			t.Errorf("unexpected name query: %s", name)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "organizations.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.SearchOrganizationsByName(ctx, "Rebel Alliance")
+	if err != nil {
+		t.Fatalf("Failed to search organizations by name: %s", err)
+	}
+}
+
+func TestMergeOrganization(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodPost, "organization_merge.json", http.StatusCreated)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	merge, err := client.MergeOrganization(ctx, 361898904439, 361898904440)
+	if err != nil {
+		t.Fatalf("Failed to merge organization: %s", err)
+	}
+
+	if merge.WinnerID != 361898904440 {
+		t.Fatalf("expected winner id is 361898904440, but got %d", merge.WinnerID)
+	}
+}
+
+func TestGetOrganizationMerge(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "organization_merge.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	merge, err := client.GetOrganizationMerge(ctx, "01HPZM6206BF4G63783E5349AD")
+	if err != nil {
+		t.Fatalf("Failed to get organization merge: %s", err)
+	}
+
+	if merge.Status != "complete" {
+		t.Fatalf("expected merge status is complete, but got %s", merge.Status)
+	}
+}
+
+func TestGetOrganizationMerges(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "organization_merges.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	merges, err := client.GetOrganizationMerges(ctx, 361898904440)
+	if err != nil {
+		t.Fatalf("Failed to get organization merges: %s", err)
+	}
+
+	if len(merges) != 1 {
+		t.Fatalf("expected length of organization merges is 1, but got %d", len(merges))
+	}
+}
@@ -0,0 +1,363 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type (
+	// RoutingAttribute is struct for skills-based routing attribute payload
+	// https://developer.zendesk.com/api-reference/ticketing/ticket-management/skill_based_routing/#routing-attributes
+	RoutingAttribute struct {
+		ID        string                  `json:"id,omitempty"`
+		URL       string                  `json:"url,omitempty"`
+		Name      string                  `json:"name"`
+		Values    []RoutingAttributeValue `json:"values,omitempty"`
+		CreatedAt time.Time               `json:"created_at,omitempty"`
+		UpdatedAt time.Time               `json:"updated_at,omitempty"`
+	}
+
+	// RoutingAttributeValue is struct for skills-based routing attribute value payload.
+	// Conditions are evaluated by Zendesk to automatically apply the value to tickets.
+	// https://developer.zendesk.com/api-reference/ticketing/ticket-management/skill_based_routing/#routing-attribute-values
+	RoutingAttributeValue struct {
+		ID          string                          `json:"id,omitempty"`
+		URL         string                          `json:"url,omitempty"`
+		AttributeID string                          `json:"attribute_id,omitempty"`
+		Name        string                          `json:"name"`
+		Conditions  *RoutingAttributeValueCondition `json:"conditions,omitempty"`
+		CreatedAt   time.Time                       `json:"created_at,omitempty"`
+		UpdatedAt   time.Time                       `json:"updated_at,omitempty"`
+	}
+
+	// RoutingAttributeValueCondition is the set of conditions which apply an attribute value to tickets
+	RoutingAttributeValueCondition struct {
+		All []TriggerCondition `json:"all"`
+		Any []TriggerCondition `json:"any"`
+	}
+
+	// RoutingAttributeDefinitions is the list of conditions available for attribute values
+	// https://developer.zendesk.com/api-reference/ticketing/ticket-management/skill_based_routing/#list-routing-attribute-definitions
+	RoutingAttributeDefinitions struct {
+		ConditionsAll []RoutingAttributeDefinition `json:"conditions_all"`
+		ConditionsAny []RoutingAttributeDefinition `json:"conditions_any"`
+	}
+
+	// RoutingAttributeDefinition describes a condition subject available for attribute values
+	RoutingAttributeDefinition struct {
+		Title   string `json:"title"`
+		Subject string `json:"subject"`
+	}
+
+	// RoutingRequirementsFulfilled is the list of tickets whose skill requirements
+	// are fulfilled by the current agent
+	RoutingRequirementsFulfilled struct {
+		FulfilledTicketIDs []int64 `json:"fulfilled_ticket_ids"`
+	}
+
+	// RoutingAttributeAPI is an interface containing skills-based routing related methods
+	RoutingAttributeAPI interface {
+		GetRoutingAttributes(ctx context.Context) ([]RoutingAttribute, error)
+		GetRoutingAttribute(ctx context.Context, attributeID string) (RoutingAttribute, error)
+		CreateRoutingAttribute(ctx context.Context, attribute RoutingAttribute) (RoutingAttribute, error)
+		UpdateRoutingAttribute(ctx context.Context, attributeID string, attribute RoutingAttribute) (RoutingAttribute, error)
+		DeleteRoutingAttribute(ctx context.Context, attributeID string) error
+		GetRoutingAttributeDefinitions(ctx context.Context) (RoutingAttributeDefinitions, error)
+		GetRoutingAttributeValues(ctx context.Context, attributeID string) ([]RoutingAttributeValue, error)
+		GetRoutingAttributeValue(ctx context.Context, attributeID, valueID string) (RoutingAttributeValue, error)
+		CreateRoutingAttributeValue(ctx context.Context, attributeID string, value RoutingAttributeValue) (RoutingAttributeValue, error)
+		UpdateRoutingAttributeValue(ctx context.Context, attributeID, valueID string, value RoutingAttributeValue) (RoutingAttributeValue, error)
+		DeleteRoutingAttributeValue(ctx context.Context, attributeID, valueID string) error
+		GetAgentAttributeValues(ctx context.Context, userID int64) ([]RoutingAttributeValue, error)
+		SetAgentAttributeValues(ctx context.Context, userID int64, valueIDs []string) ([]RoutingAttributeValue, error)
+		GetTicketAttributeValues(ctx context.Context, ticketID int64) ([]RoutingAttributeValue, error)
+		SetTicketAttributeValues(ctx context.Context, ticketID int64, valueIDs []string) ([]RoutingAttributeValue, error)
+		GetRoutingRequirementsFulfilled(ctx context.Context, ticketIDs []int64) ([]int64, error)
+	}
+)
+
+// GetRoutingAttributes fetches all routing attributes
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/skill_based_routing/#list-account-attributes
+func (z *Client) GetRoutingAttributes(ctx context.Context) ([]RoutingAttribute, error) {
+	var result struct {
+		Attributes []RoutingAttribute `json:"attributes"`
+	}
+
+	body, err := z.get(ctx, "/routing/attributes.json")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, err
+	}
+	return result.Attributes, nil
+}
+
+// GetRoutingAttribute gets the specified routing attribute
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/skill_based_routing/#show-attribute
+func (z *Client) GetRoutingAttribute(ctx context.Context, attributeID string) (RoutingAttribute, error) {
+	var result struct {
+		Attribute RoutingAttribute `json:"attribute"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/routing/attributes/%s.json", attributeID))
+	if err != nil {
+		return RoutingAttribute{}, err
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return RoutingAttribute{}, err
+	}
+	return result.Attribute, nil
+}
+
+// CreateRoutingAttribute creates new routing attribute
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/skill_based_routing/#create-attribute
+func (z *Client) CreateRoutingAttribute(ctx context.Context, attribute RoutingAttribute) (RoutingAttribute, error) {
+	var data, result struct {
+		Attribute RoutingAttribute `json:"attribute"`
+	}
+	data.Attribute = attribute
+
+	body, err := z.post(ctx, "/routing/attributes.json", data)
+	if err != nil {
+		return RoutingAttribute{}, err
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return RoutingAttribute{}, err
+	}
+	return result.Attribute, nil
+}
+
+// UpdateRoutingAttribute updates the specified routing attribute
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/skill_based_routing/#update-attribute
+func (z *Client) UpdateRoutingAttribute(ctx context.Context, attributeID string, attribute RoutingAttribute) (RoutingAttribute, error) {
+	var data, result struct {
+		Attribute RoutingAttribute `json:"attribute"`
+	}
+	data.Attribute = attribute
+
+	body, err := z.put(ctx, fmt.Sprintf("/routing/attributes/%s.json", attributeID), data)
+	if err != nil {
+		return RoutingAttribute{}, err
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return RoutingAttribute{}, err
+	}
+	return result.Attribute, nil
+}
+
+// DeleteRoutingAttribute deletes the specified routing attribute
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/skill_based_routing/#delete-attribute
+func (z *Client) DeleteRoutingAttribute(ctx context.Context, attributeID string) error {
+	err := z.delete(ctx, fmt.Sprintf("/routing/attributes/%s.json", attributeID))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetRoutingAttributeDefinitions fetches the condition definitions available for attribute values
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/skill_based_routing/#list-routing-attribute-definitions
+func (z *Client) GetRoutingAttributeDefinitions(ctx context.Context) (RoutingAttributeDefinitions, error) {
+	var result struct {
+		Definitions RoutingAttributeDefinitions `json:"definitions"`
+	}
+
+	body, err := z.get(ctx, "/routing/attributes/definitions.json")
+	if err != nil {
+		return RoutingAttributeDefinitions{}, err
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return RoutingAttributeDefinitions{}, err
+	}
+	return result.Definitions, nil
+}
+
+// GetRoutingAttributeValues fetches the values of the specified routing attribute
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/skill_based_routing/#list-attribute-values-for-an-attribute
+func (z *Client) GetRoutingAttributeValues(ctx context.Context, attributeID string) ([]RoutingAttributeValue, error) {
+	var result struct {
+		AttributeValues []RoutingAttributeValue `json:"attribute_values"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/routing/attributes/%s/values.json", attributeID))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, err
+	}
+	return result.AttributeValues, nil
+}
+
+// GetRoutingAttributeValue gets the specified value of a routing attribute
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/skill_based_routing/#show-attribute-value
+func (z *Client) GetRoutingAttributeValue(ctx context.Context, attributeID, valueID string) (RoutingAttributeValue, error) {
+	var result struct {
+		AttributeValue RoutingAttributeValue `json:"attribute_value"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/routing/attributes/%s/values/%s.json", attributeID, valueID))
+	if err != nil {
+		return RoutingAttributeValue{}, err
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return RoutingAttributeValue{}, err
+	}
+	return result.AttributeValue, nil
+}
+
+// CreateRoutingAttributeValue creates new value of the specified routing attribute
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/skill_based_routing/#create-attribute-value
+func (z *Client) CreateRoutingAttributeValue(ctx context.Context, attributeID string, value RoutingAttributeValue) (RoutingAttributeValue, error) {
+	var data, result struct {
+		AttributeValue RoutingAttributeValue `json:"attribute_value"`
+	}
+	data.AttributeValue = value
+
+	body, err := z.post(ctx, fmt.Sprintf("/routing/attributes/%s/values.json", attributeID), data)
+	if err != nil {
+		return RoutingAttributeValue{}, err
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return RoutingAttributeValue{}, err
+	}
+	return result.AttributeValue, nil
+}
+
+// UpdateRoutingAttributeValue updates the specified value of a routing attribute
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/skill_based_routing/#update-attribute-value
+func (z *Client) UpdateRoutingAttributeValue(ctx context.Context, attributeID, valueID string, value RoutingAttributeValue) (RoutingAttributeValue, error) {
+	var data, result struct {
+		AttributeValue RoutingAttributeValue `json:"attribute_value"`
+	}
+	data.AttributeValue = value
+
+	body, err := z.put(ctx, fmt.Sprintf("/routing/attributes/%s/values/%s.json", attributeID, valueID), data)
+	if err != nil {
+		return RoutingAttributeValue{}, err
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return RoutingAttributeValue{}, err
+	}
+	return result.AttributeValue, nil
+}
+
+// DeleteRoutingAttributeValue deletes the specified value of a routing attribute
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/skill_based_routing/#delete-attribute-value
+func (z *Client) DeleteRoutingAttributeValue(ctx context.Context, attributeID, valueID string) error {
+	err := z.delete(ctx, fmt.Sprintf("/routing/attributes/%s/values/%s.json", attributeID, valueID))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetAgentAttributeValues fetches the skills assigned to the specified agent
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/skill_based_routing/#list-agent-attribute-values
+func (z *Client) GetAgentAttributeValues(ctx context.Context, userID int64) ([]RoutingAttributeValue, error) {
+	return z.getInstanceValues(ctx, fmt.Sprintf("/routing/agents/%d/instance_values.json", userID))
+}
+
+// SetAgentAttributeValues replaces the skills of the specified agent with the given attribute values
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/skill_based_routing/#set-agent-attribute-values
+func (z *Client) SetAgentAttributeValues(ctx context.Context, userID int64, valueIDs []string) ([]RoutingAttributeValue, error) {
+	return z.setInstanceValues(ctx, fmt.Sprintf("/routing/agents/%d/instance_values.json", userID), valueIDs)
+}
+
+// GetTicketAttributeValues fetches the skills required by the specified ticket
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/skill_based_routing/#list-ticket-attribute-values
+func (z *Client) GetTicketAttributeValues(ctx context.Context, ticketID int64) ([]RoutingAttributeValue, error) {
+	return z.getInstanceValues(ctx, fmt.Sprintf("/routing/tickets/%d/instance_values.json", ticketID))
+}
+
+// SetTicketAttributeValues replaces the skills required by the specified ticket with the given attribute values
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/skill_based_routing/#set-ticket-attribute-values
+func (z *Client) SetTicketAttributeValues(ctx context.Context, ticketID int64, valueIDs []string) ([]RoutingAttributeValue, error) {
+	return z.setInstanceValues(ctx, fmt.Sprintf("/routing/tickets/%d/instance_values.json", ticketID), valueIDs)
+}
+
+// GetRoutingRequirementsFulfilled returns the IDs of the given tickets whose
+// skill requirements are fulfilled by the current agent
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/skill_based_routing/#list-tickets-fulfilled-by-a-user
+func (z *Client) GetRoutingRequirementsFulfilled(ctx context.Context, ticketIDs []int64) ([]int64, error) {
+	var result RoutingRequirementsFulfilled
+
+	var req struct {
+		TicketIDs string `url:"ticket_ids,omitempty"`
+	}
+	idStrs := make([]string, len(ticketIDs))
+	for i := 0; i < len(ticketIDs); i++ {
+		idStrs[i] = strconv.FormatInt(ticketIDs[i], 10)
+	}
+	req.TicketIDs = strings.Join(idStrs, ",")
+
+	u, err := addOptions("/routing/requirements/fulfilled.json", req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, err
+	}
+	return result.FulfilledTicketIDs, nil
+}
+
+func (z *Client) getInstanceValues(ctx context.Context, path string) ([]RoutingAttributeValue, error) {
+	var result struct {
+		AttributeValues []RoutingAttributeValue `json:"attribute_values"`
+	}
+
+	body, err := z.get(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, err
+	}
+	return result.AttributeValues, nil
+}
+
+func (z *Client) setInstanceValues(ctx context.Context, path string, valueIDs []string) ([]RoutingAttributeValue, error) {
+	var data struct {
+		AttributeValueIDs []string `json:"attribute_value_ids"`
+	}
+	var result struct {
+		AttributeValues []RoutingAttributeValue `json:"attribute_values"`
+	}
+	data.AttributeValueIDs = valueIDs
+	if data.AttributeValueIDs == nil {
+		data.AttributeValueIDs = []string{}
+	}
+
+	body, err := z.post(ctx, path, data)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, err
+	}
+	return result.AttributeValues, nil
+}
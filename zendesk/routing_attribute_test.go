@@ -0,0 +1,241 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+)
+
+func TestGetRoutingAttributes(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "routing_attributes.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	attributes, err := client.GetRoutingAttributes(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get routing attributes: %s", err)
+	}
+
+	if len(attributes) != 2 {
+		t.Fatalf("expected length of routing attributes is 2, but got %d", len(attributes))
+	}
+}
+
+func TestGetRoutingAttribute(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "routing_attribute.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	attribute, err := client.GetRoutingAttribute(ctx, "15821cba-7326-11e8-b07e-950ba849aa27")
+	if err != nil {
+		t.Fatalf("Failed to get routing attribute: %s", err)
+	}
+
+	if attribute.Name != "Language" {
+		t.Fatalf("expected routing attribute name is Language, but got %s", attribute.Name)
+	}
+}
+
+func TestCreateRoutingAttribute(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodPost, "routing_attribute.json", http.StatusCreated)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.CreateRoutingAttribute(ctx, RoutingAttribute{Name: "Language"})
+	if err != nil {
+		t.Fatalf("Failed to send request to create routing attribute: %s", err)
+	}
+}
+
+func TestUpdateRoutingAttribute(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodPut, "routing_attribute.json", http.StatusOK)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.UpdateRoutingAttribute(ctx, "15821cba-7326-11e8-b07e-950ba849aa27", RoutingAttribute{Name: "Language"})
+	if err != nil {
+		t.Fatalf("Failed to send request to update routing attribute: %s", err)
+	}
+}
+
+func TestDeleteRoutingAttribute(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+		w.Write(nil)
+	}))
+
+	c := newTestClient(mockAPI)
+	err := c.DeleteRoutingAttribute(ctx, "15821cba-7326-11e8-b07e-950ba849aa27")
+	if err != nil {
+		t.Fatalf("Failed to delete routing attribute: %s", err)
+	}
+}
+
+func TestGetRoutingAttributeDefinitions(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "routing_attribute_definitions.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	definitions, err := client.GetRoutingAttributeDefinitions(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get routing attribute definitions: %s", err)
+	}
+
+	if len(definitions.ConditionsAny) != 2 {
+		t.Fatalf("expected length of conditions_any is 2, but got %d", len(definitions.ConditionsAny))
+	}
+}
+
+func TestGetRoutingAttributeValues(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "routing_attribute_values.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	values, err := client.GetRoutingAttributeValues(ctx, "15821cba-7326-11e8-b07e-950ba849aa27")
+	if err != nil {
+		t.Fatalf("Failed to get routing attribute values: %s", err)
+	}
+
+	if len(values) != 2 {
+		t.Fatalf("expected length of routing attribute values is 2, but got %d", len(values))
+	}
+}
+
+func TestGetRoutingAttributeValue(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "routing_attribute_value.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	value, err := client.GetRoutingAttributeValue(ctx, "15821cba-7326-11e8-b07e-950ba849aa27", "b376b35a-e38b-11e8-a292-e3b6377c5575")
+	if err != nil {
+		t.Fatalf("Failed to get routing attribute value: %s", err)
+	}
+
+	if value.Conditions == nil || len(value.Conditions.All) != 1 {
+		t.Fatalf("expected routing attribute value to have 1 condition, but got %v", value.Conditions)
+	}
+}
+
+func TestCreateRoutingAttributeValue(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodPost, "routing_attribute_value.json", http.StatusCreated)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.CreateRoutingAttributeValue(ctx, "15821cba-7326-11e8-b07e-950ba849aa27", RoutingAttributeValue{Name: "Japanese"})
+	if err != nil {
+		t.Fatalf("Failed to send request to create routing attribute value: %s", err)
+	}
+}
+
+func TestUpdateRoutingAttributeValue(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodPut, "routing_attribute_value.json", http.StatusOK)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.UpdateRoutingAttributeValue(ctx, "15821cba-7326-11e8-b07e-950ba849aa27", "b376b35a-e38b-11e8-a292-e3b6377c5575", RoutingAttributeValue{Name: "Japanese"})
+	if err != nil {
+		t.Fatalf("Failed to send request to update routing attribute value: %s", err)
+	}
+}
+
+func TestDeleteRoutingAttributeValue(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+		w.Write(nil)
+	}))
+
+	c := newTestClient(mockAPI)
+	err := c.DeleteRoutingAttributeValue(ctx, "15821cba-7326-11e8-b07e-950ba849aa27", "b376b35a-e38b-11e8-a292-e3b6377c5575")
+	if err != nil {
+		t.Fatalf("Failed to delete routing attribute value: %s", err)
+	}
+}
+
+func TestGetAgentAttributeValues(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "routing_attribute_values.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	values, err := client.GetAgentAttributeValues(ctx, 369531345753)
+	if err != nil {
+		t.Fatalf("Failed to get agent attribute values: %s", err)
+	}
+
+	if len(values) != 2 {
+		t.Fatalf("expected length of agent attribute values is 2, but got %d", len(values))
+	}
+}
+
+func TestSetAgentAttributeValues(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data struct {
+			AttributeValueIDs []string `json:"attribute_value_ids"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil || len(data.AttributeValueIDs) != 2 {
+			t.Errorf("unexpected request body: %v", err)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodPost, "routing_attribute_values.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	values, err := client.SetAgentAttributeValues(ctx, 369531345753, []string{
+		"b376b35a-e38b-11e8-a292-e3b6377c5575",
+		"fa1131e2-e38b-11e8-a292-e3b6377c5575",
+	})
+	if err != nil {
+		t.Fatalf("Failed to set agent attribute values: %s", err)
+	}
+
+	if len(values) != 2 {
+		t.Fatalf("expected length of agent attribute values is 2, but got %d", len(values))
+	}
+}
+
+func TestGetTicketAttributeValues(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "routing_attribute_values.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	values, err := client.GetTicketAttributeValues(ctx, 2)
+	if err != nil {
+		t.Fatalf("Failed to get ticket attribute values: %s", err)
+	}
+
+	if len(values) != 2 {
+		t.Fatalf("expected length of ticket attribute values is 2, but got %d", len(values))
+	}
+}
+
+func TestSetTicketAttributeValues(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodPost, "routing_attribute_values.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.SetTicketAttributeValues(ctx, 2, []string{"b376b35a-e38b-11e8-a292-e3b6377c5575"})
+	if err != nil {
+		t.Fatalf("Failed to set ticket attribute values: %s", err)
+	}
+}
+
+func TestGetRoutingRequirementsFulfilled(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if ids := r.URL.Query().Get("ticket_ids"); ids != "1,2,17" {
+			t.Errorf("unexpected ticket_ids query: %s", ids)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "routing_requirements_fulfilled.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	ids, err := client.GetRoutingRequirementsFulfilled(ctx, []int64{1, 2, 17})
+	if err != nil {
+		t.Fatalf("Failed to get fulfilled tickets: %s", err)
+	}
+
+	if len(ids) != 2 {
+		t.Fatalf("expected length of fulfilled tickets is 2, but got %d", len(ids))
+	}
+}
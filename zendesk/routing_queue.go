@@ -0,0 +1,194 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+type (
+	// RoutingQueue is struct for omnichannel routing queue payload.
+	// Work items matching Definition are routed to agents of the primary groups
+	// first, then to agents of the secondary groups.
+	// https://developer.zendesk.com/api-reference/agent-availability/omnichannel-routing-queues/
+	RoutingQueue struct {
+		ID          string                 `json:"id,omitempty"`
+		URL         string                 `json:"url,omitempty"`
+		Name        string                 `json:"name"`
+		Description string                 `json:"description,omitempty"`
+		Order       int64                  `json:"order,omitempty"`
+		Priority    int64                  `json:"priority,omitempty"`
+		Definition  RoutingQueueDefinition `json:"definition"`
+
+		// PrimaryGroups and SecondaryGroups are returned by the API
+		PrimaryGroups   *RoutingQueueGroups `json:"primary_groups,omitempty"`
+		SecondaryGroups *RoutingQueueGroups `json:"secondary_groups,omitempty"`
+
+		// PrimaryGroupIDs and SecondaryGroupIDs are used to create or update a queue
+		PrimaryGroupIDs   []int64 `json:"primary_groups_id,omitempty"`
+		SecondaryGroupIDs []int64 `json:"secondary_groups_id,omitempty"`
+
+		CreatedAt time.Time `json:"created_at,omitempty"`
+		UpdatedAt time.Time `json:"updated_at,omitempty"`
+	}
+
+	// RoutingQueueDefinition is the set of conditions which route a work item to the queue
+	RoutingQueueDefinition struct {
+		All []TriggerCondition `json:"all"`
+		Any []TriggerCondition `json:"any"`
+	}
+
+	// RoutingQueueGroups is the list of groups assigned to a queue
+	RoutingQueueGroups struct {
+		Count  int64               `json:"count"`
+		Groups []RoutingQueueGroup `json:"groups"`
+	}
+
+	// RoutingQueueGroup is a group assigned to a queue
+	RoutingQueueGroup struct {
+		ID   int64  `json:"id"`
+		Name string `json:"name"`
+	}
+
+	// RoutingQueueDefinitions is the list of conditions available for queue definitions
+	RoutingQueueDefinitions struct {
+		All []RoutingQueueConditionDefinition `json:"all"`
+		Any []RoutingQueueConditionDefinition `json:"any"`
+	}
+
+	// RoutingQueueConditionDefinition describes a condition available for queue definitions
+	RoutingQueueConditionDefinition struct {
+		Title      string                        `json:"title"`
+		Subject    string                        `json:"subject"`
+		Type       string                        `json:"type"`
+		Group      string                        `json:"group"`
+		Nullable   bool                          `json:"nullable"`
+		Repeatable bool                          `json:"repeatable"`
+		Operators  []RoutingQueueValueDefinition `json:"operators,omitempty"`
+		Values     []RoutingQueueValueDefinition `json:"values,omitempty"`
+	}
+
+	// RoutingQueueValueDefinition is an operator or a value available for a queue condition
+	RoutingQueueValueDefinition struct {
+		Title   string `json:"title"`
+		Value   string `json:"value"`
+		Enabled bool   `json:"enabled"`
+	}
+
+	// RoutingQueueAPI is an interface containing omnichannel routing queue related methods
+	RoutingQueueAPI interface {
+		GetRoutingQueues(ctx context.Context) ([]RoutingQueue, error)
+		GetRoutingQueue(ctx context.Context, queueID string) (RoutingQueue, error)
+		CreateRoutingQueue(ctx context.Context, queue RoutingQueue) (RoutingQueue, error)
+		UpdateRoutingQueue(ctx context.Context, queueID string, queue RoutingQueue) (RoutingQueue, error)
+		DeleteRoutingQueue(ctx context.Context, queueID string) error
+		GetRoutingQueueDefinitions(ctx context.Context) (RoutingQueueDefinitions, error)
+		GetRoutingQueuesIterator(ctx context.Context, opts *PaginationOptions) *Iterator[RoutingQueue]
+		GetRoutingQueuesOBP(ctx context.Context, opts *OBPOptions) ([]RoutingQueue, Page, error)
+		GetRoutingQueuesCBP(ctx context.Context, opts *CBPOptions) ([]RoutingQueue, CursorPaginationMeta, error)
+	}
+)
+
+// GetRoutingQueues fetches all omnichannel routing queues
+// ref: https://developer.zendesk.com/api-reference/agent-availability/omnichannel-routing-queues/#list-queues
+func (z *Client) GetRoutingQueues(ctx context.Context) ([]RoutingQueue, error) {
+	var result struct {
+		Queues []RoutingQueue `json:"queues"`
+	}
+
+	body, err := z.get(ctx, "/routing/queues.json")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, err
+	}
+	return result.Queues, nil
+}
+
+// GetRoutingQueue gets the specified routing queue
+// ref: https://developer.zendesk.com/api-reference/agent-availability/omnichannel-routing-queues/#show-queue
+func (z *Client) GetRoutingQueue(ctx context.Context, queueID string) (RoutingQueue, error) {
+	var result struct {
+		Queue RoutingQueue `json:"queue"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/routing/queues/%s.json", queueID))
+	if err != nil {
+		return RoutingQueue{}, err
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return RoutingQueue{}, err
+	}
+	return result.Queue, nil
+}
+
+// CreateRoutingQueue creates new routing queue
+// ref: https://developer.zendesk.com/api-reference/agent-availability/omnichannel-routing-queues/#create-queue
+func (z *Client) CreateRoutingQueue(ctx context.Context, queue RoutingQueue) (RoutingQueue, error) {
+	var data, result struct {
+		Queue RoutingQueue `json:"queue"`
+	}
+	data.Queue = queue
+
+	body, err := z.post(ctx, "/routing/queues.json", data)
+	if err != nil {
+		return RoutingQueue{}, err
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return RoutingQueue{}, err
+	}
+	return result.Queue, nil
+}
+
+// UpdateRoutingQueue updates the specified routing queue
+// ref: https://developer.zendesk.com/api-reference/agent-availability/omnichannel-routing-queues/#update-queue
+func (z *Client) UpdateRoutingQueue(ctx context.Context, queueID string, queue RoutingQueue) (RoutingQueue, error) {
+	var data, result struct {
+		Queue RoutingQueue `json:"queue"`
+	}
+	data.Queue = queue
+
+	body, err := z.put(ctx, fmt.Sprintf("/routing/queues/%s.json", queueID), data)
+	if err != nil {
+		return RoutingQueue{}, err
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return RoutingQueue{}, err
+	}
+	return result.Queue, nil
+}
+
+// DeleteRoutingQueue deletes the specified routing queue
+// ref: https://developer.zendesk.com/api-reference/agent-availability/omnichannel-routing-queues/#delete-queue
+func (z *Client) DeleteRoutingQueue(ctx context.Context, queueID string) error {
+	err := z.delete(ctx, fmt.Sprintf("/routing/queues/%s.json", queueID))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetRoutingQueueDefinitions fetches the conditions available for queue definitions
+// ref: https://developer.zendesk.com/api-reference/agent-availability/omnichannel-routing-queues/#list-queue-definitions
+func (z *Client) GetRoutingQueueDefinitions(ctx context.Context) (RoutingQueueDefinitions, error) {
+	var result struct {
+		Definitions RoutingQueueDefinitions `json:"definitions"`
+	}
+
+	body, err := z.get(ctx, "/routing/queues/definitions.json")
+	if err != nil {
+		return RoutingQueueDefinitions{}, err
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return RoutingQueueDefinitions{}, err
+	}
+	return result.Definitions, nil
+}
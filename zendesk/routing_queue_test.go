@@ -0,0 +1,118 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetRoutingQueues(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "routing_queues.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	queues, err := client.GetRoutingQueues(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get routing queues: %s", err)
+	}
+
+	if len(queues) != 1 {
+		t.Fatalf("expected length of routing queues is 1, but got %d", len(queues))
+	}
+
+	if queues[0].PrimaryGroups == nil || queues[0].PrimaryGroups.Groups[0].ID != 6784729637757 {
+		t.Fatalf("routing queue does not have expected primary groups: %v", queues[0].PrimaryGroups)
+	}
+}
+
+func TestGetRoutingQueuesIterator(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "routing_queues.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	it := client.GetRoutingQueuesIterator(ctx, NewPaginationOptions())
+
+	queuesCount := 0
+	for it.HasMore() {
+		queues, err := it.GetNext()
+		if err != nil {
+			t.Fatalf("Failed to get routing queues: %s", err)
+		}
+		queuesCount += len(queues)
+	}
+	if queuesCount != 1 {
+		t.Fatalf("expected length of routing queues is 1, but got %d", queuesCount)
+	}
+}
+
+func TestGetRoutingQueue(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "routing_queue.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	queue, err := client.GetRoutingQueue(ctx, "01HG80ATNNZK1N7XRFVKX48XD6")
+	if err != nil {
+		t.Fatalf("Failed to get routing queue: %s", err)
+	}
+
+	if len(queue.Definition.All) != 1 {
+		t.Fatalf("expected length of queue definition is 1, but got %d", len(queue.Definition.All))
+	}
+}
+
+func TestCreateRoutingQueue(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodPost, "routing_queue.json", http.StatusCreated)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.CreateRoutingQueue(ctx, RoutingQueue{
+		Name:     "Priority Tickets",
+		Priority: 1,
+		Definition: RoutingQueueDefinition{
+			All: []TriggerCondition{{Field: "priority", Operator: "is", Value: "urgent"}},
+		},
+		PrimaryGroupIDs: []int64{6784729637757},
+	})
+	if err != nil {
+		t.Fatalf("Failed to send request to create routing queue: %s", err)
+	}
+}
+
+func TestUpdateRoutingQueue(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodPut, "routing_queue.json", http.StatusOK)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.UpdateRoutingQueue(ctx, "01HG80ATNNZK1N7XRFVKX48XD6", RoutingQueue{Name: "Priority Tickets"})
+	if err != nil {
+		t.Fatalf("Failed to send request to update routing queue: %s", err)
+	}
+}
+
+func TestDeleteRoutingQueue(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+		w.Write(nil)
+	}))
+
+	c := newTestClient(mockAPI)
+	err := c.DeleteRoutingQueue(ctx, "01HG80ATNNZK1N7XRFVKX48XD6")
+	if err != nil {
+		t.Fatalf("Failed to delete routing queue: %s", err)
+	}
+}
+
+func TestGetRoutingQueueDefinitions(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "routing_queue_definitions.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	definitions, err := client.GetRoutingQueueDefinitions(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get routing queue definitions: %s", err)
+	}
+
+	if len(definitions.All) != 1 || len(definitions.All[0].Operators) != 1 {
+		t.Fatalf("unexpected routing queue definitions: %v", definitions)
+	}
+}
@@ -0,0 +1,127 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Section is struct for Help Center section payload
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/sections/
+type Section struct {
+	ID              int64      `json:"id,omitempty"`
+	URL             string     `json:"url,omitempty"`
+	HTMLURL         string     `json:"html_url,omitempty"`
+	Name            string     `json:"name"`
+	Description     string     `json:"description,omitempty"`
+	Locale          string     `json:"locale,omitempty"`
+	SourceLocale    string     `json:"source_locale,omitempty"`
+	CategoryID      int64      `json:"category_id,omitempty"`
+	ParentSectionID *int64     `json:"parent_section_id,omitempty"`
+	Position        int64      `json:"position,omitempty"`
+	Sorting         string     `json:"sorting,omitempty"`
+	ThemeTemplate   string     `json:"theme_template,omitempty"`
+	Outdated        bool       `json:"outdated,omitempty"`
+	CreatedAt       *time.Time `json:"created_at,omitempty"`
+	UpdatedAt       *time.Time `json:"updated_at,omitempty"`
+}
+
+// SectionAPI an interface containing all Help Center section related methods
+type SectionAPI interface {
+	GetSection(ctx context.Context, locale int, sectionID int64) (Section, error)
+	CreateSection(ctx context.Context, locale int, categoryID int64, section Section) (Section, error)
+	UpdateSection(ctx context.Context, locale int, sectionID int64, section Section) (Section, error)
+	DeleteSection(ctx context.Context, sectionID int64) error
+	GetSectionsIterator(ctx context.Context, locale int, opts *PaginationOptions) *Iterator[Section]
+	GetSectionsOBP(ctx context.Context, locale int, opts *OBPOptions) ([]Section, Page, error)
+	GetSectionsCBP(ctx context.Context, locale int, opts *CBPOptions) ([]Section, CursorPaginationMeta, error)
+	GetSectionsByCategoryIterator(ctx context.Context, locale int, opts *PaginationOptions) *Iterator[Section]
+	GetSectionsByCategoryOBP(ctx context.Context, locale int, opts *OBPOptions) ([]Section, Page, error)
+	GetSectionsByCategoryCBP(ctx context.Context, locale int, opts *CBPOptions) ([]Section, CursorPaginationMeta, error)
+}
+
+// GetSection gets the specified section in the locale, or in the default locale if it's zero
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/sections/#show-section
+func (z *Client) GetSection(ctx context.Context, locale int, sectionID int64) (Section, error) {
+	var result struct {
+		Section Section `json:"section"`
+	}
+
+	path, err := helpCenterPath(locale, fmt.Sprintf("/sections/%d.json", sectionID))
+	if err != nil {
+		return Section{}, err
+	}
+
+	body, err := z.get(ctx, path)
+	if err != nil {
+		return Section{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Section{}, err
+	}
+	return result.Section, nil
+}
+
+// CreateSection creates a section in the category. The name and the description are those of the locale.
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/sections/#create-section
+func (z *Client) CreateSection(ctx context.Context, locale int, categoryID int64, section Section) (Section, error) {
+	var data, result struct {
+		Section Section `json:"section"`
+	}
+	data.Section = section
+
+	path, err := helpCenterPath(locale, fmt.Sprintf("/categories/%d/sections.json", categoryID))
+	if err != nil {
+		return Section{}, err
+	}
+
+	body, err := z.post(ctx, path, data)
+	if err != nil {
+		return Section{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Section{}, err
+	}
+	return result.Section, nil
+}
+
+// UpdateSection updates the specified section
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/sections/#update-section
+func (z *Client) UpdateSection(ctx context.Context, locale int, sectionID int64, section Section) (Section, error) {
+	var data, result struct {
+		Section Section `json:"section"`
+	}
+	data.Section = section
+
+	path, err := helpCenterPath(locale, fmt.Sprintf("/sections/%d.json", sectionID))
+	if err != nil {
+		return Section{}, err
+	}
+
+	body, err := z.put(ctx, path, data)
+	if err != nil {
+		return Section{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Section{}, err
+	}
+	return result.Section, nil
+}
+
+// DeleteSection deletes the specified section and all its articles
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/sections/#delete-section
+func (z *Client) DeleteSection(ctx context.Context, sectionID int64) error {
+	return z.delete(ctx, fmt.Sprintf("/help_center/sections/%d.json", sectionID))
+}
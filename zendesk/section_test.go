@@ -0,0 +1,64 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+)
+
+func TestGetSection(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "section.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	section, err := client.GetSection(ctx, 0, 360000123456)
+	if err != nil {
+		t.Fatalf("Failed to get section: %s", err)
+	}
+
+	if section.ID != 360000123456 || section.CategoryID != 360000054321 {
+		t.Fatalf("unexpected section %v", section)
+	}
+}
+
+func TestGetSectionsByCategoryCBP(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/help_center/categories/360000054321/sections.json" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write(readFixture(filepath.Join(http.MethodGet, "sections.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	sections, _, err := client.GetSectionsByCategoryCBP(ctx, 0, &CBPOptions{
+		CommonOptions: CommonOptions{
+			Id: 360000054321,
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to get sections of category: %s", err)
+	}
+
+	if len(sections) != 2 {
+		t.Fatalf("expected length of sections is 2, but got %d", len(sections))
+	}
+	if sections[1].ParentSectionID == nil || *sections[1].ParentSectionID != 360000123456 {
+		t.Fatalf("unexpected parent section of %v", sections[1])
+	}
+}
+
+func TestDeleteSection(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+		w.Write(nil)
+	}))
+
+	c := newTestClient(mockAPI)
+	err := c.DeleteSection(ctx, 360000123456)
+	if err != nil {
+		t.Fatalf("Failed to delete section: %s", err)
+	}
+}
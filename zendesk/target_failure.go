@@ -0,0 +1,69 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// TargetFailure is struct for target failure payload.
+// RawRequest and RawResponse are only returned by GetTargetFailure.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/targets/target_failures/
+type TargetFailure struct {
+	ID                      int64      `json:"id"`
+	URL                     string     `json:"url,omitempty"`
+	TargetName              string     `json:"target_name"`
+	StatusCode              int        `json:"status_code"`
+	ConsecutiveFailureCount int        `json:"consecutive_failure_count"`
+	RawRequest              string     `json:"raw_request,omitempty"`
+	RawResponse             string     `json:"raw_response,omitempty"`
+	CreatedAt               *time.Time `json:"created_at,omitempty"`
+}
+
+// TargetFailureAPI an interface containing all target failure related methods
+type TargetFailureAPI interface {
+	GetTargetFailures(ctx context.Context) ([]TargetFailure, error)
+	GetTargetFailure(ctx context.Context, id int64) (TargetFailure, error)
+}
+
+// GetTargetFailures fetches the 25 most recent failures of each target. The endpoint isn't paginated.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/targets/target_failures/#list-target-failures
+func (z *Client) GetTargetFailures(ctx context.Context) ([]TargetFailure, error) {
+	var result struct {
+		TargetFailures []TargetFailure `json:"target_failures"`
+	}
+
+	body, err := z.get(ctx, "/target_failures.json")
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.TargetFailures, nil
+}
+
+// GetTargetFailure gets a specified target failure with the raw request and response
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/targets/target_failures/#show-target-failure
+func (z *Client) GetTargetFailure(ctx context.Context, id int64) (TargetFailure, error) {
+	var result struct {
+		TargetFailure TargetFailure `json:"target_failure"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/target_failures/%d.json", id))
+	if err != nil {
+		return TargetFailure{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return TargetFailure{}, err
+	}
+	return result.TargetFailure, nil
+}
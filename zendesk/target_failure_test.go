@@ -0,0 +1,39 @@
+package zendesk
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestGetTargetFailures(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "target_failures.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	failures, err := client.GetTargetFailures(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get target failures: %s", err)
+	}
+
+	if len(failures) != 2 {
+		t.Fatalf("expected length of target failures is 2, but got %d", len(failures))
+	}
+	if failures[1].StatusCode != http.StatusUnauthorized || failures[1].ConsecutiveFailureCount != 2 {
+		t.Fatalf("unexpected target failure %v", failures[1])
+	}
+}
+
+func TestGetTargetFailure(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "target_failure.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	failure, err := client.GetTargetFailure(ctx, 1)
+	if err != nil {
+		t.Fatalf("Failed to get target failure: %s", err)
+	}
+
+	if failure.ID != 1 || failure.RawRequest == "" || failure.RawResponse == "" {
+		t.Fatalf("unexpected target failure %v", failure)
+	}
+}
@@ -0,0 +1,314 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// TargetMigrationOptions is options for MigrateTarget
+type TargetMigrationOptions struct {
+	// DryRun plans the migration without changing the account
+	DryRun bool
+
+	// Password is the password of the target, which isn't returned by the API.
+	// It's required to migrate a target using basic authentication.
+	Password string
+
+	// DeleteTarget deletes the target once the triggers and automations are rewritten
+	DeleteTarget bool
+}
+
+// TargetMigrationRule is a trigger or an automation notifying the migrated target
+type TargetMigrationRule struct {
+	// Type is "trigger" or "automation"
+	Type  string
+	ID    int64
+	Title string
+
+	// Actions is the number of notification_target actions rewritten
+	Actions int
+}
+
+// TargetMigration is the conversion of a HTTP target into a webhook, along with
+// the notification_target actions rewritten into notification_webhook actions
+type TargetMigration struct {
+	Target Target
+
+	// Webhook is the webhook replacing the target. Its ID is empty until the webhook is created.
+	Webhook Webhook
+
+	Rules    []TargetMigrationRule
+	Warnings []string
+
+	// Applied is true once the webhook is created and the rules are rewritten
+	Applied bool
+
+	triggers    []Trigger
+	automations []Automation
+}
+
+// TargetMigrationAPI an interface containing the target migration methods
+type TargetMigrationAPI interface {
+	MigrateTarget(ctx context.Context, targetID int64, opts TargetMigrationOptions) (*TargetMigration, error)
+}
+
+// NewTargetMigration converts the HTTP target into a webhook, and finds the actions
+// of the triggers and automations which notify the target. The account isn't changed.
+func NewTargetMigration(target Target, triggers []Trigger, automations []Automation) (*TargetMigration, error) {
+	if target.Type != "http_target" {
+		return nil, fmt.Errorf("target %d is a %s, only http_target can be migrated", target.ID, target.Type)
+	}
+
+	method := strings.ToUpper(target.Method)
+	if method == "" {
+		return nil, fmt.Errorf("target %d has no method", target.ID)
+	}
+
+	format, ok := webhookRequestFormats[target.ContentType]
+	if !ok && method != "GET" && method != "DELETE" {
+		return nil, fmt.Errorf("target %d has an unsupported content type %q", target.ID, target.ContentType)
+	}
+	if format == "" {
+		format = "json"
+	}
+
+	m := &TargetMigration{
+		Target: target,
+		Webhook: Webhook{
+			Name:          target.Title,
+			Description:   fmt.Sprintf("Migrated from target %d", target.ID),
+			Endpoint:      target.TargetURL,
+			HTTPMethod:    method,
+			RequestFormat: format,
+			Status:        "active",
+			Subscriptions: []string{"conditional_ticket_events"},
+		},
+	}
+	if !target.Active {
+		m.Webhook.Status = "inactive"
+	}
+
+	if target.Username != "" {
+		m.Webhook.Authentication = &WebhookAuthentication{
+			Type:        "basic_auth",
+			Data:        map[string]string{"username": target.Username, "password": target.Password},
+			AddPosition: "header",
+		}
+		if target.Password == "" {
+			m.Warnings = append(m.Warnings, "the target uses basic authentication, but its password is unknown")
+		}
+	}
+
+	for _, trigger := range triggers {
+		_, n, warnings := m.rewriteTrigger(trigger, "")
+		if n == 0 {
+			continue
+		}
+		m.triggers = append(m.triggers, trigger)
+		m.Rules = append(m.Rules, TargetMigrationRule{Type: "trigger", ID: trigger.ID, Title: trigger.Title, Actions: n})
+		m.addWarnings("trigger", trigger.ID, warnings)
+	}
+
+	for _, automation := range automations {
+		_, n, warnings := m.rewriteAutomation(automation, "")
+		if n == 0 {
+			continue
+		}
+		m.automations = append(m.automations, automation)
+		m.Rules = append(m.Rules, TargetMigrationRule{Type: "automation", ID: automation.ID, Title: automation.Title, Actions: n})
+		m.addWarnings("automation", automation.ID, warnings)
+	}
+	return m, nil
+}
+
+// webhookRequestFormats maps the content types of targets to the request formats of webhooks
+var webhookRequestFormats = map[string]string{
+	"application/json":                  "json",
+	"application/xml":                   "xml",
+	"application/x-www-form-urlencoded": "form_encoded",
+}
+
+func (m *TargetMigration) addWarnings(ruleType string, id int64, warnings []string) {
+	for _, w := range warnings {
+		m.Warnings = append(m.Warnings, fmt.Sprintf("%s %d: %s", ruleType, id, w))
+	}
+}
+
+func (m *TargetMigration) rewriteTrigger(trigger Trigger, webhookID string) (Trigger, int, []string) {
+	actions, n, warnings := m.rewriteActions(trigger.Actions, webhookID)
+	trigger.Actions = actions
+	return trigger, n, warnings
+}
+
+func (m *TargetMigration) rewriteAutomation(automation Automation, webhookID string) (Automation, int, []string) {
+	actions := make([]TriggerAction, len(automation.Actions))
+	for i, a := range automation.Actions {
+		actions[i] = TriggerAction(a)
+	}
+
+	actions, n, warnings := m.rewriteActions(actions, webhookID)
+	automation.Actions = make([]AutomationAction, len(actions))
+	for i, a := range actions {
+		automation.Actions[i] = AutomationAction(a)
+	}
+	return automation, n, warnings
+}
+
+// rewriteActions returns a copy of the actions where the notifications of the target
+// are replaced by notifications of the webhook, and the number of actions replaced
+func (m *TargetMigration) rewriteActions(actions []TriggerAction, webhookID string) ([]TriggerAction, int, []string) {
+	var (
+		result   = make([]TriggerAction, len(actions))
+		n        int
+		warnings []string
+	)
+	for i, a := range actions {
+		result[i] = a
+		if a.Field != "notification_target" {
+			continue
+		}
+
+		values, ok := ruleActionStrings(a.Value)
+		if !ok || len(values) == 0 || values[0] != ruleIDString(m.Target.ID) {
+			continue
+		}
+
+		message := ""
+		if len(values) > 1 {
+			message = values[1]
+		}
+		if m.Webhook.HTTPMethod == "GET" || m.Webhook.HTTPMethod == "DELETE" {
+			message = webhookQueryParams(message)
+		}
+		if len(values) > 2 {
+			warnings = append(warnings, "extra values of notification_target action are dropped")
+		}
+
+		result[i] = TriggerAction{Field: "notification_webhook", Value: []string{webhookID, message}}
+		n++
+	}
+	return result, n, warnings
+}
+
+// ruleActionStrings converts a list value of an action, or of a multi-select custom field, to strings
+func ruleActionStrings(value interface{}) ([]string, bool) {
+	switch v := value.(type) {
+	case []string:
+		return v, true
+	case []interface{}:
+		values := make([]string, len(v))
+		for i, item := range v {
+			values[i] = ruleValueString(item)
+		}
+		return values, true
+	}
+	return nil, false
+}
+
+// webhookQueryParams converts the "key=value&..." message of a target using GET or DELETE
+// into the JSON list of key/value pairs used by webhooks. Placeholders are left as is.
+func webhookQueryParams(message string) string {
+	pairs := [][]string{}
+	for _, param := range strings.Split(message, "&") {
+		if param == "" {
+			continue
+		}
+		key, value, _ := strings.Cut(param, "=")
+		pairs = append(pairs, []string{key, value})
+	}
+	data, _ := json.Marshal(pairs)
+	return string(data)
+}
+
+// String returns a report of the migration, listing the webhook, the rules and the warnings
+func (m *TargetMigration) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "target %d %q: %s %s\n", m.Target.ID, m.Target.Title, m.Webhook.HTTPMethod, m.Webhook.Endpoint)
+
+	id := m.Webhook.ID
+	if id == "" {
+		id = "(new)"
+	}
+	fmt.Fprintf(&b, "+ webhook %s %q: %s %s, %s, %s\n",
+		id, m.Webhook.Name, m.Webhook.HTTPMethod, m.Webhook.Endpoint, m.Webhook.RequestFormat, m.Webhook.Status)
+
+	for _, r := range m.Rules {
+		fmt.Fprintf(&b, "~ %s %d %q: %d notification_target action(s) -> notification_webhook\n", r.Type, r.ID, r.Title, r.Actions)
+	}
+	for _, w := range m.Warnings {
+		fmt.Fprintf(&b, "warning: %s\n", w)
+	}
+	switch {
+	case m.Applied:
+	case m.Webhook.ID == "":
+		b.WriteString("dry run: nothing was changed\n")
+	default:
+		b.WriteString("incomplete: the webhook was created, but some rules were not rewritten\n")
+	}
+	return b.String()
+}
+
+// MigrateTarget replaces the HTTP target with a webhook. The webhook is created first,
+// then the notification_target actions of triggers and automations are rewritten
+// into notification_webhook actions. With DryRun, the migration is returned without
+// changing the account, and its String method reports what would be changed.
+//
+// The migration stops at the first error, leaving the rules already rewritten as is.
+func (z *Client) MigrateTarget(ctx context.Context, targetID int64, opts TargetMigrationOptions) (*TargetMigration, error) {
+	target, err := z.GetTarget(ctx, targetID)
+	if err != nil {
+		return nil, err
+	}
+	if opts.Password != "" {
+		target.Password = opts.Password
+	}
+
+	triggers, err := iterateAll(z.GetTriggersIterator(ctx, NewPaginationOptions()))
+	if err != nil {
+		return nil, err
+	}
+	automations, err := iterateAll(z.GetAutomationsIterator(ctx, NewPaginationOptions()))
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := NewTargetMigration(target, triggers, automations)
+	if err != nil {
+		return nil, err
+	}
+	if opts.DryRun {
+		return m, nil
+	}
+	if target.Username != "" && target.Password == "" {
+		return m, fmt.Errorf("target %d uses basic authentication, the password is required", targetID)
+	}
+
+	hook, err := z.CreateWebhook(ctx, &m.Webhook)
+	if err != nil {
+		return m, fmt.Errorf("failed to create webhook: %w", err)
+	}
+	m.Webhook = *hook
+
+	for _, trigger := range m.triggers {
+		trigger, _, _ = m.rewriteTrigger(trigger, hook.ID)
+		if _, err := z.UpdateTrigger(ctx, trigger.ID, trigger); err != nil {
+			return m, fmt.Errorf("failed to update trigger %d: %w", trigger.ID, err)
+		}
+	}
+	for _, automation := range m.automations {
+		automation, _, _ = m.rewriteAutomation(automation, hook.ID)
+		if _, err := z.UpdateAutomation(ctx, automation.ID, automation); err != nil {
+			return m, fmt.Errorf("failed to update automation %d: %w", automation.ID, err)
+		}
+	}
+
+	if opts.DeleteTarget {
+		if err := z.DeleteTarget(ctx, targetID); err != nil {
+			return m, fmt.Errorf("failed to delete target: %w", err)
+		}
+	}
+	m.Applied = true
+	return m, nil
+}
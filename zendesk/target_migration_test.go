@@ -0,0 +1,125 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestNewTargetMigration(t *testing.T) {
+	target := Target{
+		ID:        360000217439,
+		Type:      "http_target",
+		Title:     "target :: http :: postbin",
+		Active:    true,
+		Method:    "get",
+		Username:  "john_smith",
+		TargetURL: "https://postb.in/WERcssi2",
+	}
+	triggers := []Trigger{
+		{ID: 1, Title: "Notify postbin", Actions: []TriggerAction{
+			{Field: "status", Value: "open"},
+			{Field: "notification_target", Value: []interface{}{"360000217439", "ticket_id={{ticket.id}}&status={{ticket.status}}"}},
+		}},
+		{ID: 2, Title: "Notify other target", Actions: []TriggerAction{
+			{Field: "notification_target", Value: []interface{}{"360000217438", "{}"}},
+		}},
+	}
+	automations := []Automation{
+		{ID: 3, Title: "Notify postbin after 24 hours", Actions: []AutomationAction{
+			{Field: "notification_target", Value: []interface{}{float64(360000217439), "ticket_id={{ticket.id}}"}},
+		}},
+	}
+
+	m, err := NewTargetMigration(target, triggers, automations)
+	if err != nil {
+		t.Fatalf("Failed to create migration: %s", err)
+	}
+
+	if m.Webhook.HTTPMethod != http.MethodGet || m.Webhook.RequestFormat != "json" || m.Webhook.Authentication == nil {
+		t.Fatalf("unexpected webhook %v", m.Webhook)
+	}
+	if len(m.Rules) != 2 || m.Rules[0].ID != 1 || m.Rules[1].Type != "automation" {
+		t.Fatalf("unexpected rules %v", m.Rules)
+	}
+	if len(m.Warnings) != 1 {
+		t.Fatalf("expected a warning for the unknown password, but got %v", m.Warnings)
+	}
+
+	trigger, _, _ := m.rewriteTrigger(m.triggers[0], "01EJFTSCC78X5V07NPY2MHR00M")
+	value := trigger.Actions[1].Value.([]string)
+	if trigger.Actions[1].Field != "notification_webhook" || value[0] != "01EJFTSCC78X5V07NPY2MHR00M" ||
+		value[1] != `[["ticket_id","{{ticket.id}}"],["status","{{ticket.status}}"]]` {
+		t.Fatalf("unexpected action %v", trigger.Actions[1])
+	}
+	if triggers[0].Actions[1].Field != "notification_target" {
+		t.Fatalf("original trigger was changed")
+	}
+
+	report := m.String()
+	for _, s := range []string{`~ trigger 1 "Notify postbin"`, "warning:", "dry run"} {
+		if !strings.Contains(report, s) {
+			t.Fatalf("expected report to contain %q, but got\n%s", s, report)
+		}
+	}
+
+	target.Type = "email_target"
+	if _, err := NewTargetMigration(target, nil, nil); err == nil {
+		t.Fatalf("did not get expected error")
+	}
+}
+
+func TestMigrateTarget(t *testing.T) {
+	var updated Trigger
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /targets/360000217439.json":
+			w.Write(readFixture(filepath.Join(http.MethodGet, "target.json")))
+		case "GET /triggers.json":
+			w.Write([]byte(`{"triggers": [{"id": 1, "title": "Notify postbin", "actions": [
+				{"field": "notification_target", "value": ["360000217439", "{\"id\": \"{{ticket.id}}\"}"]}
+			]}], "meta": {"has_more": false}}`))
+		case "GET /automations.json":
+			w.Write([]byte(`{"automations": [], "meta": {"has_more": false}}`))
+		case "POST /webhooks":
+			w.WriteHeader(http.StatusCreated)
+			w.Write(readFixture(filepath.Join(http.MethodPost, "webhooks.json")))
+		case "PUT /triggers/1.json":
+			var data struct {
+				Trigger Trigger `json:"trigger"`
+			}
+			json.NewDecoder(r.Body).Decode(&data)
+			updated = data.Trigger
+			json.NewEncoder(w).Encode(data)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	m, err := client.MigrateTarget(ctx, 360000217439, TargetMigrationOptions{DryRun: true})
+	if err != nil {
+		t.Fatalf("Failed to plan migration: %s", err)
+	}
+	if m.Applied || len(m.Rules) != 1 || updated.ID != 0 {
+		t.Fatalf("dry run changed the account: %s", m)
+	}
+
+	m, err = client.MigrateTarget(ctx, 360000217439, TargetMigrationOptions{})
+	if err != nil {
+		t.Fatalf("Failed to migrate target: %s", err)
+	}
+	if !m.Applied || m.Webhook.ID != "01EJFTSCC78X5V07NPY2MHR00M" {
+		t.Fatalf("unexpected migration %s", m)
+	}
+
+	value, _ := ruleActionStrings(updated.Actions[0].Value)
+	if updated.Actions[0].Field != "notification_webhook" || len(value) != 2 || value[0] != m.Webhook.ID {
+		t.Fatalf("unexpected action %v", updated.Actions[0])
+	}
+}
@@ -56,6 +56,12 @@ type TicketFieldAPI interface {
 	GetTicketFieldsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[TicketField]
 	GetTicketFieldsOBP(ctx context.Context, opts *OBPOptions) ([]TicketField, Page, error)
 	GetTicketFieldsCBP(ctx context.Context, opts *CBPOptions) ([]TicketField, CursorPaginationMeta, error)
+	GetTicketFieldOptionsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[CustomFieldOption]
+	GetTicketFieldOptionsOBP(ctx context.Context, opts *OBPOptions) ([]CustomFieldOption, Page, error)
+	GetTicketFieldOptionsCBP(ctx context.Context, opts *CBPOptions) ([]CustomFieldOption, CursorPaginationMeta, error)
+	GetTicketFieldOption(ctx context.Context, fieldID, optionID int64) (CustomFieldOption, error)
+	CreateOrUpdateTicketFieldOption(ctx context.Context, fieldID int64, option CustomFieldOption) (CustomFieldOption, error)
+	DeleteTicketFieldOption(ctx context.Context, fieldID, optionID int64) error
 }
 
 // GetTicketFields fetches ticket field list
@@ -153,3 +159,27 @@ func (z *Client) DeleteTicketField(ctx context.Context, ticketID int64) error {
 
 	return nil
 }
+
+// GetTicketFieldOption gets the specified option of a ticket field
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/ticket_fields/#show-ticket-field-option
+func (z *Client) GetTicketFieldOption(ctx context.Context, fieldID, optionID int64) (CustomFieldOption, error) {
+	return z.getCustomFieldOption(ctx, fmt.Sprintf("/ticket_fields/%d/options/%d.json", fieldID, optionID))
+}
+
+// CreateOrUpdateTicketFieldOption creates an option of a dropdown or multiselect ticket field.
+// The option is updated instead when its ID is set.
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/ticket_fields/#create-or-update-ticket-field-option
+func (z *Client) CreateOrUpdateTicketFieldOption(ctx context.Context, fieldID int64, option CustomFieldOption) (CustomFieldOption, error) {
+	return z.createOrUpdateCustomFieldOption(ctx, fmt.Sprintf("/ticket_fields/%d/options.json", fieldID), option)
+}
+
+// DeleteTicketFieldOption deletes the specified option of a ticket field
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/ticket_fields/#delete-ticket-field-option
+func (z *Client) DeleteTicketFieldOption(ctx context.Context, fieldID, optionID int64) error {
+	err := z.delete(ctx, fmt.Sprintf("/ticket_fields/%d/options/%d.json", fieldID, optionID))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
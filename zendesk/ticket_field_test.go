@@ -1,8 +1,10 @@
 package zendesk
 
 import (
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"path/filepath"
 	"testing"
 )
 
@@ -76,3 +78,89 @@ func TestDeleteTicketField(t *testing.T) {
 		t.Fatalf("Failed to delete ticket field: %s", err)
 	}
 }
+
+func TestGetTicketFieldOptionsIterator(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/ticket_fields/1/options.json" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "custom_field_options.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	opts := NewPaginationOptions()
+	opts.Id = 1
+	it := client.GetTicketFieldOptionsIterator(ctx, opts)
+
+	var options []CustomFieldOption
+	for it.HasMore() {
+		page, err := it.GetNext()
+		if err != nil {
+			t.Fatalf("Failed to get ticket field options: %s", err)
+		}
+		options = append(options, page...)
+	}
+
+	if len(options) != 2 {
+		t.Fatalf("expected length of ticket field options is 2, but got %d", len(options))
+	}
+}
+
+func TestGetTicketFieldOption(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "custom_field_option.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	option, err := client.GetTicketFieldOption(ctx, 1, 10001)
+	if err != nil {
+		t.Fatalf("Failed to get ticket field option: %s", err)
+	}
+
+	if option.Value != "banana" {
+		t.Fatalf("expected option value is banana, but got %s", option.Value)
+	}
+}
+
+func TestCreateOrUpdateTicketFieldOption(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data struct {
+			CustomFieldOption CustomFieldOption `json:"custom_field_option"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+			t.Errorf("Failed to decode request body: %s", err)
+		}
+		if data.CustomFieldOption.ID != 10001 || data.CustomFieldOption.Position != 1 {
+			t.Errorf("unexpected option: %+v", data.CustomFieldOption)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodPost, "custom_field_option.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.CreateOrUpdateTicketFieldOption(ctx, 1, CustomFieldOption{
+		ID:       10001,
+		Name:     "Banana Pie",
+		Value:    "banana",
+		Position: 1,
+	})
+	if err != nil {
+		t.Fatalf("Failed to update ticket field option: %s", err)
+	}
+}
+
+func TestDeleteTicketFieldOption(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/ticket_fields/1/options/10001.json" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+		w.Write(nil)
+	}))
+
+	c := newTestClient(mockAPI)
+	err := c.DeleteTicketFieldOption(ctx, 1, 10001)
+	if err != nil {
+		t.Fatalf("Failed to delete ticket field option: %s", err)
+	}
+}
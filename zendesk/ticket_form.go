@@ -21,6 +21,79 @@ type TicketForm struct {
 	TicketFieldIDs     []int64 `json:"ticket_field_ids,omitempty"`
 	InAllBrands        bool    `json:"in_all_brands,omitempty"`
 	RestrictedBrandIDs []int64 `json:"restricted_brand_ids,omitempty"`
+
+	// AgentConditions and EndUserConditions show fields depending on the values of other fields.
+	// Use UpdateTicketFormConditions to remove all conditions of a form.
+	AgentConditions   []TicketFormCondition `json:"agent_conditions,omitempty"`
+	EndUserConditions []TicketFormCondition `json:"end_user_conditions,omitempty"`
+}
+
+// Types of TicketFormRequiredOnStatuses
+const (
+	RequiredOnNoStatuses   = "NO_STATUSES"
+	RequiredOnAllStatuses  = "ALL_STATUSES"
+	RequiredOnSomeStatuses = "SOME_STATUSES"
+)
+
+// TicketFormCondition shows the child fields when the parent field has the value.
+// Value is a string for dropdown fields and a bool for checkbox fields.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/ticket_forms/#json-format
+type TicketFormCondition struct {
+	ParentFieldID   int64                           `json:"parent_field_id"`
+	ParentFieldType string                          `json:"parent_field_type,omitempty"`
+	Value           interface{}                     `json:"value"`
+	ChildFields     []TicketFormConditionChildField `json:"child_fields"`
+}
+
+// TicketFormConditionChildField is a field shown by a condition
+type TicketFormConditionChildField struct {
+	ID         int64 `json:"id"`
+	IsRequired bool  `json:"is_required"`
+
+	// RequiredOnStatuses is only available for agent conditions
+	RequiredOnStatuses *TicketFormRequiredOnStatuses `json:"required_on_statuses,omitempty"`
+}
+
+// TicketFormRequiredOnStatuses sets the ticket statuses on which a child field is required
+type TicketFormRequiredOnStatuses struct {
+	Type     string   `json:"type"`
+	Statuses []string `json:"statuses,omitempty"`
+}
+
+// NewTicketFormCondition returns a condition which shows the child fields,
+// which are not required, when the parent field has the value
+func NewTicketFormCondition(parentFieldID int64, value interface{}, childFieldIDs ...int64) TicketFormCondition {
+	children := make([]TicketFormConditionChildField, len(childFieldIDs))
+	for i, id := range childFieldIDs {
+		children[i] = TicketFormConditionChildField{ID: id}
+	}
+	return TicketFormCondition{
+		ParentFieldID: parentFieldID,
+		Value:         value,
+		ChildFields:   children,
+	}
+}
+
+// Require makes the specified child field of the condition required on all statuses
+// or, when statuses are given, on these statuses only. Statuses are ignored by end user conditions.
+func (c TicketFormCondition) Require(childFieldID int64, statuses ...string) TicketFormCondition {
+	children := make([]TicketFormConditionChildField, len(c.ChildFields))
+	copy(children, c.ChildFields)
+	for i := range children {
+		if children[i].ID != childFieldID {
+			continue
+		}
+		children[i].IsRequired = true
+		if len(statuses) > 0 {
+			children[i].RequiredOnStatuses = &TicketFormRequiredOnStatuses{
+				Type:     RequiredOnSomeStatuses,
+				Statuses: statuses,
+			}
+		}
+	}
+	c.ChildFields = children
+	return c
 }
 
 // TicketFormListOptions is options for GetTicketForms
@@ -44,6 +117,10 @@ type TicketFormAPI interface {
 	GetTicketFormsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[TicketForm]
 	GetTicketFormsOBP(ctx context.Context, opts *OBPOptions) ([]TicketForm, Page, error)
 	GetTicketFormsCBP(ctx context.Context, opts *CBPOptions) ([]TicketForm, CursorPaginationMeta, error)
+	GetManyTicketForms(ctx context.Context, ids []int64) ([]TicketForm, error)
+	ReorderTicketForms(ctx context.Context, ids []int64) ([]TicketForm, error)
+	CloneTicketForm(ctx context.Context, id int64, prependCloneTitle bool) (TicketForm, error)
+	UpdateTicketFormConditions(ctx context.Context, id int64, agentConditions, endUserConditions []TicketFormCondition) (TicketForm, error)
 }
 
 // GetTicketForms fetches ticket forms
@@ -146,3 +223,111 @@ func (z *Client) DeleteTicketForm(ctx context.Context, id int64) error {
 
 	return nil
 }
+
+// GetManyTicketForms returns the specified ticket forms
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/ticket_forms/#show-many-ticket-forms
+func (z *Client) GetManyTicketForms(ctx context.Context, ids []int64) ([]TicketForm, error) {
+	var result struct {
+		TicketForms []TicketForm `json:"ticket_forms"`
+	}
+
+	u, err := idsOptions("/ticket_forms/show_many.json", ids)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.TicketForms, nil
+}
+
+// ReorderTicketForms sets the order of ticket forms and returns the reordered forms
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/ticket_forms/#reorder-ticket-forms
+func (z *Client) ReorderTicketForms(ctx context.Context, ids []int64) ([]TicketForm, error) {
+	var data struct {
+		TicketFormIDs []int64 `json:"ticket_form_ids"`
+	}
+	data.TicketFormIDs = ids
+
+	var result struct {
+		TicketForms []TicketForm `json:"ticket_forms"`
+	}
+
+	body, err := z.put(ctx, "/ticket_forms/reorder.json", data)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.TicketForms, nil
+}
+
+// CloneTicketForm clones the specified ticket form and returns the new form.
+// When prependCloneTitle is true, the name of the new form starts with "Clone of".
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/ticket_forms/#clone-an-already-existing-ticket-form
+func (z *Client) CloneTicketForm(ctx context.Context, id int64, prependCloneTitle bool) (TicketForm, error) {
+	var data struct {
+		PrependCloneTitle bool `json:"prepend_clone_title"`
+	}
+	data.PrependCloneTitle = prependCloneTitle
+
+	var result struct {
+		TicketForm TicketForm `json:"ticket_form"`
+	}
+
+	body, err := z.post(ctx, fmt.Sprintf("/ticket_forms/%d/clone.json", id), data)
+	if err != nil {
+		return TicketForm{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return TicketForm{}, err
+	}
+	return result.TicketForm, nil
+}
+
+// UpdateTicketFormConditions replaces the conditions of the specified ticket form.
+// Unlike UpdateTicketForm, empty conditions are sent to the API and remove the existing conditions.
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/ticket_forms/#update-ticket-form
+func (z *Client) UpdateTicketFormConditions(ctx context.Context, id int64, agentConditions, endUserConditions []TicketFormCondition) (TicketForm, error) {
+	var data struct {
+		TicketForm struct {
+			AgentConditions   []TicketFormCondition `json:"agent_conditions"`
+			EndUserConditions []TicketFormCondition `json:"end_user_conditions"`
+		} `json:"ticket_form"`
+	}
+	data.TicketForm.AgentConditions = agentConditions
+	data.TicketForm.EndUserConditions = endUserConditions
+	if data.TicketForm.AgentConditions == nil {
+		data.TicketForm.AgentConditions = []TicketFormCondition{}
+	}
+	if data.TicketForm.EndUserConditions == nil {
+		data.TicketForm.EndUserConditions = []TicketFormCondition{}
+	}
+
+	var result struct {
+		TicketForm TicketForm `json:"ticket_form"`
+	}
+
+	body, err := z.put(ctx, fmt.Sprintf("/ticket_forms/%d.json", id), data)
+	if err != nil {
+		return TicketForm{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return TicketForm{}, err
+	}
+	return result.TicketForm, nil
+}
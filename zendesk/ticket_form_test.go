@@ -1,8 +1,11 @@
 package zendesk
 
 import (
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"path/filepath"
+	"reflect"
 	"testing"
 )
 
@@ -115,3 +118,131 @@ func TestUpdateTicketFormFailure(t *testing.T) {
 		t.Fatal("Client did not return error when api failed")
 	}
 }
+
+func TestGetTicketFormConditions(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "ticket_form.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	form, err := client.GetTicketForm(ctx, 47)
+	if err != nil {
+		t.Fatalf("Failed to get ticket form: %s", err)
+	}
+
+	if len(form.AgentConditions) != 2 || len(form.EndUserConditions) != 2 {
+		t.Fatalf("unexpected conditions: %+v, %+v", form.AgentConditions, form.EndUserConditions)
+	}
+
+	condition := form.AgentConditions[0]
+	if condition.ParentFieldID != 100 || condition.Value != "matching_value" {
+		t.Fatalf("unexpected agent condition: %+v", condition)
+	}
+	if len(condition.ChildFields) != 2 || !condition.ChildFields[1].IsRequired {
+		t.Fatalf("unexpected child fields: %+v", condition.ChildFields)
+	}
+}
+
+func TestNewTicketFormCondition(t *testing.T) {
+	condition := NewTicketFormCondition(100, "matching_value", 101, 200).Require(200, "new", "open")
+
+	expected := TicketFormCondition{
+		ParentFieldID: 100,
+		Value:         "matching_value",
+		ChildFields: []TicketFormConditionChildField{
+			{ID: 101},
+			{ID: 200, IsRequired: true, RequiredOnStatuses: &TicketFormRequiredOnStatuses{
+				Type:     RequiredOnSomeStatuses,
+				Statuses: []string{"new", "open"},
+			}},
+		},
+	}
+	if !reflect.DeepEqual(condition, expected) {
+		t.Fatalf("expected condition %+v, but got %+v", expected, condition)
+	}
+}
+
+func TestGetManyTicketForms(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if ids := r.URL.Query().Get("ids"); ids != "47,48" {
+			t.Errorf("unexpected ids query: %s", ids)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "ticket_forms.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	forms, err := client.GetManyTicketForms(ctx, []int64{47, 48})
+	if err != nil {
+		t.Fatalf("Failed to get many ticket forms: %s", err)
+	}
+
+	if len(forms) != 1 {
+		t.Fatalf("expected length of ticket forms is 1, but got %d", len(forms))
+	}
+}
+
+func TestReorderTicketForms(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data struct {
+			TicketFormIDs []int64 `json:"ticket_form_ids"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+			t.Errorf("Failed to decode request body: %s", err)
+		}
+		if !reflect.DeepEqual(data.TicketFormIDs, []int64{48, 47}) {
+			t.Errorf("unexpected ticket form ids: %v", data.TicketFormIDs)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodPut, "ticket_forms.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	forms, err := client.ReorderTicketForms(ctx, []int64{48, 47})
+	if err != nil {
+		t.Fatalf("Failed to reorder ticket forms: %s", err)
+	}
+
+	if len(forms) != 2 || forms[0].ID != 48 {
+		t.Fatalf("unexpected ticket forms: %+v", forms)
+	}
+}
+
+func TestCloneTicketForm(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/ticket_forms/47/clone.json" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodPost, "ticket_form.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.CloneTicketForm(ctx, 47, true)
+	if err != nil {
+		t.Fatalf("Failed to clone ticket form: %s", err)
+	}
+}
+
+func TestUpdateTicketFormConditions(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data struct {
+			TicketForm map[string]json.RawMessage `json:"ticket_form"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+			t.Errorf("Failed to decode request body: %s", err)
+		}
+		if string(data.TicketForm["end_user_conditions"]) != "[]" {
+			t.Errorf("expected empty end user conditions, but got %s", data.TicketForm["end_user_conditions"])
+		}
+		w.Write(readFixture(filepath.Join(http.MethodPut, "ticket_form.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.UpdateTicketFormConditions(ctx, 47, []TicketFormCondition{
+		NewTicketFormCondition(100, "matching_value", 101),
+	}, nil)
+	if err != nil {
+		t.Fatalf("Failed to update ticket form conditions: %s", err)
+	}
+}
@@ -0,0 +1,186 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// TranslationSource is the kind of Help Center records which have translations
+type TranslationSource string
+
+// Translation sources
+const (
+	TranslationSourceArticle  TranslationSource = "articles"
+	TranslationSourceSection  TranslationSource = "sections"
+	TranslationSourceCategory TranslationSource = "categories"
+)
+
+// Translation is struct for Help Center translation payload.
+// Title and Body are the name and the description of sections and categories.
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/translations/
+type Translation struct {
+	ID          int64      `json:"id,omitempty"`
+	URL         string     `json:"url,omitempty"`
+	HTMLURL     string     `json:"html_url,omitempty"`
+	SourceID    int64      `json:"source_id,omitempty"`
+	SourceType  string     `json:"source_type,omitempty"`
+	Locale      string     `json:"locale"`
+	Title       string     `json:"title"`
+	Body        string     `json:"body,omitempty"`
+	Outdated    bool       `json:"outdated,omitempty"`
+	Draft       bool       `json:"draft,omitempty"`
+	Hidden      bool       `json:"hidden,omitempty"`
+	CreatedByID int64      `json:"created_by_id,omitempty"`
+	UpdatedByID int64      `json:"updated_by_id,omitempty"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+}
+
+// TranslationAPI an interface containing all Help Center translation related methods
+type TranslationAPI interface {
+	GetTranslations(ctx context.Context, source TranslationSource, sourceID int64) ([]Translation, error)
+	GetMissingTranslations(ctx context.Context, source TranslationSource, sourceID int64) ([]string, error)
+	GetTranslation(ctx context.Context, source TranslationSource, sourceID int64, locale int) (Translation, error)
+	CreateTranslation(ctx context.Context, source TranslationSource, sourceID int64, translation Translation) (Translation, error)
+	UpdateTranslation(
+		ctx context.Context, source TranslationSource, sourceID int64, locale int, translation Translation,
+	) (Translation, error)
+	DeleteTranslation(ctx context.Context, translationID int64) error
+}
+
+// GetTranslations lists the translations of the specified article, section or category
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/translations/#list-translations
+func (z *Client) GetTranslations(ctx context.Context, source TranslationSource, sourceID int64) ([]Translation, error) {
+	var result struct {
+		Translations []Translation `json:"translations"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/help_center/%s/%d/translations.json", source, sourceID))
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Translations, nil
+}
+
+// GetMissingTranslations lists the locales of the Help Center which the specified
+// article, section or category isn't translated to
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/translations/#list-missing-translations
+func (z *Client) GetMissingTranslations(ctx context.Context, source TranslationSource, sourceID int64) ([]string, error) {
+	var result struct {
+		Locales []string `json:"locales"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/help_center/%s/%d/translations/missing.json", source, sourceID))
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Locales, nil
+}
+
+// GetTranslation gets the translation of the specified article, section or category in the locale
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/translations/#show-translation
+func (z *Client) GetTranslation(
+	ctx context.Context, source TranslationSource, sourceID int64, locale int,
+) (Translation, error) {
+	var result struct {
+		Translation Translation `json:"translation"`
+	}
+
+	path, err := translationPath(source, sourceID, locale)
+	if err != nil {
+		return Translation{}, err
+	}
+
+	body, err := z.get(ctx, path)
+	if err != nil {
+		return Translation{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Translation{}, err
+	}
+	return result.Translation, nil
+}
+
+// CreateTranslation translates the specified article, section or category to the locale of the translation
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/translations/#create-translation
+func (z *Client) CreateTranslation(
+	ctx context.Context, source TranslationSource, sourceID int64, translation Translation,
+) (Translation, error) {
+	var data, result struct {
+		Translation Translation `json:"translation"`
+	}
+	data.Translation = translation
+
+	body, err := z.post(ctx, fmt.Sprintf("/help_center/%s/%d/translations.json", source, sourceID), data)
+	if err != nil {
+		return Translation{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Translation{}, err
+	}
+	return result.Translation, nil
+}
+
+// UpdateTranslation updates the translation of the specified article, section or category in the locale
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/translations/#update-translation
+func (z *Client) UpdateTranslation(
+	ctx context.Context, source TranslationSource, sourceID int64, locale int, translation Translation,
+) (Translation, error) {
+	var data, result struct {
+		Translation Translation `json:"translation"`
+	}
+	data.Translation = translation
+
+	path, err := translationPath(source, sourceID, locale)
+	if err != nil {
+		return Translation{}, err
+	}
+
+	body, err := z.put(ctx, path, data)
+	if err != nil {
+		return Translation{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Translation{}, err
+	}
+	return result.Translation, nil
+}
+
+// DeleteTranslation deletes the specified translation. The translation of the source locale can't be deleted.
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/translations/#delete-translation
+func (z *Client) DeleteTranslation(ctx context.Context, translationID int64) error {
+	return z.delete(ctx, fmt.Sprintf("/help_center/translations/%d.json", translationID))
+}
+
+func translationPath(source TranslationSource, sourceID int64, locale int) (string, error) {
+	text := LocaleTypeText(locale)
+	if text == "" {
+		return "", fmt.Errorf("unknown locale %d", locale)
+	}
+	return fmt.Sprintf("/help_center/%s/%d/translations/%s.json", source, sourceID, text), nil
+}
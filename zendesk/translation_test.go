@@ -0,0 +1,73 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+)
+
+func TestGetTranslations(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "translations.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	translations, err := client.GetTranslations(ctx, TranslationSourceArticle, 360001234567)
+	if err != nil {
+		t.Fatalf("Failed to get translations: %s", err)
+	}
+
+	if len(translations) != 2 {
+		t.Fatalf("expected length of translations is 2, but got %d", len(translations))
+	}
+}
+
+func TestGetMissingTranslations(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "translations_missing.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	locales, err := client.GetMissingTranslations(ctx, TranslationSourceSection, 360000123456)
+	if err != nil {
+		t.Fatalf("Failed to get missing translations: %s", err)
+	}
+
+	if len(locales) != 2 {
+		t.Fatalf("expected length of locales is 2, but got %d", len(locales))
+	}
+}
+
+func TestGetTranslation(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/help_center/articles/360001234567/translations/de.json" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write(readFixture(filepath.Join(http.MethodGet, "translation.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	translation, err := client.GetTranslation(ctx, TranslationSourceArticle, 360001234567, LocaleDE)
+	if err != nil {
+		t.Fatalf("Failed to get translation: %s", err)
+	}
+
+	if translation.Locale != "de" || !translation.Draft {
+		t.Fatalf("unexpected translation %v", translation)
+	}
+}
+
+func TestUpdateTranslation(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodPut, "translation.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.UpdateTranslation(ctx, TranslationSourceArticle, 360001234567, LocaleDE, Translation{
+		Locale: "de",
+		Title:  "So setzen Sie Ihr Passwort zurück",
+	})
+	if err != nil {
+		t.Fatalf("Failed to update translation: %s", err)
+	}
+}
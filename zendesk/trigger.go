@@ -54,6 +54,54 @@ type TriggerListOptions struct {
 	SortOrder  string `url:"sort_order,omitempty"`
 }
 
+// TriggerSearchOptions is options for SearchTriggers
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/triggers/#search-triggers
+type TriggerSearchOptions struct {
+	PageOptions
+	Query     string `url:"query"`
+	Active    *bool  `url:"active,omitempty"`
+	SortBy    string `url:"sort_by,omitempty"`
+	SortOrder string `url:"sort_order,omitempty"`
+}
+
+// TriggerBulkUpdate is an item of UpdateManyTriggers.
+// Only position, active and category_id can be updated in bulk.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/triggers/#update-many-triggers
+type TriggerBulkUpdate struct {
+	ID         int64  `json:"id"`
+	Position   *int64 `json:"position,omitempty"`
+	Active     *bool  `json:"active,omitempty"`
+	CategoryID string `json:"category_id,omitempty"`
+}
+
+// TriggerRevision is a snapshot of a trigger taken when it was changed
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/triggers/#list-trigger-revisions
+type TriggerRevision struct {
+	ID        int64           `json:"id"`
+	URL       string          `json:"url,omitempty"`
+	AuthorID  int64           `json:"author_id"`
+	CreatedAt time.Time       `json:"created_at"`
+	Snapshot  TriggerSnapshot `json:"snapshot"`
+
+	// Diff is the change from the previous revision. It is only returned by GetTriggerRevisions.
+	Diff json.RawMessage `json:"diff,omitempty"`
+}
+
+// TriggerSnapshot is the state of a trigger at a revision
+type TriggerSnapshot struct {
+	Title      string `json:"title"`
+	Active     bool   `json:"active"`
+	Conditions struct {
+		All []TriggerCondition `json:"all"`
+		Any []TriggerCondition `json:"any"`
+	} `json:"conditions"`
+	Actions     []TriggerAction `json:"actions"`
+	Description string          `json:"description,omitempty"`
+}
+
 // TriggerAPI an interface containing all trigger related methods
 type TriggerAPI interface {
 	GetTriggers(ctx context.Context, opts *TriggerListOptions) ([]Trigger, Page, error)
@@ -61,6 +109,14 @@ type TriggerAPI interface {
 	GetTrigger(ctx context.Context, id int64) (Trigger, error)
 	UpdateTrigger(ctx context.Context, id int64, trigger Trigger) (Trigger, error)
 	DeleteTrigger(ctx context.Context, id int64) error
+	GetActiveTriggers(ctx context.Context, opts *TriggerListOptions) ([]Trigger, Page, error)
+	SearchTriggers(ctx context.Context, opts *TriggerSearchOptions) ([]Trigger, Page, error)
+	ReorderTriggers(ctx context.Context, triggerIDs []int64) ([]Trigger, error)
+	UpdateManyTriggers(ctx context.Context, triggers []TriggerBulkUpdate) ([]Trigger, error)
+	DeleteManyTriggers(ctx context.Context, ids []int64) error
+	GetTriggerRevisions(ctx context.Context, triggerID int64, opts *CBPOptions) ([]TriggerRevision, CursorPaginationMeta, error)
+	GetTriggerRevisionsIterator(ctx context.Context, triggerID int64, opts *PaginationOptions) *Iterator[TriggerRevision]
+	GetTriggerRevision(ctx context.Context, triggerID, revisionID int64) (TriggerRevision, error)
 	GetTriggersIterator(ctx context.Context, opts *PaginationOptions) *Iterator[Trigger]
 	GetTriggersOBP(ctx context.Context, opts *OBPOptions) ([]Trigger, Page, error)
 	GetTriggersCBP(ctx context.Context, opts *CBPOptions) ([]Trigger, CursorPaginationMeta, error)
@@ -170,3 +226,201 @@ func (z *Client) DeleteTrigger(ctx context.Context, id int64) error {
 
 	return nil
 }
+
+// GetActiveTriggers fetch active trigger list
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/triggers/#list-active-triggers
+func (z *Client) GetActiveTriggers(ctx context.Context, opts *TriggerListOptions) ([]Trigger, Page, error) {
+	var data struct {
+		Triggers []Trigger `json:"triggers"`
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &TriggerListOptions{}
+	}
+
+	u, err := addOptions("/triggers/active.json", tmp)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	return data.Triggers, data.Page, nil
+}
+
+// SearchTriggers searches triggers by title
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/triggers/#search-triggers
+func (z *Client) SearchTriggers(ctx context.Context, opts *TriggerSearchOptions) ([]Trigger, Page, error) {
+	var data struct {
+		Triggers []Trigger `json:"triggers"`
+		Page
+	}
+
+	if opts == nil {
+		return nil, Page{}, &OptionsError{opts}
+	}
+
+	u, err := addOptions("/triggers/search.json", opts)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	return data.Triggers, data.Page, nil
+}
+
+// ReorderTriggers sets the position of triggers in the order of triggerIDs
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/triggers/#reorder-triggers
+func (z *Client) ReorderTriggers(ctx context.Context, triggerIDs []int64) ([]Trigger, error) {
+	var data struct {
+		TriggerIDs []int64 `json:"trigger_ids"`
+	}
+	var result struct {
+		Triggers []Trigger `json:"triggers"`
+	}
+	data.TriggerIDs = triggerIDs
+
+	body, err := z.put(ctx, "/triggers/reorder.json", data)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(body) == 0 {
+		return nil, nil
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Triggers, nil
+}
+
+// UpdateManyTriggers updates the position, active state or category of the specified triggers
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/triggers/#update-many-triggers
+func (z *Client) UpdateManyTriggers(ctx context.Context, triggers []TriggerBulkUpdate) ([]Trigger, error) {
+	var data struct {
+		Triggers []TriggerBulkUpdate `json:"triggers"`
+	}
+	var result struct {
+		Triggers []Trigger `json:"triggers"`
+	}
+	data.Triggers = triggers
+
+	body, err := z.put(ctx, "/triggers/update_many.json", data)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Triggers, nil
+}
+
+// DeleteManyTriggers deletes the specified triggers
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/triggers/#bulk-delete-triggers
+func (z *Client) DeleteManyTriggers(ctx context.Context, ids []int64) error {
+	u, err := idsOptions("/triggers/destroy_many.json", ids)
+	if err != nil {
+		return err
+	}
+
+	err = z.delete(ctx, u)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetTriggerRevisions fetches the revisions of the specified trigger, newest first
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/triggers/#list-trigger-revisions
+func (z *Client) GetTriggerRevisions(ctx context.Context, triggerID int64, opts *CBPOptions) ([]TriggerRevision, CursorPaginationMeta, error) {
+	var result struct {
+		TriggerRevisions []TriggerRevision    `json:"trigger_revisions"`
+		Meta             CursorPaginationMeta `json:"meta"`
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &CBPOptions{}
+	}
+
+	u, err := addOptions(fmt.Sprintf("/triggers/%d/revisions.json", triggerID), tmp.CursorPagination)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+	return result.TriggerRevisions, result.Meta, nil
+}
+
+// GetTriggerRevisionsIterator returns an iterator over the revisions of the specified trigger.
+// The endpoint supports cursor based pagination only, so IsCBP is ignored.
+func (z *Client) GetTriggerRevisionsIterator(ctx context.Context, triggerID int64, opts *PaginationOptions) *Iterator[TriggerRevision] {
+	return &Iterator[TriggerRevision]{
+		CommonOptions: opts.CommonOptions,
+		pageSize:      opts.PageSize,
+		hasMore:       true,
+		isCBP:         true,
+		pageAfter:     "",
+		pageIndex:     1,
+		ctx:           ctx,
+		cbpFunc: func(ctx context.Context, opts *CBPOptions) ([]TriggerRevision, CursorPaginationMeta, error) {
+			return z.GetTriggerRevisions(ctx, triggerID, opts)
+		},
+	}
+}
+
+// GetTriggerRevision returns the specified revision of a trigger
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/triggers/#show-trigger-revision
+func (z *Client) GetTriggerRevision(ctx context.Context, triggerID, revisionID int64) (TriggerRevision, error) {
+	var result struct {
+		TriggerRevision TriggerRevision `json:"trigger_revision"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/triggers/%d/revisions/%d.json", triggerID, revisionID))
+	if err != nil {
+		return TriggerRevision{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return TriggerRevision{}, err
+	}
+	return result.TriggerRevision, nil
+}
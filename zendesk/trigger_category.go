@@ -0,0 +1,210 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// TriggerCategory is struct for trigger category payload.
+// Triggers are run in the order of the position of their category, then their own position.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/trigger_categories/
+type TriggerCategory struct {
+	ID         string                     `json:"id,omitempty"`
+	Name       string                     `json:"name"`
+	Position   int64                      `json:"position,omitempty"`
+	RuleCounts *TriggerCategoryRuleCounts `json:"rule_counts,omitempty"`
+	CreatedAt  time.Time                  `json:"created_at,omitempty"`
+	UpdatedAt  time.Time                  `json:"updated_at,omitempty"`
+}
+
+// TriggerCategoryRuleCounts is the number of triggers in a category.
+// It is returned only when rule_counts is included.
+type TriggerCategoryRuleCounts struct {
+	ActiveCount   int64 `json:"active_count"`
+	InactiveCount int64 `json:"inactive_count"`
+}
+
+// TriggerCategoryListOptions is options for GetTriggerCategories
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/trigger_categories/#list-trigger-categories
+type TriggerCategoryListOptions struct {
+	CursorPagination
+
+	// Sort can take "position" or "-position"
+	Sort string `url:"sort,omitempty"`
+
+	// Include can take "rule_counts"
+	Include string `url:"include,omitempty"`
+}
+
+// TriggerCategoryBatch is the set of position changes applied at once by BatchUpdateTriggerCategories
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/trigger_categories/#create-batch-job-for-trigger-categories
+type TriggerCategoryBatch struct {
+	TriggerCategories []TriggerCategoryPosition `json:"trigger_categories,omitempty"`
+	Triggers          []TriggerPosition         `json:"triggers,omitempty"`
+}
+
+// TriggerCategoryPosition moves a trigger category
+type TriggerCategoryPosition struct {
+	ID       string `json:"id"`
+	Position int64  `json:"position"`
+}
+
+// TriggerPosition moves a trigger, optionally to another category
+type TriggerPosition struct {
+	ID         int64  `json:"id,string"`
+	Position   int64  `json:"position"`
+	CategoryID string `json:"category_id,omitempty"`
+}
+
+// TriggerCategoryAPI an interface containing all trigger category related methods
+type TriggerCategoryAPI interface {
+	GetTriggerCategories(ctx context.Context, opts *TriggerCategoryListOptions) ([]TriggerCategory, CursorPaginationMeta, error)
+	GetTriggerCategory(ctx context.Context, id string) (TriggerCategory, error)
+	CreateTriggerCategory(ctx context.Context, category TriggerCategory) (TriggerCategory, error)
+	UpdateTriggerCategory(ctx context.Context, id string, category TriggerCategory) (TriggerCategory, error)
+	DeleteTriggerCategory(ctx context.Context, id string) error
+	BatchUpdateTriggerCategories(ctx context.Context, batch TriggerCategoryBatch) ([]TriggerCategory, []Trigger, error)
+}
+
+// GetTriggerCategories fetches trigger categories
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/trigger_categories/#list-trigger-categories
+func (z *Client) GetTriggerCategories(ctx context.Context, opts *TriggerCategoryListOptions) ([]TriggerCategory, CursorPaginationMeta, error) {
+	var result struct {
+		TriggerCategories []TriggerCategory    `json:"trigger_categories"`
+		Meta              CursorPaginationMeta `json:"meta"`
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &TriggerCategoryListOptions{}
+	}
+
+	u, err := addOptions("/trigger_categories", tmp)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+	return result.TriggerCategories, result.Meta, nil
+}
+
+// GetTriggerCategory returns the specified trigger category
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/trigger_categories/#show-trigger-category
+func (z *Client) GetTriggerCategory(ctx context.Context, id string) (TriggerCategory, error) {
+	var result struct {
+		TriggerCategory TriggerCategory `json:"trigger_category"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/trigger_categories/%s", id))
+	if err != nil {
+		return TriggerCategory{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return TriggerCategory{}, err
+	}
+	return result.TriggerCategory, nil
+}
+
+// CreateTriggerCategory creates new trigger category
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/trigger_categories/#create-trigger-category
+func (z *Client) CreateTriggerCategory(ctx context.Context, category TriggerCategory) (TriggerCategory, error) {
+	var data, result struct {
+		TriggerCategory TriggerCategory `json:"trigger_category"`
+	}
+	data.TriggerCategory = category
+
+	body, err := z.post(ctx, "/trigger_categories", data)
+	if err != nil {
+		return TriggerCategory{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return TriggerCategory{}, err
+	}
+	return result.TriggerCategory, nil
+}
+
+// UpdateTriggerCategory updates the name or the position of the specified trigger category
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/trigger_categories/#update-trigger-category
+func (z *Client) UpdateTriggerCategory(ctx context.Context, id string, category TriggerCategory) (TriggerCategory, error) {
+	var data, result struct {
+		TriggerCategory TriggerCategory `json:"trigger_category"`
+	}
+	data.TriggerCategory = category
+
+	body, err := z.patch(ctx, fmt.Sprintf("/trigger_categories/%s", id), data)
+	if err != nil {
+		return TriggerCategory{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return TriggerCategory{}, err
+	}
+	return result.TriggerCategory, nil
+}
+
+// DeleteTriggerCategory deletes the specified trigger category.
+// The category must not contain any triggers.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/trigger_categories/#delete-trigger-category
+func (z *Client) DeleteTriggerCategory(ctx context.Context, id string) error {
+	err := z.delete(ctx, fmt.Sprintf("/trigger_categories/%s", id))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// BatchUpdateTriggerCategories moves trigger categories and triggers in a single request
+// and returns the updated categories and triggers
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/trigger_categories/#create-batch-job-for-trigger-categories
+func (z *Client) BatchUpdateTriggerCategories(ctx context.Context, batch TriggerCategoryBatch) ([]TriggerCategory, []Trigger, error) {
+	var data struct {
+		Job struct {
+			Action string               `json:"action"`
+			Items  TriggerCategoryBatch `json:"items"`
+		} `json:"job"`
+	}
+	var result struct {
+		Results struct {
+			TriggerCategories []TriggerCategory `json:"trigger_categories"`
+			Triggers          []Trigger         `json:"triggers"`
+		} `json:"results"`
+	}
+	data.Job.Action = "patch"
+	data.Job.Items = batch
+
+	body, err := z.post(ctx, "/trigger_categories/jobs", data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, nil, err
+	}
+	return result.Results.TriggerCategories, result.Results.Triggers, nil
+}
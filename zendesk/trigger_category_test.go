@@ -0,0 +1,139 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+)
+
+func TestGetTriggerCategories(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if include := r.URL.Query().Get("include"); include != "rule_counts" {
+			t.Errorf("unexpected include: %s", include)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "trigger_categories.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	categories, _, err := client.GetTriggerCategories(ctx, &TriggerCategoryListOptions{Include: "rule_counts"})
+	if err != nil {
+		t.Fatalf("Failed to get trigger categories: %s", err)
+	}
+
+	if len(categories) != 2 {
+		t.Fatalf("expected length of trigger categories is 2, but got %d", len(categories))
+	}
+	if categories[0].RuleCounts == nil || categories[0].RuleCounts.ActiveCount != 4 {
+		t.Fatalf("unexpected rule counts: %+v", categories[0].RuleCounts)
+	}
+}
+
+func TestGetTriggerCategory(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "trigger_category.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	category, err := client.GetTriggerCategory(ctx, "10026")
+	if err != nil {
+		t.Fatalf("Failed to get trigger category: %s", err)
+	}
+
+	if category.ID != "10026" {
+		t.Fatalf("expected id is 10026, but got %s", category.ID)
+	}
+}
+
+func TestCreateTriggerCategory(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodPost, "trigger_category.json", http.StatusCreated)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.CreateTriggerCategory(ctx, TriggerCategory{Name: "Notifications"})
+	if err != nil {
+		t.Fatalf("Failed to create trigger category: %s", err)
+	}
+}
+
+func TestUpdateTriggerCategory(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+		if r.URL.Path != "/trigger_categories/10026" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "trigger_category.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.UpdateTriggerCategory(ctx, "10026", TriggerCategory{Name: "Notifications"})
+	if err != nil {
+		t.Fatalf("Failed to update trigger category: %s", err)
+	}
+}
+
+func TestDeleteTriggerCategory(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	err := client.DeleteTriggerCategory(ctx, "10026")
+	if err != nil {
+		t.Fatalf("Failed to delete trigger category: %s", err)
+	}
+}
+
+func TestBatchUpdateTriggerCategories(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data struct {
+			Job struct {
+				Action string `json:"action"`
+				Items  struct {
+					TriggerCategories []map[string]interface{} `json:"trigger_categories"`
+					Triggers          []map[string]interface{} `json:"triggers"`
+				} `json:"items"`
+			} `json:"job"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+			t.Errorf("Failed to decode request body: %s", err)
+		}
+		if data.Job.Action != "patch" {
+			t.Errorf("unexpected action: %s", data.Job.Action)
+		}
+		if len(data.Job.Items.TriggerCategories) != 2 || len(data.Job.Items.Triggers) != 1 {
+			t.Errorf("unexpected items: %+v", data.Job.Items)
+		}
+		if id := data.Job.Items.Triggers[0]["id"]; id != "360056295714" {
+			t.Errorf("unexpected trigger id: %v", id)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodPost, "trigger_category_jobs.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	categories, triggers, err := client.BatchUpdateTriggerCategories(ctx, TriggerCategoryBatch{
+		TriggerCategories: []TriggerCategoryPosition{
+			{ID: "10026", Position: 1},
+			{ID: "10027", Position: 0},
+		},
+		Triggers: []TriggerPosition{
+			{ID: 360056295714, Position: 0, CategoryID: "10027"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to batch update trigger categories: %s", err)
+	}
+
+	if len(categories) != 2 || len(triggers) != 1 {
+		t.Fatalf("unexpected results: %d categories, %d triggers", len(categories), len(triggers))
+	}
+	if triggers[0].CategoryID != "10027" {
+		t.Fatalf("unexpected category of trigger: %s", triggers[0].CategoryID)
+	}
+}
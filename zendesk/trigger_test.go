@@ -1,8 +1,11 @@
 package zendesk
 
 import (
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"path/filepath"
+	"reflect"
 	"testing"
 )
 
@@ -130,3 +133,187 @@ func TestDeleteTriggerFailure(t *testing.T) {
 		t.Fatal("Client did not return error when api failed")
 	}
 }
+
+func TestGetActiveTriggers(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/triggers/active.json" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "triggers.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	triggers, _, err := client.GetActiveTriggers(ctx, nil)
+	if err != nil {
+		t.Fatalf("Failed to get active triggers: %s", err)
+	}
+
+	if len(triggers) != 8 {
+		t.Fatalf("expected length of triggers is 8, but got %d", len(triggers))
+	}
+}
+
+func TestSearchTriggers(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/triggers/search.json" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if q := r.URL.Query().Get("query"); q != "notify" {
+			t.Errorf("unexpected query: %s", q)
+		}
+		if a := r.URL.Query().Get("active"); a != "false" {
+			t.Errorf("unexpected active: %s", a)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "triggers.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	active := false
+	_, _, err := client.SearchTriggers(ctx, &TriggerSearchOptions{Query: "notify", Active: &active})
+	if err != nil {
+		t.Fatalf("Failed to search triggers: %s", err)
+	}
+
+	_, _, err = client.SearchTriggers(ctx, nil)
+	if _, ok := err.(*OptionsError); !ok {
+		t.Fatalf("expected an OptionsError, but got %v", err)
+	}
+}
+
+func TestReorderTriggers(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data struct {
+			TriggerIDs []int64 `json:"trigger_ids"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+			t.Errorf("Failed to decode request body: %s", err)
+		}
+		if !reflect.DeepEqual(data.TriggerIDs, []int64{3, 1, 2}) {
+			t.Errorf("unexpected trigger ids: %v", data.TriggerIDs)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "triggers.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	triggers, err := client.ReorderTriggers(ctx, []int64{3, 1, 2})
+	if err != nil {
+		t.Fatalf("Failed to reorder triggers: %s", err)
+	}
+
+	if len(triggers) != 8 {
+		t.Fatalf("expected length of triggers is 8, but got %d", len(triggers))
+	}
+}
+
+func TestUpdateManyTriggers(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data struct {
+			Triggers []map[string]interface{} `json:"triggers"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+			t.Errorf("Failed to decode request body: %s", err)
+		}
+		expected := []map[string]interface{}{
+			{"id": float64(1), "position": float64(0)},
+			{"id": float64(2), "active": false, "category_id": "10026"},
+		}
+		if !reflect.DeepEqual(data.Triggers, expected) {
+			t.Errorf("unexpected triggers: %v", data.Triggers)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "triggers.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	first := int64(0)
+	inactive := false
+	_, err := client.UpdateManyTriggers(ctx, []TriggerBulkUpdate{
+		{ID: 1, Position: &first},
+		{ID: 2, Active: &inactive, CategoryID: "10026"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to update many triggers: %s", err)
+	}
+}
+
+func TestDeleteManyTriggers(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/triggers/destroy_many.json" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if ids := r.URL.Query().Get("ids"); ids != "1,2" {
+			t.Errorf("unexpected ids: %s", ids)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	err := client.DeleteManyTriggers(ctx, []int64{1, 2})
+	if err != nil {
+		t.Fatalf("Failed to delete many triggers: %s", err)
+	}
+}
+
+func TestGetTriggerRevisions(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "trigger_revisions.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	revisions, meta, err := client.GetTriggerRevisions(ctx, 360056295714, nil)
+	if err != nil {
+		t.Fatalf("Failed to get trigger revisions: %s", err)
+	}
+
+	if len(revisions) != 2 {
+		t.Fatalf("expected length of trigger revisions is 2, but got %d", len(revisions))
+	}
+	if meta.HasMore {
+		t.Fatal("expected no more revisions")
+	}
+	if revisions[0].AuthorID != 369531345753 || !revisions[0].Snapshot.Active {
+		t.Fatalf("unexpected revision: %+v", revisions[0])
+	}
+	if len(revisions[0].Diff) == 0 || len(revisions[1].Diff) != 0 {
+		t.Fatal("expected only the first revision to have a diff")
+	}
+}
+
+func TestGetTriggerRevisionsIterator(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "trigger_revisions.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	it := client.GetTriggerRevisionsIterator(ctx, 360056295714, NewPaginationOptions())
+
+	var revisions []TriggerRevision
+	for it.HasMore() {
+		page, err := it.GetNext()
+		if err != nil {
+			t.Fatalf("Failed to iterate trigger revisions: %s", err)
+		}
+		revisions = append(revisions, page...)
+	}
+
+	if len(revisions) != 2 {
+		t.Fatalf("expected length of trigger revisions is 2, but got %d", len(revisions))
+	}
+}
+
+func TestGetTriggerRevision(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "trigger_revision.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	revision, err := client.GetTriggerRevision(ctx, 360056295714, 100)
+	if err != nil {
+		t.Fatalf("Failed to get trigger revision: %s", err)
+	}
+
+	if revision.ID != 100 || revision.Snapshot.Title != "Notify requester of received request" {
+		t.Fatalf("unexpected revision: %+v", revision)
+	}
+}
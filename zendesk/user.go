@@ -111,6 +111,13 @@ type SearchUsersOptions struct {
 	Query       string `json:"query,omitempty" url:"query,omitempty"`
 }
 
+// DeletedUserListOptions is options for GetDeletedUsers
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#list-deleted-users
+type DeletedUserListOptions struct {
+	PageOptions
+}
+
 // UserAPI an interface containing all user related methods
 type UserAPI interface {
 	SearchUsers(ctx context.Context, opts *SearchUsersOptions) ([]User, Page, error)
@@ -122,6 +129,19 @@ type UserAPI interface {
 	CreateOrUpdateUser(ctx context.Context, user User) (User, error)
 	UpdateUser(ctx context.Context, userID int64, user User) (User, error)
 	GetUserRelated(ctx context.Context, userID int64) (UserRelated, error)
+	DeleteUser(ctx context.Context, userID int64) (User, error)
+	MergeUser(ctx context.Context, userID int64, targetUserID int64) (User, error)
+	CreateManyUsers(ctx context.Context, users []User) (JobStatus, error)
+	CreateOrUpdateManyUsers(ctx context.Context, users []User) (JobStatus, error)
+	UpdateManyUsers(ctx context.Context, users []User) (JobStatus, error)
+	BulkUpdateUsers(ctx context.Context, userIDs []int64, user User) (JobStatus, error)
+	DeleteManyUsers(ctx context.Context, userIDs []int64) (JobStatus, error)
+	SetUserPassword(ctx context.Context, userID int64, password string) error
+	ChangeUserPassword(ctx context.Context, userID int64, previousPassword, password string) error
+	GetUserPasswordRequirements(ctx context.Context, userID int64) ([]string, error)
+	GetDeletedUsers(ctx context.Context, opts *DeletedUserListOptions) ([]User, Page, error)
+	GetDeletedUser(ctx context.Context, userID int64) (User, error)
+	PermanentlyDeleteUser(ctx context.Context, userID int64) (User, error)
 	GetUsersIterator(ctx context.Context, opts *PaginationOptions) *Iterator[User]
 	GetUsersOBP(ctx context.Context, opts *OBPOptions) ([]User, Page, error)
 	GetUsersCBP(ctx context.Context, opts *CBPOptions) ([]User, CursorPaginationMeta, error)
@@ -298,8 +318,6 @@ func (z *Client) CreateOrUpdateUser(ctx context.Context, user User) (User, error
 	return result.User, nil
 }
 
-// TODO: CreateOrUpdateManyUsers(users []User)
-
 // GetUser get an existing user
 // ref: https://developer.zendesk.com/rest_api/docs/support/users#show-user
 func (z *Client) GetUser(ctx context.Context, userID int64) (User, error) {
@@ -357,3 +375,259 @@ func (z *Client) GetUserRelated(ctx context.Context, userID int64) (UserRelated,
 
 	return data.UserRelated, nil
 }
+
+// DeleteUser soft-deletes the specified user and returns it.
+// Deleted users can be permanently deleted with PermanentlyDeleteUser.
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#delete-user
+func (z *Client) DeleteUser(ctx context.Context, userID int64) (User, error) {
+	var result struct {
+		User User `json:"user"`
+	}
+
+	body, err := z.deleteWithResponse(ctx, fmt.Sprintf("/users/%d.json", userID))
+	if err != nil {
+		return User{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return User{}, err
+	}
+	return result.User, nil
+}
+
+// MergeUser merges the specified user into the target user and returns the target user
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#merge-users
+func (z *Client) MergeUser(ctx context.Context, userID int64, targetUserID int64) (User, error) {
+	var data struct {
+		User struct {
+			ID int64 `json:"id"`
+		} `json:"user"`
+	}
+	var result struct {
+		User User `json:"user"`
+	}
+	data.User.ID = targetUserID
+
+	body, err := z.put(ctx, fmt.Sprintf("/users/%d/merge.json", userID), data)
+	if err != nil {
+		return User{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return User{}, err
+	}
+	return result.User, nil
+}
+
+// CreateManyUsers enqueues a job to create up to 100 users
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#create-many-users
+func (z *Client) CreateManyUsers(ctx context.Context, users []User) (JobStatus, error) {
+	var data struct {
+		Users []User `json:"users"`
+	}
+	data.Users = users
+
+	body, err := z.post(ctx, "/users/create_many.json", data)
+	if err != nil {
+		return JobStatus{}, err
+	}
+
+	return jobStatusResponse(body)
+}
+
+// CreateOrUpdateManyUsers enqueues a job to create or update up to 100 users.
+// Users are matched by email or external ID.
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#create-or-update-many-users
+func (z *Client) CreateOrUpdateManyUsers(ctx context.Context, users []User) (JobStatus, error) {
+	var data struct {
+		Users []User `json:"users"`
+	}
+	data.Users = users
+
+	body, err := z.post(ctx, "/users/create_or_update_many.json", data)
+	if err != nil {
+		return JobStatus{}, err
+	}
+
+	return jobStatusResponse(body)
+}
+
+// UpdateManyUsers enqueues a job to update up to 100 users individually.
+// Each user must have its ID set.
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#update-many-users
+func (z *Client) UpdateManyUsers(ctx context.Context, users []User) (JobStatus, error) {
+	var data struct {
+		Users []User `json:"users"`
+	}
+	data.Users = users
+
+	body, err := z.put(ctx, "/users/update_many.json", data)
+	if err != nil {
+		return JobStatus{}, err
+	}
+
+	return jobStatusResponse(body)
+}
+
+// BulkUpdateUsers enqueues a job to apply the same change to up to 100 users
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#update-many-users
+func (z *Client) BulkUpdateUsers(ctx context.Context, userIDs []int64, user User) (JobStatus, error) {
+	var data struct {
+		User User `json:"user"`
+	}
+	data.User = user
+
+	u, err := idsOptions("/users/update_many.json", userIDs)
+	if err != nil {
+		return JobStatus{}, err
+	}
+
+	body, err := z.put(ctx, u, data)
+	if err != nil {
+		return JobStatus{}, err
+	}
+
+	return jobStatusResponse(body)
+}
+
+// DeleteManyUsers enqueues a job to soft-delete up to 100 users
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#bulk-delete-users
+func (z *Client) DeleteManyUsers(ctx context.Context, userIDs []int64) (JobStatus, error) {
+	u, err := idsOptions("/users/destroy_many.json", userIDs)
+	if err != nil {
+		return JobStatus{}, err
+	}
+
+	body, err := z.deleteWithResponse(ctx, u)
+	if err != nil {
+		return JobStatus{}, err
+	}
+
+	return jobStatusResponse(body)
+}
+
+// SetUserPassword sets the password of the specified user as an admin
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/user_passwords/#set-a-users-password
+func (z *Client) SetUserPassword(ctx context.Context, userID int64, password string) error {
+	var data struct {
+		Password string `json:"password"`
+	}
+	data.Password = password
+
+	_, err := z.post(ctx, fmt.Sprintf("/users/%d/password.json", userID), data)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ChangeUserPassword changes the password of the specified user.
+// Only the user themselves can change their password.
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/user_passwords/#change-your-password
+func (z *Client) ChangeUserPassword(ctx context.Context, userID int64, previousPassword, password string) error {
+	var data struct {
+		PreviousPassword string `json:"previous_password"`
+		Password         string `json:"password"`
+	}
+	data.PreviousPassword = previousPassword
+	data.Password = password
+
+	_, err := z.put(ctx, fmt.Sprintf("/users/%d/password.json", userID), data)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetUserPasswordRequirements returns the password requirements of the specified user
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/user_passwords/#get-a-list-of-password-requirements
+func (z *Client) GetUserPasswordRequirements(ctx context.Context, userID int64) ([]string, error) {
+	var result struct {
+		Requirements []string `json:"requirements"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/users/%d/password/requirements.json", userID))
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Requirements, nil
+}
+
+// GetDeletedUsers fetches soft-deleted users
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#list-deleted-users
+func (z *Client) GetDeletedUsers(ctx context.Context, opts *DeletedUserListOptions) ([]User, Page, error) {
+	var data struct {
+		DeletedUsers []User `json:"deleted_users"`
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &DeletedUserListOptions{}
+	}
+
+	u, err := addOptions("/deleted_users.json", tmp)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	return data.DeletedUsers, data.Page, nil
+}
+
+// GetDeletedUser gets the specified soft-deleted user
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#show-deleted-user
+func (z *Client) GetDeletedUser(ctx context.Context, userID int64) (User, error) {
+	var result struct {
+		DeletedUser User `json:"deleted_user"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/deleted_users/%d.json", userID))
+	if err != nil {
+		return User{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return User{}, err
+	}
+	return result.DeletedUser, nil
+}
+
+// PermanentlyDeleteUser permanently deletes the specified soft-deleted user,
+// e.g. to comply with a GDPR right to erasure request.
+// The user must be deleted with DeleteUser beforehand.
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#permanently-delete-user
+func (z *Client) PermanentlyDeleteUser(ctx context.Context, userID int64) (User, error) {
+	var result struct {
+		DeletedUser User `json:"deleted_user"`
+	}
+
+	body, err := z.deleteWithResponse(ctx, fmt.Sprintf("/deleted_users/%d.json", userID))
+	if err != nil {
+		return User{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return User{}, err
+	}
+	return result.DeletedUser, nil
+}
@@ -3,6 +3,7 @@ package zendesk
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"time"
 )
 
@@ -36,6 +37,13 @@ type UserFieldAPI interface {
 	GetUserFieldsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[UserField]
 	GetUserFieldsOBP(ctx context.Context, opts *OBPOptions) ([]UserField, Page, error)
 	GetUserFieldsCBP(ctx context.Context, opts *CBPOptions) ([]UserField, CursorPaginationMeta, error)
+	GetUserFieldOptionsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[CustomFieldOption]
+	GetUserFieldOptionsOBP(ctx context.Context, opts *OBPOptions) ([]CustomFieldOption, Page, error)
+	GetUserFieldOptionsCBP(ctx context.Context, opts *CBPOptions) ([]CustomFieldOption, CursorPaginationMeta, error)
+	GetUserFieldOption(ctx context.Context, fieldID, optionID int64) (CustomFieldOption, error)
+	CreateOrUpdateUserFieldOption(ctx context.Context, fieldID int64, option CustomFieldOption) (CustomFieldOption, error)
+	DeleteUserFieldOption(ctx context.Context, fieldID, optionID int64) error
+	ReorderUserFields(ctx context.Context, fieldIDs []int64) error
 }
 
 // GetUserFields fetch trigger list
@@ -88,3 +96,43 @@ func (z *Client) CreateUserField(ctx context.Context, userField UserField) (User
 	}
 	return result.UserField, nil
 }
+
+// GetUserFieldOption gets the specified option of a user field
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/user_fields/#show-user-field-option
+func (z *Client) GetUserFieldOption(ctx context.Context, fieldID, optionID int64) (CustomFieldOption, error) {
+	return z.getCustomFieldOption(ctx, fmt.Sprintf("/user_fields/%d/options/%d.json", fieldID, optionID))
+}
+
+// CreateOrUpdateUserFieldOption creates an option of a dropdown or multiselect user field.
+// The option is updated instead when its ID is set.
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/user_fields/#create-or-update-user-field-option
+func (z *Client) CreateOrUpdateUserFieldOption(ctx context.Context, fieldID int64, option CustomFieldOption) (CustomFieldOption, error) {
+	return z.createOrUpdateCustomFieldOption(ctx, fmt.Sprintf("/user_fields/%d/options.json", fieldID), option)
+}
+
+// DeleteUserFieldOption deletes the specified option of a user field
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/user_fields/#delete-user-field-option
+func (z *Client) DeleteUserFieldOption(ctx context.Context, fieldID, optionID int64) error {
+	err := z.delete(ctx, fmt.Sprintf("/user_fields/%d/options/%d.json", fieldID, optionID))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ReorderUserFields sets the order of user fields
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/user_fields/#reorder-user-field
+func (z *Client) ReorderUserFields(ctx context.Context, fieldIDs []int64) error {
+	var data struct {
+		IDs []int64 `json:"user_field_ids"`
+	}
+	data.IDs = fieldIDs
+
+	_, err := z.put(ctx, "/user_fields/reorder.json", data)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -55,3 +55,51 @@ func TestUserFieldQueryParamsSet(t *testing.T) {
 		t.Fatalf("Received error calling API: %v", err)
 	}
 }
+
+func TestGetUserFieldOptionsOBP(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "custom_field_options.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	options, _, err := client.GetUserFieldOptionsOBP(ctx, &OBPOptions{
+		CommonOptions: CommonOptions{
+			Id: 1,
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to get user field options: %s", err)
+	}
+
+	if len(options) != 2 {
+		t.Fatalf("expected length of user field options is 2, but got %d", len(options))
+	}
+}
+
+func TestDeleteUserFieldOption(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+		w.Write(nil)
+	}))
+
+	c := newTestClient(mockAPI)
+	err := c.DeleteUserFieldOption(ctx, 1, 10001)
+	if err != nil {
+		t.Fatalf("Failed to delete user field option: %s", err)
+	}
+}
+
+func TestReorderUserFields(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/user_fields/reorder.json" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	err := client.ReorderUserFields(ctx, []int64{2, 1})
+	if err != nil {
+		t.Fatalf("Failed to reorder user fields: %s", err)
+	}
+}
@@ -0,0 +1,217 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// User identity types
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/user_identities/#json-format
+const (
+	UserIdentityTypeEmail           = "email"
+	UserIdentityTypeTwitter         = "twitter"
+	UserIdentityTypeFacebook        = "facebook"
+	UserIdentityTypeGoogle          = "google"
+	UserIdentityTypePhoneNumber     = "phone_number"
+	UserIdentityTypeAgentForwarding = "agent_forwarding"
+	UserIdentityTypeSDK             = "sdk"
+)
+
+// UserIdentity is struct for user identity payload
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/user_identities/
+type UserIdentity struct {
+	ID                 int64     `json:"id,omitempty"`
+	URL                string    `json:"url,omitempty"`
+	UserID             int64     `json:"user_id,omitempty"`
+	Type               string    `json:"type"`
+	Value              string    `json:"value"`
+	Verified           bool      `json:"verified,omitempty"`
+	Primary            bool      `json:"primary,omitempty"`
+	DeliverableState   string    `json:"deliverable_state,omitempty"`
+	UndeliverableCount int64     `json:"undeliverable_count,omitempty"`
+	CreatedAt          time.Time `json:"created_at,omitempty"`
+	UpdatedAt          time.Time `json:"updated_at,omitempty"`
+}
+
+// UserIdentityListOptions is options for GetUserIdentities
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/user_identities/#list-identities
+type UserIdentityListOptions struct {
+	PageOptions
+}
+
+// UserIdentityAPI an interface containing all user identity related methods
+type UserIdentityAPI interface {
+	GetUserIdentities(ctx context.Context, userID int64, opts *UserIdentityListOptions) ([]UserIdentity, Page, error)
+	GetUserIdentity(ctx context.Context, userID, identityID int64) (UserIdentity, error)
+	CreateUserIdentity(ctx context.Context, userID int64, identity UserIdentity) (UserIdentity, error)
+	UpdateUserIdentity(ctx context.Context, userID, identityID int64, identity UserIdentity) (UserIdentity, error)
+	MakeUserIdentityPrimary(ctx context.Context, userID, identityID int64) ([]UserIdentity, error)
+	VerifyUserIdentity(ctx context.Context, userID, identityID int64) (UserIdentity, error)
+	RequestUserIdentityVerification(ctx context.Context, userID, identityID int64) error
+	DeleteUserIdentity(ctx context.Context, userID, identityID int64) error
+	GetUserIdentitiesIterator(ctx context.Context, opts *PaginationOptions) *Iterator[UserIdentity]
+	GetUserIdentitiesOBP(ctx context.Context, opts *OBPOptions) ([]UserIdentity, Page, error)
+	GetUserIdentitiesCBP(ctx context.Context, opts *CBPOptions) ([]UserIdentity, CursorPaginationMeta, error)
+}
+
+// GetUserIdentities fetches identities of the specified user
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/user_identities/#list-identities
+func (z *Client) GetUserIdentities(ctx context.Context, userID int64, opts *UserIdentityListOptions) ([]UserIdentity, Page, error) {
+	var data struct {
+		Identities []UserIdentity `json:"identities"`
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &UserIdentityListOptions{}
+	}
+
+	u, err := addOptions(fmt.Sprintf("/users/%d/identities.json", userID), tmp)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	return data.Identities, data.Page, nil
+}
+
+// GetUserIdentity gets the specified identity of a user
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/user_identities/#show-identity
+func (z *Client) GetUserIdentity(ctx context.Context, userID, identityID int64) (UserIdentity, error) {
+	var result struct {
+		Identity UserIdentity `json:"identity"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/users/%d/identities/%d.json", userID, identityID))
+	if err != nil {
+		return UserIdentity{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return UserIdentity{}, err
+	}
+	return result.Identity, nil
+}
+
+// CreateUserIdentity adds new identity such as a secondary email or a phone number to the specified user
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/user_identities/#create-identity
+func (z *Client) CreateUserIdentity(ctx context.Context, userID int64, identity UserIdentity) (UserIdentity, error) {
+	var data, result struct {
+		Identity UserIdentity `json:"identity"`
+	}
+	data.Identity = identity
+
+	body, err := z.post(ctx, fmt.Sprintf("/users/%d/identities.json", userID), data)
+	if err != nil {
+		return UserIdentity{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return UserIdentity{}, err
+	}
+	return result.Identity, nil
+}
+
+// UpdateUserIdentity updates the value or the verified state of the specified identity
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/user_identities/#update-identity
+func (z *Client) UpdateUserIdentity(ctx context.Context, userID, identityID int64, identity UserIdentity) (UserIdentity, error) {
+	var data, result struct {
+		Identity UserIdentity `json:"identity"`
+	}
+	data.Identity = identity
+
+	body, err := z.put(ctx, fmt.Sprintf("/users/%d/identities/%d.json", userID, identityID), data)
+	if err != nil {
+		return UserIdentity{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return UserIdentity{}, err
+	}
+	return result.Identity, nil
+}
+
+// MakeUserIdentityPrimary sets the specified identity as primary and returns all identities of the user
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/user_identities/#make-identity-primary
+func (z *Client) MakeUserIdentityPrimary(ctx context.Context, userID, identityID int64) ([]UserIdentity, error) {
+	var result struct {
+		Identities []UserIdentity `json:"identities"`
+	}
+
+	body, err := z.put(ctx, fmt.Sprintf("/users/%d/identities/%d/make_primary.json", userID, identityID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Identities, nil
+}
+
+// VerifyUserIdentity sets the specified identity as verified
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/user_identities/#verify-identity
+func (z *Client) VerifyUserIdentity(ctx context.Context, userID, identityID int64) (UserIdentity, error) {
+	var result struct {
+		Identity UserIdentity `json:"identity"`
+	}
+
+	body, err := z.put(ctx, fmt.Sprintf("/users/%d/identities/%d/verify.json", userID, identityID), nil)
+	if err != nil {
+		return UserIdentity{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return UserIdentity{}, err
+	}
+	return result.Identity, nil
+}
+
+// RequestUserIdentityVerification sends a verification email to the user of the specified email identity
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/user_identities/#request-user-verification
+func (z *Client) RequestUserIdentityVerification(ctx context.Context, userID, identityID int64) error {
+	_, err := z.put(ctx, fmt.Sprintf("/users/%d/identities/%d/request_verification.json", userID, identityID), nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteUserIdentity deletes the specified identity of a user
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/user_identities/#delete-identity
+func (z *Client) DeleteUserIdentity(ctx context.Context, userID, identityID int64) error {
+	err := z.delete(ctx, fmt.Sprintf("/users/%d/identities/%d.json", userID, identityID))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,139 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetUserIdentities(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "user_identities.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	identities, _, err := client.GetUserIdentities(ctx, 35436, nil)
+	if err != nil {
+		t.Fatalf("Failed to get user identities: %s", err)
+	}
+
+	if len(identities) != 2 {
+		t.Fatalf("expected length of user identities is 2, but got %d", len(identities))
+	}
+}
+
+func TestGetUserIdentitiesIterator(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "user_identities.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	opts := NewPaginationOptions()
+	opts.Id = 35436
+	opts.IsCBP = false
+	it := client.GetUserIdentitiesIterator(ctx, opts)
+
+	count := 0
+	for it.HasMore() {
+		identities, err := it.GetNext()
+		if err != nil {
+			t.Fatalf("Failed to get user identities: %s", err)
+		}
+		count += len(identities)
+	}
+	if count != 2 {
+		t.Fatalf("expected length of user identities is 2, but got %d", count)
+	}
+}
+
+func TestGetUserIdentity(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "user_identity.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	identity, err := client.GetUserIdentity(ctx, 35436, 77938)
+	if err != nil {
+		t.Fatalf("Failed to get user identity: %s", err)
+	}
+
+	if identity.Type != UserIdentityTypeEmail {
+		t.Fatalf("expected user identity type is email, but got %s", identity.Type)
+	}
+}
+
+func TestCreateUserIdentity(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodPost, "user_identity.json", http.StatusCreated)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.CreateUserIdentity(ctx, 35436, UserIdentity{Type: UserIdentityTypeEmail, Value: "someone@example.com"})
+	if err != nil {
+		t.Fatalf("Failed to send request to create user identity: %s", err)
+	}
+}
+
+func TestUpdateUserIdentity(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodPut, "user_identity.json", http.StatusOK)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.UpdateUserIdentity(ctx, 35436, 77938, UserIdentity{Value: "someone@example.com"})
+	if err != nil {
+		t.Fatalf("Failed to send request to update user identity: %s", err)
+	}
+}
+
+func TestMakeUserIdentityPrimary(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodPut, "user_identities.json", http.StatusOK)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	identities, err := client.MakeUserIdentityPrimary(ctx, 35436, 77938)
+	if err != nil {
+		t.Fatalf("Failed to make user identity primary: %s", err)
+	}
+
+	if len(identities) != 2 || !identities[0].Primary {
+		t.Fatalf("unexpected user identities: %v", identities)
+	}
+}
+
+func TestVerifyUserIdentity(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodPut, "user_identity.json", http.StatusOK)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	identity, err := client.VerifyUserIdentity(ctx, 35436, 77938)
+	if err != nil {
+		t.Fatalf("Failed to verify user identity: %s", err)
+	}
+
+	if !identity.Verified {
+		t.Fatal("expected user identity to be verified")
+	}
+}
+
+func TestRequestUserIdentityVerification(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write(nil)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	err := client.RequestUserIdentityVerification(ctx, 35436, 77938)
+	if err != nil {
+		t.Fatalf("Failed to request user identity verification: %s", err)
+	}
+}
+
+func TestDeleteUserIdentity(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+		w.Write(nil)
+	}))
+
+	c := newTestClient(mockAPI)
+	err := c.DeleteUserIdentity(ctx, 35436, 77938)
+	if err != nil {
+		t.Fatalf("Failed to delete user identity: %s", err)
+	}
+}
@@ -1,6 +1,7 @@
 package zendesk
 
 import (
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"path/filepath"
@@ -233,3 +234,210 @@ func TestGetUserRelated(t *testing.T) {
 		t.Fatalf("Returned user does not have the expected assigned tickets %d. It is %d", expectedAssignedTickets, userRelated.AssignedTickets)
 	}
 }
+
+func TestDeleteUser(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodDelete, "user.json", http.StatusOK)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	user, err := client.DeleteUser(ctx, 369531345753)
+	if err != nil {
+		t.Fatalf("Failed to delete user: %s", err)
+	}
+
+	expectedID := int64(369531345753)
+	if user.ID != expectedID {
+		t.Fatalf("Returned user does not have the expected ID %d. User id is %d", expectedID, user.ID)
+	}
+}
+
+func TestMergeUser(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data struct {
+			User struct {
+				ID int64 `json:"id"`
+			} `json:"user"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil || data.User.ID != 369531345753 {
+			t.Errorf("unexpected merge target: %v", err)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodPut, "user.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	user, err := client.MergeUser(ctx, 1234, 369531345753)
+	if err != nil {
+		t.Fatalf("Failed to merge user: %s", err)
+	}
+
+	if user.ID != 369531345753 {
+		t.Fatalf("expected merged user ID is 369531345753, but got %d", user.ID)
+	}
+}
+
+func TestCreateManyUsers(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodPost, "job_status.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	status, err := client.CreateManyUsers(ctx, []User{{Name: "Roger"}, {Name: "Woger"}})
+	if err != nil {
+		t.Fatalf("Failed to create many users: %s", err)
+	}
+
+	if status.Status != JobStatusQueued {
+		t.Fatalf("expected job status is queued, but got %s", status.Status)
+	}
+}
+
+func TestCreateOrUpdateManyUsers(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodPost, "job_status.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	status, err := client.CreateOrUpdateManyUsers(ctx, []User{{Name: "Roger", Email: "roge@example.org"}})
+	if err != nil {
+		t.Fatalf("Failed to create or update many users: %s", err)
+	}
+
+	if status.ID == "" {
+		t.Fatal("expected job status to have an ID")
+	}
+}
+
+func TestUpdateManyUsers(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodPut, "job_status.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.UpdateManyUsers(ctx, []User{{ID: 1, Name: "Roger"}, {ID: 2, Name: "Woger"}})
+	if err != nil {
+		t.Fatalf("Failed to update many users: %s", err)
+	}
+}
+
+func TestBulkUpdateUsers(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if ids := r.URL.Query().Get("ids"); ids != "1,2,3" {
+			t.Errorf("unexpected ids query: %s", ids)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodPut, "job_status.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.BulkUpdateUsers(ctx, []int64{1, 2, 3}, User{OrganizationID: 1})
+	if err != nil {
+		t.Fatalf("Failed to bulk update users: %s", err)
+	}
+}
+
+func TestDeleteManyUsers(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if ids := r.URL.Query().Get("ids"); ids != "1,2,3" {
+			t.Errorf("unexpected ids query: %s", ids)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodDelete, "job_status.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	status, err := client.DeleteManyUsers(ctx, []int64{1, 2, 3})
+	if err != nil {
+		t.Fatalf("Failed to delete many users: %s", err)
+	}
+
+	if status.Status != JobStatusQueued {
+		t.Fatalf("expected job status is queued, but got %s", status.Status)
+	}
+}
+
+func TestSetUserPassword(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write(nil)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	err := client.SetUserPassword(ctx, 369531345753, "newpassword")
+	if err != nil {
+		t.Fatalf("Failed to set user password: %s", err)
+	}
+}
+
+func TestChangeUserPassword(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write(nil)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	err := client.ChangeUserPassword(ctx, 369531345753, "oldpassword", "newpassword")
+	if err != nil {
+		t.Fatalf("Failed to change user password: %s", err)
+	}
+}
+
+func TestGetUserPasswordRequirements(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "password_requirements.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	requirements, err := client.GetUserPasswordRequirements(ctx, 369531345753)
+	if err != nil {
+		t.Fatalf("Failed to get password requirements: %s", err)
+	}
+
+	if len(requirements) != 2 {
+		t.Fatalf("expected length of password requirements is 2, but got %d", len(requirements))
+	}
+}
+
+func TestGetDeletedUsers(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "deleted_users.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	users, _, err := client.GetDeletedUsers(ctx, nil)
+	if err != nil {
+		t.Fatalf("Failed to get deleted users: %s", err)
+	}
+
+	if len(users) != 1 {
+		t.Fatalf("expected length of deleted users is 1, but got %d", len(users))
+	}
+}
+
+func TestGetDeletedUser(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "deleted_user.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	user, err := client.GetDeletedUser(ctx, 189304711533)
+	if err != nil {
+		t.Fatalf("Failed to get deleted user: %s", err)
+	}
+
+	if user.Active {
+		t.Fatal("expected deleted user to be inactive")
+	}
+}
+
+func TestPermanentlyDeleteUser(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodDelete, "deleted_user.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	user, err := client.PermanentlyDeleteUser(ctx, 189304711533)
+	if err != nil {
+		t.Fatalf("Failed to permanently delete user: %s", err)
+	}
+
+	expectedID := int64(189304711533)
+	if user.ID != expectedID {
+		t.Fatalf("Returned user does not have the expected ID %d. User id is %d", expectedID, user.ID)
+	}
+}
@@ -4,27 +4,139 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 )
 
 type (
-	// View is struct for group membership payload
+	// View is struct for view payload
 	// https://developer.zendesk.com/api-reference/ticketing/business-rules/views/
 	View struct {
-		ID          int64     `json:"id,omitempty"`
-		Active      bool      `json:"active"`
-		Description string    `json:"description"`
-		Position    int64     `json:"position"`
-		Title       string    `json:"title"`
-		CreatedAt   time.Time `json:"created_at,omitempty"`
-		UpdatedAt   time.Time `json:"updated_at,omitempty"`
+		ID          int64            `json:"id,omitempty"`
+		URL         string           `json:"url,omitempty"`
+		Active      bool             `json:"active"`
+		Default     bool             `json:"default,omitempty"`
+		Description string           `json:"description"`
+		Position    int64            `json:"position"`
+		Title       string           `json:"title"`
+		RawTitle    string           `json:"raw_title,omitempty"`
+		Watchable   bool             `json:"watchable,omitempty"`
+		Conditions  ViewConditions   `json:"conditions"`
+		Execution   ViewExecution    `json:"execution"`
+		Restriction *ViewRestriction `json:"restriction"`
+		CreatedAt   time.Time        `json:"created_at,omitempty"`
+		UpdatedAt   time.Time        `json:"updated_at,omitempty"`
 
-		// Conditions Conditions
-		// Execution Execution
-		// Restriction Restriction
+		// fetchedTitle is the title the view was decoded with
+		fetchedTitle string
 	}
 
+	// ViewConditions is the set of conditions a ticket must match to be listed in a view
+	ViewConditions struct {
+		All []TriggerCondition `json:"all"`
+		Any []TriggerCondition `json:"any"`
+	}
+
+	// ViewExecution describes how the tickets of a view are displayed
+	ViewExecution struct {
+		GroupBy    string `json:"group_by,omitempty"`
+		GroupOrder string `json:"group_order,omitempty"`
+		SortBy     string `json:"sort_by,omitempty"`
+		SortOrder  string `json:"sort_order,omitempty"`
+
+		// Group and Sort are returned by the API and describe GroupBy and SortBy
+		Group *ViewExecutionOrder `json:"group,omitempty"`
+		Sort  *ViewExecutionOrder `json:"sort,omitempty"`
+
+		Columns      []ViewColumn `json:"columns,omitempty"`
+		Fields       []ViewColumn `json:"fields,omitempty"`
+		CustomFields []ViewColumn `json:"custom_fields,omitempty"`
+	}
+
+	// ViewExecutionOrder is a column used to group or sort the tickets of a view
+	ViewExecutionOrder struct {
+		ID    interface{} `json:"id"`
+		Title string      `json:"title"`
+		Order string      `json:"order"`
+	}
+
+	// ViewColumn is a column of a view.
+	// ID is a string such as "subject" for system fields or a number for custom fields.
+	ViewColumn struct {
+		ID    interface{} `json:"id"`
+		Title string      `json:"title"`
+		Type  string      `json:"type,omitempty"`
+		URL   string      `json:"url,omitempty"`
+	}
+
+	// ViewRestriction limits who can use a view.
+	// Type is "Group" or "User". A view restricted to several groups has them in IDs.
+	ViewRestriction struct {
+		Type string  `json:"type"`
+		ID   int64   `json:"id,omitempty"`
+		IDs  []int64 `json:"ids,omitempty"`
+	}
+
+	// ViewOutput is the display format used to create, update or preview a view
+	// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/views/#view-output
+	ViewOutput struct {
+		Columns    []interface{} `json:"columns,omitempty"`
+		GroupBy    string        `json:"group_by,omitempty"`
+		GroupOrder string        `json:"group_order,omitempty"`
+		SortBy     string        `json:"sort_by,omitempty"`
+		SortOrder  string        `json:"sort_order,omitempty"`
+	}
+
+	// ViewRows is the tickets of a view in the column/row format returned by
+	// ExecuteView and PreviewView
+	ViewRows struct {
+		View    *View        `json:"view,omitempty"`
+		Columns []ViewColumn `json:"columns"`
+		Rows    []ViewRow    `json:"rows"`
+		Groups  []ViewGroup  `json:"groups,omitempty"`
+		Page
+	}
+
+	// ViewRow is a row of a view.
+	// Values holds the value of each column keyed by the column ID.
+	ViewRow struct {
+		Ticket Ticket
+		Values map[string]interface{}
+	}
+
+	// ViewGroup is a group of rows when the view is grouped
+	ViewGroup struct {
+		ID    interface{} `json:"id"`
+		Name  string      `json:"name"`
+		Count int64       `json:"count"`
+	}
+
+	// ViewSearchOptions is options for SearchViews
+	// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/views/#search-views
+	ViewSearchOptions struct {
+		PageOptions
+		Query   string `url:"query"`
+		Access  string `url:"access,omitempty"`
+		Active  *bool  `url:"active,omitempty"`
+		GroupID int64  `url:"group_id,omitempty"`
+		Include string `url:"include,omitempty"`
+
+		// SortBy can take "alphabetical", "created_at", "updated_at" or "position"
+		SortBy    string `url:"sort_by,omitempty"`
+		SortOrder string `url:"sort_order,omitempty"`
+	}
+
+	// ViewExecuteOptions is options for ExecuteView
+	// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/views/#execute-view
+	ViewExecuteOptions struct {
+		PageOptions
+		SortBy    string `url:"sort_by,omitempty"`
+		SortOrder string `url:"sort_order,omitempty"`
+	}
+
+	// ViewCount is the ticket count of a view.
+	// The count is calculated in the background and Fresh is false until it is up to date.
 	ViewCount struct {
 		ViewID int64  `json:"view_id"`
 		URL    string `json:"url"`
@@ -39,6 +151,14 @@ type (
 		GetViews(context.Context) ([]View, Page, error)
 		GetTicketsFromView(context.Context, int64, *TicketListOptions) ([]Ticket, Page, error)
 		GetCountTicketsInViews(ctx context.Context, ids []string) ([]ViewCount, error)
+		GetCountTicketsInView(ctx context.Context, viewID int64) (ViewCount, error)
+		WaitCountTicketsInViews(ctx context.Context, ids []string, interval time.Duration) ([]ViewCount, error)
+		CreateView(ctx context.Context, view View) (View, error)
+		UpdateView(ctx context.Context, viewID int64, view View) (View, error)
+		DeleteView(ctx context.Context, viewID int64) error
+		SearchViews(ctx context.Context, opts *ViewSearchOptions) ([]View, Page, error)
+		ExecuteView(ctx context.Context, viewID int64, opts *ViewExecuteOptions) (ViewRows, error)
+		PreviewView(ctx context.Context, view View, opts *PageOptions) (ViewRows, error)
 		GetTicketsFromViewIterator(ctx context.Context, opts *PaginationOptions) *Iterator[Ticket]
 		GetTicketsFromViewOBP(ctx context.Context, opts *OBPOptions) ([]Ticket, Page, error)
 		GetTicketsFromViewCBP(ctx context.Context, opts *CBPOptions) ([]Ticket, CursorPaginationMeta, error)
@@ -139,3 +259,320 @@ func (z *Client) GetCountTicketsInViews(ctx context.Context, ids []string) ([]Vi
 	}
 	return result.ViewCounts, nil
 }
+
+// GetCountTicketsInView counts tickets in the specified view
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/views/#count-tickets-in-view
+func (z *Client) GetCountTicketsInView(ctx context.Context, viewID int64) (ViewCount, error) {
+	var result struct {
+		ViewCount ViewCount `json:"view_count"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/views/%d/count.json", viewID))
+	if err != nil {
+		return ViewCount{}, err
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return ViewCount{}, err
+	}
+	return result.ViewCount, nil
+}
+
+// WaitCountTicketsInViews polls the counts of the specified views every interval
+// until all of them are fresh or ctx is cancelled. The last fetched counts are returned in both cases.
+// interval must be positive.
+func (z *Client) WaitCountTicketsInViews(ctx context.Context, ids []string, interval time.Duration) ([]ViewCount, error) {
+	if interval <= 0 {
+		return nil, fmt.Errorf("interval must be positive, but got %s", interval)
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var last []ViewCount
+	for {
+		counts, err := z.GetCountTicketsInViews(ctx, ids)
+		if err != nil {
+			return last, err
+		}
+		last = counts
+
+		fresh := true
+		for _, count := range counts {
+			if !count.Fresh {
+				fresh = false
+				break
+			}
+		}
+		if fresh {
+			return counts, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return last, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// CreateView creates new view.
+// Conditions, Execution and Restriction of the view are sent in the format the API expects on write.
+// The view is added at the end of the list if Position is zero.
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/views/#create-view
+func (z *Client) CreateView(ctx context.Context, view View) (View, error) {
+	var data struct {
+		View viewPayload `json:"view"`
+	}
+	var result struct {
+		View View `json:"view"`
+	}
+	data.View = newViewPayload(view)
+
+	body, err := z.post(ctx, "/views.json", data)
+	if err != nil {
+		return View{}, err
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return View{}, err
+	}
+	return result.View, nil
+}
+
+// UpdateView updates the specified view.
+// A view fetched by GetView can be modified and passed as is.
+// The position of the view is always sent, so that it can be moved to the top.
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/views/#update-view
+func (z *Client) UpdateView(ctx context.Context, viewID int64, view View) (View, error) {
+	var data struct {
+		View viewPayload `json:"view"`
+	}
+	var result struct {
+		View View `json:"view"`
+	}
+	data.View = newViewPayload(view)
+	data.View.Position = &view.Position
+
+	body, err := z.put(ctx, fmt.Sprintf("/views/%d.json", viewID), data)
+	if err != nil {
+		return View{}, err
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return View{}, err
+	}
+	return result.View, nil
+}
+
+// DeleteView deletes the specified view
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/views/#delete-view
+func (z *Client) DeleteView(ctx context.Context, viewID int64) error {
+	err := z.delete(ctx, fmt.Sprintf("/views/%d.json", viewID))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SearchViews searches views by title
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/views/#search-views
+func (z *Client) SearchViews(ctx context.Context, opts *ViewSearchOptions) ([]View, Page, error) {
+	var result struct {
+		Views []View `json:"views"`
+		Page
+	}
+
+	if opts == nil {
+		return nil, Page{}, &OptionsError{opts}
+	}
+
+	u, err := addOptions("/views/search.json", opts)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, Page{}, err
+	}
+	return result.Views, result.Page, nil
+}
+
+// ExecuteView returns the tickets of the specified view in the column/row format
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/views/#execute-view
+func (z *Client) ExecuteView(ctx context.Context, viewID int64, opts *ViewExecuteOptions) (ViewRows, error) {
+	var result ViewRows
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &ViewExecuteOptions{}
+	}
+
+	u, err := addOptions(fmt.Sprintf("/views/%d/execute.json", viewID), tmp)
+	if err != nil {
+		return ViewRows{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return ViewRows{}, err
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return ViewRows{}, err
+	}
+	return result, nil
+}
+
+// PreviewView returns the tickets which would be listed by a view with the conditions
+// and the execution of the given view, without creating it
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/views/#preview-views
+func (z *Client) PreviewView(ctx context.Context, view View, opts *PageOptions) (ViewRows, error) {
+	var data struct {
+		View struct {
+			All    []TriggerCondition `json:"all"`
+			Any    []TriggerCondition `json:"any"`
+			Output ViewOutput         `json:"output"`
+		} `json:"view"`
+	}
+	var result ViewRows
+
+	payload := newViewPayload(view)
+	data.View.All = payload.All
+	data.View.Any = payload.Any
+	data.View.Output = payload.Output
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &PageOptions{}
+	}
+
+	u, err := addOptions("/views/preview.json", tmp)
+	if err != nil {
+		return ViewRows{}, err
+	}
+
+	body, err := z.post(ctx, u, data)
+	if err != nil {
+		return ViewRows{}, err
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return ViewRows{}, err
+	}
+	return result, nil
+}
+
+// Value returns the value of the specified column of the row
+func (r ViewRow) Value(column ViewColumn) interface{} {
+	key := fmt.Sprint(column.ID)
+	// numeric IDs of custom field columns are decoded as float64
+	if id, ok := column.ID.(float64); ok {
+		key = strconv.FormatFloat(id, 'f', -1, 64)
+	}
+	return r.Values[key]
+}
+
+// UnmarshalJSON separates the ticket of the row from the values of its columns
+func (r *ViewRow) UnmarshalJSON(data []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	r.Ticket = Ticket{}
+	r.Values = make(map[string]interface{}, len(raw))
+	for key, value := range raw {
+		if key == "ticket" {
+			if err := json.Unmarshal(value, &r.Ticket); err != nil {
+				return err
+			}
+			continue
+		}
+
+		var v interface{}
+		if err := json.Unmarshal(value, &v); err != nil {
+			return err
+		}
+		r.Values[key] = v
+	}
+	return nil
+}
+
+// UnmarshalJSON decodes the view and remembers its title, so that UpdateView
+// can tell whether the title has been changed since the view was fetched
+func (v *View) UnmarshalJSON(data []byte) error {
+	type view View
+	if err := json.Unmarshal(data, (*view)(v)); err != nil {
+		return err
+	}
+	v.fetchedTitle = v.Title
+	return nil
+}
+
+// viewPayload is the format of a view accepted by create and update endpoints,
+// which differs from the format returned by the API
+type viewPayload struct {
+	Title       string             `json:"title"`
+	RawTitle    string             `json:"raw_title,omitempty"`
+	Description string             `json:"description,omitempty"`
+	Active      bool               `json:"active"`
+	Position    *int64             `json:"position,omitempty"`
+	Restriction *ViewRestriction   `json:"restriction"`
+	All         []TriggerCondition `json:"all"`
+	Any         []TriggerCondition `json:"any"`
+	Output      ViewOutput         `json:"output"`
+}
+
+func newViewPayload(view View) viewPayload {
+	execution := view.Execution
+	output := ViewOutput{
+		GroupBy:    execution.GroupBy,
+		GroupOrder: execution.GroupOrder,
+		SortBy:     execution.SortBy,
+		SortOrder:  execution.SortOrder,
+	}
+	for _, column := range execution.Columns {
+		output.Columns = append(output.Columns, column.ID)
+	}
+
+	// all and any are sent even if empty to clear the conditions on update
+	conditionsAll, conditionsAny := view.Conditions.All, view.Conditions.Any
+	if conditionsAll == nil {
+		conditionsAll = []TriggerCondition{}
+	}
+	if conditionsAny == nil {
+		conditionsAny = []TriggerCondition{}
+	}
+
+	// raw_title keeps the dynamic content placeholders of the title,
+	// but it would override the title if the title has been changed
+	rawTitle := view.RawTitle
+	if view.Title != view.fetchedTitle {
+		rawTitle = ""
+	}
+
+	// a view created without position is added at the end of the list
+	var position *int64
+	if view.Position != 0 {
+		position = &view.Position
+	}
+
+	return viewPayload{
+		Title:       view.Title,
+		RawTitle:    rawTitle,
+		Description: view.Description,
+		Active:      view.Active,
+		Position:    position,
+		Restriction: view.Restriction,
+		All:         conditionsAll,
+		Any:         conditionsAny,
+		Output:      output,
+	}
+}
@@ -1,8 +1,14 @@
 package zendesk
 
 import (
+	"context"
+	"encoding/json"
 	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
 	"testing"
+	"time"
 )
 
 func TestGetView(t *testing.T) {
@@ -50,3 +56,349 @@ func TestGetCountTicketsInViewsTestGetViews(t *testing.T) {
 		t.Fatalf("expected length of views ticket counts is 2, but got %d", len(viewsCount))
 	}
 }
+
+func TestGetViewConditionsAndExecution(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "view.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	view, err := client.GetView(ctx, 360002440594)
+	if err != nil {
+		t.Fatalf("Failed to get view: %s", err)
+	}
+
+	if len(view.Conditions.All) != 2 || view.Conditions.All[0].Field != "status" {
+		t.Fatalf("unexpected conditions: %+v", view.Conditions)
+	}
+	if view.Execution.GroupBy != "status" || len(view.Execution.Columns) != 5 {
+		t.Fatalf("unexpected execution: %+v", view.Execution)
+	}
+	if view.Restriction != nil {
+		t.Fatalf("expected no restriction, but got %+v", view.Restriction)
+	}
+}
+
+func TestGetCountTicketsInView(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "view_count.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	count, err := client.GetCountTicketsInView(ctx, 25)
+	if err != nil {
+		t.Fatalf("Failed to get view ticket count: %s", err)
+	}
+
+	if count.Value != 719 || !count.Fresh {
+		t.Fatalf("unexpected view count: %+v", count)
+	}
+}
+
+func TestWaitCountTicketsInViews(t *testing.T) {
+	calls := 0
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if ids := r.URL.Query().Get("ids"); ids != "25,78" {
+			t.Errorf("unexpected ids: %s", ids)
+		}
+		fixture := "views_ticket_count.json"
+		if calls > 1 {
+			fixture = "views_ticket_count_fresh.json"
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, fixture)))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	counts, err := client.WaitCountTicketsInViews(ctx, []string{"25", "78"}, time.Millisecond)
+	if err != nil {
+		t.Fatalf("Failed to wait views ticket count: %s", err)
+	}
+
+	if calls != 2 {
+		t.Fatalf("expected 2 requests, but got %d", calls)
+	}
+	if counts[1].Value != 12 {
+		t.Fatalf("unexpected view count: %+v", counts[1])
+	}
+}
+
+func TestWaitCountTicketsInViewsCancelled(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "views_ticket_count.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	cctx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+
+	counts, err := client.WaitCountTicketsInViews(cctx, []string{"25", "78"}, time.Millisecond)
+	if err == nil {
+		t.Fatal("expected an error, but no error")
+	}
+	if len(counts) != 2 || counts[1].Fresh {
+		t.Fatalf("expected the last stale counts, but got %+v", counts)
+	}
+}
+
+func TestWaitCountTicketsInViewsInvalidInterval(t *testing.T) {
+	client, _ := NewClient(nil)
+
+	_, err := client.WaitCountTicketsInViews(ctx, []string{"25", "78"}, 0)
+	if err == nil {
+		t.Fatal("expected an error for non-positive interval")
+	}
+}
+
+func TestCreateView(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data struct {
+			View map[string]json.RawMessage `json:"view"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+			t.Errorf("Failed to decode request body: %s", err)
+		}
+		if _, ok := data.View["conditions"]; ok {
+			t.Error("conditions must be sent as all and any")
+		}
+		if string(data.View["any"]) != "[]" {
+			t.Errorf("unexpected any: %s", data.View["any"])
+		}
+		var output ViewOutput
+		if err := json.Unmarshal(data.View["output"], &output); err != nil {
+			t.Errorf("Failed to decode output: %s", err)
+		}
+		if !reflect.DeepEqual(output.Columns, []interface{}{"subject", float64(360001234567)}) || output.GroupBy != "status" {
+			t.Errorf("unexpected output: %+v", output)
+		}
+		if _, ok := data.View["position"]; ok {
+			t.Errorf("position must not be sent for a view without position: %s", data.View["position"])
+		}
+		if string(data.View["restriction"]) != `{"type":"Group","id":123}` {
+			t.Errorf("unexpected restriction: %s", data.View["restriction"])
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write(readFixture(filepath.Join(http.MethodPost, "view.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	view := View{
+		Title:  "Wonderful tickets",
+		Active: true,
+		Conditions: ViewConditions{
+			All: []TriggerCondition{{Field: "status", Operator: "less_than", Value: "solved"}},
+		},
+		Execution: ViewExecution{
+			GroupBy: "status",
+			Columns: []ViewColumn{{ID: "subject"}, {ID: int64(360001234567)}},
+		},
+		Restriction: &ViewRestriction{Type: "Group", ID: 123},
+	}
+
+	created, err := client.CreateView(ctx, view)
+	if err != nil {
+		t.Fatalf("Failed to create view: %s", err)
+	}
+
+	if created.ID != 360002440594 {
+		t.Fatalf("unexpected view id: %d", created.ID)
+	}
+}
+
+func TestUpdateViewRoundTrip(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			w.Write(readFixture(filepath.Join(http.MethodGet, "view.json")))
+			return
+		}
+
+		if r.URL.Path != "/views/360002440594.json" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		var data struct {
+			View struct {
+				RawTitle string             `json:"raw_title"`
+				Position *int64             `json:"position"`
+				All      []TriggerCondition `json:"all"`
+				Output   ViewOutput         `json:"output"`
+			} `json:"view"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+			t.Errorf("Failed to decode request body: %s", err)
+		}
+		if len(data.View.All) != 2 || len(data.View.Output.Columns) != 5 || data.View.Output.SortBy != "nice_id" {
+			t.Errorf("view was not round-tripped: %+v", data.View)
+		}
+		if data.View.RawTitle != "{{zd.your_wonderful_tickets}}" {
+			t.Errorf("unexpected raw title: %s", data.View.RawTitle)
+		}
+		if data.View.Position == nil || *data.View.Position != 0 {
+			t.Errorf("position 0 must be sent on update: %v", data.View.Position)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodPut, "view.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	view, err := client.GetView(ctx, 360002440594)
+	if err != nil {
+		t.Fatalf("Failed to get view: %s", err)
+	}
+
+	_, err = client.UpdateView(ctx, view.ID, view)
+	if err != nil {
+		t.Fatalf("Failed to update view: %s", err)
+	}
+}
+
+func TestUpdateViewRename(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			w.Write(readFixture(filepath.Join(http.MethodGet, "view.json")))
+			return
+		}
+
+		var data struct {
+			View map[string]json.RawMessage `json:"view"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+			t.Errorf("Failed to decode request body: %s", err)
+		}
+		if string(data.View["title"]) != `"Renamed tickets"` {
+			t.Errorf("unexpected title: %s", data.View["title"])
+		}
+		if _, ok := data.View["raw_title"]; ok {
+			t.Errorf("raw title of the renamed view must not be sent: %s", data.View["raw_title"])
+		}
+		w.Write(readFixture(filepath.Join(http.MethodPut, "view.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	view, err := client.GetView(ctx, 360002440594)
+	if err != nil {
+		t.Fatalf("Failed to get view: %s", err)
+	}
+
+	view.Title = "Renamed tickets"
+	_, err = client.UpdateView(ctx, view.ID, view)
+	if err != nil {
+		t.Fatalf("Failed to update view: %s", err)
+	}
+}
+
+func TestDeleteView(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	err := client.DeleteView(ctx, 360002440594)
+	if err != nil {
+		t.Fatalf("Failed to delete view: %s", err)
+	}
+}
+
+func TestSearchViews(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/views/search.json" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if q := r.URL.Query().Get("query"); q != "wonderful" {
+			t.Errorf("unexpected query: %s", q)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "views.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	views, _, err := client.SearchViews(ctx, &ViewSearchOptions{Query: "wonderful"})
+	if err != nil {
+		t.Fatalf("Failed to search views: %s", err)
+	}
+
+	if len(views) != 2 {
+		t.Fatalf("expected length of views is 2, but got %d", len(views))
+	}
+}
+
+func TestExecuteView(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/views/360002440594/execute.json" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if sortBy := r.URL.Query().Get("sort_by"); sortBy != "subject" {
+			t.Errorf("unexpected sort_by: %s", sortBy)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "view_execute.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	rows, err := client.ExecuteView(ctx, 360002440594, &ViewExecuteOptions{SortBy: "subject"})
+	if err != nil {
+		t.Fatalf("Failed to execute view: %s", err)
+	}
+
+	if len(rows.Columns) != 3 || len(rows.Rows) != 2 {
+		t.Fatalf("unexpected rows: %+v", rows)
+	}
+	if rows.Count != 2 {
+		t.Fatalf("expected count is 2, but got %d", rows.Count)
+	}
+
+	row := rows.Rows[0]
+	if row.Ticket.ID != 35436 {
+		t.Fatalf("expected ticket id is 35436, but got %d", row.Ticket.ID)
+	}
+	if v := row.Value(rows.Columns[0]); v != "Help, my printer is on fire!" {
+		t.Fatalf("unexpected subject: %v", v)
+	}
+	if v := row.Value(rows.Columns[2]); v != "printer" {
+		t.Fatalf("unexpected custom field value: %v", v)
+	}
+	if _, ok := row.Values["ticket"]; ok {
+		t.Fatal("ticket must not be included in values")
+	}
+}
+
+func TestPreviewView(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/views/preview.json" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		var data struct {
+			View map[string]json.RawMessage `json:"view"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+			t.Errorf("Failed to decode request body: %s", err)
+		}
+		if _, ok := data.View["title"]; ok {
+			t.Error("title must not be sent to preview")
+		}
+		if _, ok := data.View["output"]; !ok {
+			t.Error("output must be sent to preview")
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "view_execute.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	view := View{
+		Conditions: ViewConditions{
+			All: []TriggerCondition{{Field: "status", Operator: "is", Value: "open"}},
+		},
+		Execution: ViewExecution{
+			Columns: []ViewColumn{{ID: "subject"}, {ID: "requester"}},
+		},
+	}
+
+	rows, err := client.PreviewView(ctx, view, nil)
+	if err != nil {
+		t.Fatalf("Failed to preview view: %s", err)
+	}
+
+	if len(rows.Rows) != 2 {
+		t.Fatalf("expected length of rows is 2, but got %d", len(rows.Rows))
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"time"
 )
 
@@ -38,12 +39,173 @@ type WebhookSigningSecret struct {
 	Secret    string `json:"secret"`
 }
 
+// WebhookListOptions is options for GetWebhooks
+//
+// ref: https://developer.zendesk.com/api-reference/event-connectors/webhooks/webhooks/#list-webhooks
+type WebhookListOptions struct {
+	CursorPagination
+
+	// NameContains filters webhooks by a case-insensitive substring of the name
+	NameContains string `url:"filter[name_contains],omitempty"`
+
+	// Status filters webhooks by status, "active" or "inactive"
+	Status string `url:"filter[status],omitempty"`
+
+	// Sort is one of "name", "status" and the same prefixed with "-" for descending order
+	Sort string `url:"sort,omitempty"`
+}
+
+// WebhookPatch is a partial update of a webhook. Only the non-nil fields are changed.
+//
+// ref: https://developer.zendesk.com/api-reference/event-connectors/webhooks/webhooks/#patch-webhook
+type WebhookPatch struct {
+	Authentication *WebhookAuthentication `json:"authentication,omitempty"`
+	Description    *string                `json:"description,omitempty"`
+	Endpoint       *string                `json:"endpoint,omitempty"`
+	HTTPMethod     *string                `json:"http_method,omitempty"`
+	Name           *string                `json:"name,omitempty"`
+	RequestFormat  *string                `json:"request_format,omitempty"`
+	Status         *string                `json:"status,omitempty"`
+	Subscriptions  []string               `json:"subscriptions,omitempty"`
+}
+
+// WebhookHeader is a HTTP header of a test request or response
+type WebhookHeader struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// WebhookTestRequest is the request sent by TestWebhook
+//
+// ref: https://developer.zendesk.com/api-reference/event-connectors/webhooks/webhooks/#test-webhook
+type WebhookTestRequest struct {
+	Authentication *WebhookAuthentication `json:"authentication,omitempty"`
+	Endpoint       string                 `json:"endpoint,omitempty"`
+	HTTPMethod     string                 `json:"http_method,omitempty"`
+	RequestFormat  string                 `json:"request_format,omitempty"`
+	Headers        []WebhookHeader        `json:"headers,omitempty"`
+	Payload        string                 `json:"payload,omitempty"`
+}
+
+// WebhookTestResponse is the response of the endpoint to a test request
+type WebhookTestResponse struct {
+	Status  int             `json:"status"`
+	Headers []WebhookHeader `json:"headers"`
+	Body    string          `json:"body"`
+}
+
+// WebhookInvocationStatus is the result of a webhook invocation or attempt
+type WebhookInvocationStatus string
+
+// Webhook invocation statuses. Other statuses may be returned by the API.
+const (
+	WebhookInvocationSuccess WebhookInvocationStatus = "success"
+	WebhookInvocationFailed  WebhookInvocationStatus = "failed"
+)
+
+// WebhookInvocation is a request of a webhook, which may be attempted several times
+//
+// ref: https://developer.zendesk.com/api-reference/event-connectors/webhooks/webhook-invocations/
+type WebhookInvocation struct {
+	ID                string                  `json:"id"`
+	LatestCompletedAt time.Time               `json:"latest_completed_at"`
+	Status            WebhookInvocationStatus `json:"status"`
+	StatusCode        int                     `json:"status_code"`
+}
+
+// WebhookInvocationListOptions is options for GetWebhookInvocations
+//
+// ref: https://developer.zendesk.com/api-reference/event-connectors/webhooks/webhook-invocations/#list-webhook-invocations
+type WebhookInvocationListOptions struct {
+	CursorPagination
+
+	// FromTS and ToTS filter invocations by the time they were completed
+	FromTS time.Time `url:"filter[from_ts],omitempty"`
+	ToTS   time.Time `url:"filter[to_ts],omitempty"`
+
+	// Status filters invocations by status
+	Status WebhookInvocationStatus `url:"filter[status],omitempty"`
+
+	// Sort is "latest_completed_at" or "-latest_completed_at" for descending order
+	Sort string `url:"sort,omitempty"`
+}
+
+// WebhookAttemptMessage is the request or the response of an attempt
+type WebhookAttemptMessage struct {
+	Headers []WebhookHeader `json:"headers,omitempty"`
+	Payload string          `json:"payload,omitempty"`
+
+	// Status is the HTTP status code of the response
+	Status int `json:"status,omitempty"`
+}
+
+// WebhookInvocationAttempt is an attempt to send an invocation to the endpoint
+//
+// ref: https://developer.zendesk.com/api-reference/event-connectors/webhooks/webhook-invocations/#list-webhook-invocation-attempts
+type WebhookInvocationAttempt struct {
+	ID           string                  `json:"id"`
+	InvocationID string                  `json:"invocation_id"`
+	Status       WebhookInvocationStatus `json:"status"`
+	CompletedAt  time.Time               `json:"completed_at"`
+
+	// Latency is the response time of the endpoint in milliseconds
+	Latency int64 `json:"latency"`
+
+	Request  *WebhookAttemptMessage `json:"request,omitempty"`
+	Response *WebhookAttemptMessage `json:"response,omitempty"`
+}
+
+// LatencyDuration returns the response time of the endpoint
+func (a WebhookInvocationAttempt) LatencyDuration() time.Duration {
+	return time.Duration(a.Latency) * time.Millisecond
+}
+
 type WebhookAPI interface {
+	GetWebhooks(ctx context.Context, opts *WebhookListOptions) ([]Webhook, CursorPaginationMeta, error)
 	CreateWebhook(ctx context.Context, hook *Webhook) (*Webhook, error)
+	CloneWebhook(ctx context.Context, webhookID string) (*Webhook, error)
 	GetWebhook(ctx context.Context, webhookID string) (*Webhook, error)
 	UpdateWebhook(ctx context.Context, webhookID string, hook *Webhook) error
+	PatchWebhook(ctx context.Context, webhookID string, patch *WebhookPatch) error
 	DeleteWebhook(ctx context.Context, webhookID string) error
+	TestWebhook(ctx context.Context, webhookID string, req *WebhookTestRequest) (*WebhookTestResponse, error)
 	GetWebhookSigningSecret(ctx context.Context, webhookID string) (*WebhookSigningSecret, error)
+	ResetWebhookSigningSecret(ctx context.Context, webhookID string) (*WebhookSigningSecret, error)
+	GetWebhookInvocations(
+		ctx context.Context, webhookID string, opts *WebhookInvocationListOptions,
+	) ([]WebhookInvocation, CursorPaginationMeta, error)
+	GetWebhookInvocationAttempts(ctx context.Context, webhookID, invocationID string) ([]WebhookInvocationAttempt, error)
+}
+
+// GetWebhooks lists webhooks. The endpoint supports cursor based pagination only.
+//
+// https://developer.zendesk.com/api-reference/event-connectors/webhooks/webhooks/#list-webhooks
+func (z *Client) GetWebhooks(ctx context.Context, opts *WebhookListOptions) ([]Webhook, CursorPaginationMeta, error) {
+	var result struct {
+		Webhooks []Webhook            `json:"webhooks"`
+		Meta     CursorPaginationMeta `json:"meta"`
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &WebhookListOptions{}
+	}
+
+	u, err := addOptions("/webhooks", tmp)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+	return result.Webhooks, result.Meta, nil
 }
 
 // CreateWebhook creates new webhook.
@@ -67,6 +229,28 @@ func (z *Client) CreateWebhook(ctx context.Context, hook *Webhook) (*Webhook, er
 	return result.Webhook, nil
 }
 
+// CloneWebhook creates a copy of the specified webhook, including its authentication
+// and signing secret. The copy only has its ID filled in.
+//
+// https://developer.zendesk.com/api-reference/event-connectors/webhooks/webhooks/#create-or-clone-webhook
+func (z *Client) CloneWebhook(ctx context.Context, webhookID string) (*Webhook, error) {
+	var result struct {
+		Webhook *Webhook `json:"webhook"`
+	}
+
+	u := fmt.Sprintf("/webhooks?clone_webhook_id=%s", url.QueryEscape(webhookID))
+	body, err := z.post(ctx, u, struct{}{})
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Webhook, nil
+}
+
 // GetWebhook gets a specified webhook.
 //
 // https://developer.zendesk.com/api-reference/event-connectors/webhooks/webhooks/#show-webhook
@@ -105,6 +289,23 @@ func (z *Client) UpdateWebhook(ctx context.Context, webhookID string, hook *Webh
 	return nil
 }
 
+// PatchWebhook changes the fields of the specified webhook which are set in patch.
+//
+// https://developer.zendesk.com/api-reference/event-connectors/webhooks/webhooks/#patch-webhook
+func (z *Client) PatchWebhook(ctx context.Context, webhookID string, patch *WebhookPatch) error {
+	var data struct {
+		Webhook *WebhookPatch `json:"webhook"`
+	}
+	data.Webhook = patch
+
+	_, err := z.patch(ctx, fmt.Sprintf("/webhooks/%s", webhookID), data)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // DeleteWebhook deletes the specified webhook.
 //
 // https://developer.zendesk.com/api-reference/event-connectors/webhooks/webhooks/#delete-webhook
@@ -137,3 +338,113 @@ func (z *Client) GetWebhookSigningSecret(ctx context.Context, webhookID string)
 
 	return result.SigningSecret, nil
 }
+
+// ResetWebhookSigningSecret replaces the signing secret of specified webhook and returns the new one.
+//
+// https://developer.zendesk.com/api-reference/event-connectors/webhooks/webhooks/#reset-webhook-signing-secret
+func (z *Client) ResetWebhookSigningSecret(ctx context.Context, webhookID string) (*WebhookSigningSecret, error) {
+	var result struct {
+		SigningSecret *WebhookSigningSecret `json:"signing_secret"`
+	}
+
+	body, err := z.post(ctx, fmt.Sprintf("/webhooks/%s/signing_secret", webhookID), struct{}{})
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.SigningSecret, nil
+}
+
+// TestWebhook sends a test request and returns the response of the endpoint.
+// If webhookID is not empty, the fields missing in req are taken from the webhook,
+// and the request is signed with its signing secret.
+//
+// https://developer.zendesk.com/api-reference/event-connectors/webhooks/webhooks/#test-webhook
+func (z *Client) TestWebhook(ctx context.Context, webhookID string, req *WebhookTestRequest) (*WebhookTestResponse, error) {
+	var data struct {
+		Request *WebhookTestRequest `json:"request"`
+	}
+	var result struct {
+		Response *WebhookTestResponse `json:"response"`
+	}
+	data.Request = req
+
+	u := "/webhooks/test"
+	if webhookID != "" {
+		u += "?webhook_id=" + url.QueryEscape(webhookID)
+	}
+
+	body, err := z.post(ctx, u, data)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Response, nil
+}
+
+// GetWebhookInvocations lists the invocations of specified webhook.
+// The endpoint supports cursor based pagination only.
+//
+// https://developer.zendesk.com/api-reference/event-connectors/webhooks/webhook-invocations/#list-webhook-invocations
+func (z *Client) GetWebhookInvocations(
+	ctx context.Context, webhookID string, opts *WebhookInvocationListOptions,
+) ([]WebhookInvocation, CursorPaginationMeta, error) {
+	var result struct {
+		Invocations []WebhookInvocation  `json:"invocations"`
+		Meta        CursorPaginationMeta `json:"meta"`
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &WebhookInvocationListOptions{}
+	}
+
+	u, err := addOptions(fmt.Sprintf("/webhooks/%s/invocations", webhookID), tmp)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+	return result.Invocations, result.Meta, nil
+}
+
+// GetWebhookInvocationAttempts lists the attempts of specified invocation,
+// with the request sent and the response of the endpoint.
+//
+// https://developer.zendesk.com/api-reference/event-connectors/webhooks/webhook-invocations/#list-webhook-invocation-attempts
+func (z *Client) GetWebhookInvocationAttempts(
+	ctx context.Context, webhookID, invocationID string,
+) ([]WebhookInvocationAttempt, error) {
+	var result struct {
+		Attempts []WebhookInvocationAttempt `json:"attempts"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/webhooks/%s/invocations/%s/attempts", webhookID, invocationID))
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Attempts, nil
+}
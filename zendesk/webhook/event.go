@@ -0,0 +1,359 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// EventTypePrefix is the prefix of event types, which is also used in webhook subscriptions
+const EventTypePrefix = "zen:event-type:"
+
+// User event types
+const (
+	EventUserCreated                       = "zen:event-type:user.created"
+	EventUserDeleted                       = "zen:event-type:user.deleted"
+	EventUserMerged                        = "zen:event-type:user.merged"
+	EventUserActiveChanged                 = "zen:event-type:user.active_changed"
+	EventUserAliasChanged                  = "zen:event-type:user.alias_changed"
+	EventUserCustomFieldChanged            = "zen:event-type:user.custom_field_changed"
+	EventUserCustomRoleChanged             = "zen:event-type:user.custom_role_changed"
+	EventUserDefaultGroupChanged           = "zen:event-type:user.default_group_changed"
+	EventUserDetailsChanged                = "zen:event-type:user.details_changed"
+	EventUserEmailIdentityChanged          = "zen:event-type:user.email_identity_changed"
+	EventUserExternalIDChanged             = "zen:event-type:user.external_id_changed"
+	EventUserGroupMembershipCreated        = "zen:event-type:user.group_membership_created"
+	EventUserGroupMembershipDeleted        = "zen:event-type:user.group_membership_deleted"
+	EventUserLastLoginChanged              = "zen:event-type:user.last_login_changed"
+	EventUserNameChanged                   = "zen:event-type:user.name_changed"
+	EventUserNotesChanged                  = "zen:event-type:user.notes_changed"
+	EventUserOrganizationMembershipCreated = "zen:event-type:user.organization_membership_created"
+	EventUserOrganizationMembershipDeleted = "zen:event-type:user.organization_membership_deleted"
+	EventUserRoleChanged                   = "zen:event-type:user.role_changed"
+	EventUserTagsChanged                   = "zen:event-type:user.tags_changed"
+	EventUserTimeZoneChanged               = "zen:event-type:user.time_zone_changed"
+)
+
+// Organization event types
+const (
+	EventOrganizationCreated            = "zen:event-type:organization.created"
+	EventOrganizationDeleted            = "zen:event-type:organization.deleted"
+	EventOrganizationCustomFieldChanged = "zen:event-type:organization.custom_field_changed"
+	EventOrganizationExternalIDChanged  = "zen:event-type:organization.external_id_changed"
+	EventOrganizationNameChanged        = "zen:event-type:organization.name_changed"
+	EventOrganizationTagsChanged        = "zen:event-type:organization.tags_changed"
+)
+
+// Ticket event types
+const (
+	EventTicketCreated                = "zen:event-type:ticket.created"
+	EventTicketSoftDeleted            = "zen:event-type:ticket.soft_deleted"
+	EventTicketPermanentlyDeleted     = "zen:event-type:ticket.permanently_deleted"
+	EventTicketMerged                 = "zen:event-type:ticket.merged"
+	EventTicketAgentAssignmentChanged = "zen:event-type:ticket.agent_assignment_changed"
+	EventTicketGroupAssignmentChanged = "zen:event-type:ticket.group_assignment_changed"
+	EventTicketBrandChanged           = "zen:event-type:ticket.brand_changed"
+	EventTicketCommentAdded           = "zen:event-type:ticket.comment_added"
+	EventTicketCustomFieldChanged     = "zen:event-type:ticket.custom_field_changed"
+	EventTicketCustomStatusChanged    = "zen:event-type:ticket.custom_status_changed"
+	EventTicketDescriptionChanged     = "zen:event-type:ticket.description_changed"
+	EventTicketExternalIDChanged      = "zen:event-type:ticket.external_id_changed"
+	EventTicketFormChanged            = "zen:event-type:ticket.form_changed"
+	EventTicketOrganizationChanged    = "zen:event-type:ticket.organization_changed"
+	EventTicketPriorityChanged        = "zen:event-type:ticket.priority_changed"
+	EventTicketRequesterChanged       = "zen:event-type:ticket.requester_changed"
+	EventTicketStatusChanged          = "zen:event-type:ticket.status_changed"
+	EventTicketSubjectChanged         = "zen:event-type:ticket.subject_changed"
+	EventTicketTagsChanged            = "zen:event-type:ticket.tags_changed"
+	EventTicketUndeleted              = "zen:event-type:ticket.undeleted"
+	EventTicketMarkedAsSpam           = "zen:event-type:ticket.marked_as_spam"
+)
+
+// Families of event types
+const (
+	FamilyUser         = "user"
+	FamilyOrganization = "organization"
+	FamilyTicket       = "ticket"
+)
+
+// EventFamily returns the family of the event type, such as "user" for
+// "zen:event-type:user.created", or an empty string if the type is malformed
+func EventFamily(eventType string) string {
+	if !strings.HasPrefix(eventType, EventTypePrefix) {
+		return ""
+	}
+	family, _, ok := strings.Cut(strings.TrimPrefix(eventType, EventTypePrefix), ".")
+	if !ok {
+		return ""
+	}
+	return family
+}
+
+// EventHeader is the part of event payloads common to all event types
+//
+// ref: https://developer.zendesk.com/api-reference/webhooks/event-types/webhook-event-types/
+type EventHeader struct {
+	Type                string    `json:"type"`
+	AccountID           int64     `json:"account_id"`
+	ID                  string    `json:"id"`
+	Subject             string    `json:"subject"`
+	Time                time.Time `json:"time"`
+	ZendeskEventVersion string    `json:"zendesk_event_version"`
+}
+
+// Event is an event payload whose detail and event data are kept as raw JSON.
+// It's used for the event types which have no typed payload.
+type Event struct {
+	EventHeader
+	Detail json.RawMessage `json:"detail"`
+	Event  json.RawMessage `json:"event"`
+}
+
+// Change is the event data of events changing a single value, such as
+// user.name_changed. The type of the values depends on the event type.
+type Change struct {
+	Current  json.RawMessage `json:"current,omitempty"`
+	Previous json.RawMessage `json:"previous,omitempty"`
+}
+
+// Decode decodes the current and previous values of the change
+func (c Change) Decode(current, previous interface{}) error {
+	if len(c.Current) > 0 && current != nil {
+		if err := json.Unmarshal(c.Current, current); err != nil {
+			return err
+		}
+	}
+	if len(c.Previous) > 0 && previous != nil {
+		if err := json.Unmarshal(c.Previous, previous); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// EventRef refers to a record in event data, such as the group of a group membership
+type EventRef struct {
+	ID   string `json:"id"`
+	Name string `json:"name,omitempty"`
+}
+
+// EventField is the custom field changed by *.custom_field_changed events
+type EventField struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+	Type  string `json:"type"`
+}
+
+// TagsChange is the event data of *.tags_changed events
+type TagsChange struct {
+	TagsAdded   []string `json:"tags_added,omitempty"`
+	TagsRemoved []string `json:"tags_removed,omitempty"`
+}
+
+// UserDetail is the user an event is about
+type UserDetail struct {
+	ID             string    `json:"id"`
+	Email          string    `json:"email"`
+	ExternalID     string    `json:"external_id"`
+	DefaultGroupID string    `json:"default_group_id"`
+	OrganizationID string    `json:"organization_id"`
+	Role           string    `json:"role"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// UserEventData is the event data of user events. Only the fields used by the
+// event type are set.
+type UserEventData struct {
+	Change
+	TagsChange
+
+	// Field is set by user.custom_field_changed
+	Field *EventField `json:"field,omitempty"`
+
+	// Group is set by user.group_membership_* events
+	Group *EventRef `json:"group,omitempty"`
+
+	// Organization is set by user.organization_membership_* events
+	Organization *EventRef `json:"organization,omitempty"`
+}
+
+// UserEvent is the payload of user events
+type UserEvent struct {
+	EventHeader
+	Detail UserDetail    `json:"detail"`
+	Event  UserEventData `json:"event"`
+}
+
+// OrganizationDetail is the organization an event is about
+type OrganizationDetail struct {
+	ID             string    `json:"id"`
+	Name           string    `json:"name"`
+	ExternalID     string    `json:"external_id"`
+	GroupID        string    `json:"group_id"`
+	SharedComments bool      `json:"shared_comments"`
+	SharedTickets  bool      `json:"shared_tickets"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// OrganizationEventData is the event data of organization events. Only the
+// fields used by the event type are set.
+type OrganizationEventData struct {
+	Change
+	TagsChange
+
+	// Field is set by organization.custom_field_changed
+	Field *EventField `json:"field,omitempty"`
+}
+
+// OrganizationEvent is the payload of organization events
+type OrganizationEvent struct {
+	EventHeader
+	Detail OrganizationDetail    `json:"detail"`
+	Event  OrganizationEventData `json:"event"`
+}
+
+// TicketVia is the channel a ticket was created from
+type TicketVia struct {
+	Channel string `json:"channel"`
+}
+
+// TicketDetail is the ticket an event is about
+type TicketDetail struct {
+	ID             string    `json:"id"`
+	ActorID        string    `json:"actor_id"`
+	AssigneeID     string    `json:"assignee_id"`
+	BrandID        string    `json:"brand_id"`
+	CustomStatus   string    `json:"custom_status"`
+	Description    string    `json:"description"`
+	ExternalID     string    `json:"external_id"`
+	FormID         string    `json:"form_id"`
+	GroupID        string    `json:"group_id"`
+	IsPublic       bool      `json:"is_public"`
+	OrganizationID string    `json:"organization_id"`
+	Priority       string    `json:"priority"`
+	RequesterID    string    `json:"requester_id"`
+	Status         string    `json:"status"`
+	Subject        string    `json:"subject"`
+	SubmitterID    string    `json:"submitter_id"`
+	Tags           []string  `json:"tags"`
+	Type           string    `json:"type"`
+	Via            TicketVia `json:"via"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// CommentAuthor is the author of a comment added to a ticket
+type CommentAuthor struct {
+	ID      string `json:"id"`
+	IsStaff bool   `json:"is_staff"`
+	Name    string `json:"name"`
+}
+
+// TicketComment is the comment added by ticket.comment_added
+type TicketComment struct {
+	ID       string        `json:"id"`
+	Body     string        `json:"body"`
+	IsPublic bool          `json:"is_public"`
+	Author   CommentAuthor `json:"author"`
+}
+
+// TicketEventData is the event data of ticket events. Only the fields used by
+// the event type are set.
+type TicketEventData struct {
+	Change
+	TagsChange
+
+	// Field is set by ticket.custom_field_changed
+	Field *EventField `json:"field,omitempty"`
+
+	// Comment is set by ticket.comment_added
+	Comment *TicketComment `json:"comment,omitempty"`
+}
+
+// TicketEvent is the payload of ticket events
+type TicketEvent struct {
+	EventHeader
+	Detail TicketDetail    `json:"detail"`
+	Event  TicketEventData `json:"event"`
+}
+
+// Dispatcher decodes event payloads and calls the handler of their family.
+// Events are routed by family, not by type: event types of a family which are
+// not listed above, such as ones added by Zendesk later, are also passed to the
+// handler of the family, so check Type before reading the event data.
+// Events of other families, or of a family without handler, are passed to Raw,
+// as well as events which can't be decoded into the payload of their family.
+//
+//	d := &webhook.Dispatcher{
+//		Ticket: func(ctx context.Context, e *webhook.TicketEvent) error {
+//			if e.Type == webhook.EventTicketStatusChanged { ... }
+//			return nil
+//		},
+//	}
+//	http.Handle("/zendesk/events", d.Handler(verifier))
+type Dispatcher struct {
+	User         func(ctx context.Context, e *UserEvent) error
+	Organization func(ctx context.Context, e *OrganizationEvent) error
+	Ticket       func(ctx context.Context, e *TicketEvent) error
+
+	// Raw handles the events without typed handler. They are ignored if it's nil.
+	Raw func(ctx context.Context, e *Event) error
+}
+
+// Dispatch decodes the body and calls the handler for its event type.
+// The error wraps ErrInvalidPayload when the body can't be decoded.
+func (d *Dispatcher) Dispatch(ctx context.Context, body []byte) error {
+	var header EventHeader
+	if err := json.Unmarshal(body, &header); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidPayload, err)
+	}
+
+	var err error
+	switch family := EventFamily(header.Type); {
+	case family == FamilyUser && d.User != nil:
+		var e *UserEvent
+		if e, err = decodeEvent[UserEvent](body); err == nil {
+			return d.User(ctx, e)
+		}
+	case family == FamilyOrganization && d.Organization != nil:
+		var e *OrganizationEvent
+		if e, err = decodeEvent[OrganizationEvent](body); err == nil {
+			return d.Organization(ctx, e)
+		}
+	case family == FamilyTicket && d.Ticket != nil:
+		var e *TicketEvent
+		if e, err = decodeEvent[TicketEvent](body); err == nil {
+			return d.Ticket(ctx, e)
+		}
+	}
+
+	// events which can't be decoded into the payload of their family fall back to Raw
+	if d.Raw == nil {
+		return err
+	}
+	e, err := decodeEvent[Event](body)
+	if err != nil {
+		return err
+	}
+	return d.Raw(ctx, e)
+}
+
+func decodeEvent[T any](body []byte) (*T, error) {
+	var e T
+	if err := json.Unmarshal(body, &e); err != nil {
+		return nil, fmt.Errorf("%w: failed to decode event: %s", ErrInvalidPayload, err)
+	}
+	return &e, nil
+}
+
+// Handler returns a Handler verifying requests with v and dispatching their events
+func (d *Dispatcher) Handler(v *Verifier) http.Handler {
+	return NewHandler(v, func(ctx context.Context, body json.RawMessage) error {
+		return d.Dispatch(ctx, body)
+	})
+}
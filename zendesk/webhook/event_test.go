@@ -0,0 +1,209 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+var (
+	ctx = context.Background()
+
+	userCreatedBody = []byte(`{
+  "account_id": 12514403,
+  "detail": {
+    "created_at": "2023-02-14T20:01:33Z",
+    "default_group_id": null,
+    "email": "john@example.com",
+    "external_id": null,
+    "id": "7537529318399",
+    "organization_id": null,
+    "role": "end-user",
+    "updated_at": "2023-02-14T20:01:33Z"
+  },
+  "event": {},
+  "id": "cbe4028c-7239-495d-b020-f22348516046",
+  "subject": "zen:user:7537529318399",
+  "time": "2023-02-14T20:01:33.836839551Z",
+  "type": "zen:event-type:user.created",
+  "zendesk_event_version": "2022-11-06"
+}`)
+
+	ticketStatusChangedBody = []byte(`{
+  "account_id": 12514403,
+  "detail": {
+    "actor_id": "8447388090494",
+    "assignee_id": "8447388090494",
+    "brand_id": "8447346621310",
+    "created_at": "2024-05-30T12:21:32Z",
+    "custom_status": "8447388090626",
+    "description": "Printer is on fire",
+    "form_id": "8646151517822",
+    "group_id": "8447320466430",
+    "id": "35436",
+    "is_public": true,
+    "priority": "LOW",
+    "requester_id": "8447388090494",
+    "status": "OPEN",
+    "subject": "Help, my printer is on fire!",
+    "submitter_id": "8447388090494",
+    "tags": ["printer"],
+    "type": "TASK",
+    "updated_at": "2024-05-30T12:22:05Z",
+    "via": {"channel": "web_service"}
+  },
+  "event": {"current": "OPEN", "previous": "NEW"},
+  "id": "7c0a1d3f-d37c-4d3d-8a3e-6ba2c7e27e25",
+  "subject": "zen:ticket:35436",
+  "time": "2024-05-30T12:22:05.000000000Z",
+  "type": "zen:event-type:ticket.status_changed",
+  "zendesk_event_version": "2022-11-06"
+}`)
+
+	articlePublishedBody = []byte(`{
+  "account_id": 12514403,
+  "detail": {"brand_id": "8447346621310", "id": "360000123456"},
+  "event": {"author_id": "8447388090494", "locale": "en-us"},
+  "id": "a6cc1b2d-3e45-4f67-8a9b-0c1d2e3f4a5b",
+  "subject": "zen:article:360000123456",
+  "time": "2024-05-30T12:22:05.000000000Z",
+  "type": "zen:event-type:article.published",
+  "zendesk_event_version": "2022-11-06"
+}`)
+)
+
+func TestEventFamily(t *testing.T) {
+	cases := map[string]string{
+		EventUserCreated:              FamilyUser,
+		EventOrganizationNameChanged:  FamilyOrganization,
+		EventTicketStatusChanged:      FamilyTicket,
+		"zen:event-type:article.foo":  "article",
+		"conditional_ticket_events":   "",
+		"zen:event-type:user_created": "",
+	}
+	for eventType, expected := range cases {
+		if family := EventFamily(eventType); family != expected {
+			t.Errorf("expected family of %q is %q, but got %q", eventType, expected, family)
+		}
+	}
+}
+
+func TestDispatcher(t *testing.T) {
+	var (
+		user   *UserEvent
+		ticket *TicketEvent
+		raw    *Event
+	)
+	d := &Dispatcher{
+		User: func(ctx context.Context, e *UserEvent) error {
+			user = e
+			return nil
+		},
+		Ticket: func(ctx context.Context, e *TicketEvent) error {
+			ticket = e
+			return nil
+		},
+		Raw: func(ctx context.Context, e *Event) error {
+			raw = e
+			return nil
+		},
+	}
+
+	if err := d.Dispatch(ctx, userCreatedBody); err != nil {
+		t.Fatalf("Failed to dispatch user event: %s", err)
+	}
+	if user == nil || user.Type != EventUserCreated || user.Detail.Email != "john@example.com" {
+		t.Fatalf("unexpected user event %v", user)
+	}
+
+	if err := d.Dispatch(ctx, ticketStatusChangedBody); err != nil {
+		t.Fatalf("Failed to dispatch ticket event: %s", err)
+	}
+	if ticket == nil || ticket.Detail.ID != "35436" || ticket.Detail.Via.Channel != "web_service" {
+		t.Fatalf("unexpected ticket event %v", ticket)
+	}
+	var current, previous string
+	if err := ticket.Event.Decode(&current, &previous); err != nil {
+		t.Fatalf("Failed to decode change: %s", err)
+	}
+	if current != "OPEN" || previous != "NEW" {
+		t.Fatalf("expected change is NEW -> OPEN, but got %s -> %s", previous, current)
+	}
+
+	if err := d.Dispatch(ctx, articlePublishedBody); err != nil {
+		t.Fatalf("Failed to dispatch article event: %s", err)
+	}
+	if raw == nil || raw.Subject != "zen:article:360000123456" || string(raw.Event) != `{"author_id": "8447388090494", "locale": "en-us"}` {
+		t.Fatalf("unexpected raw event %v", raw)
+	}
+
+	// organization events have no handler, so they fall back to Raw
+	raw = nil
+	body := []byte(`{"type": "zen:event-type:organization.created", "detail": {"id": "1"}, "event": {}}`)
+	if err := d.Dispatch(ctx, body); err != nil {
+		t.Fatalf("Failed to dispatch organization event: %s", err)
+	}
+	if raw == nil || raw.Type != EventOrganizationCreated {
+		t.Fatalf("expected organization event to be passed to Raw, but got %v", raw)
+	}
+}
+
+func TestDispatcherHandler(t *testing.T) {
+	v := &Verifier{Secret: TestSigningSecret, Now: testNow}
+	d := &Dispatcher{
+		User: func(ctx context.Context, e *UserEvent) error {
+			return errors.New("failed to sync user")
+		},
+	}
+	h := d.Handler(v)
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, newSignedRequest(TestSigningSecret, userCreatedBody))
+	if w.Code != http.StatusInternalServerError {
+		t.Fatalf("expected status is %d, but got %d", http.StatusInternalServerError, w.Code)
+	}
+
+	// events without handler are acknowledged
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, newSignedRequest(TestSigningSecret, ticketStatusChangedBody))
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status is %d, but got %d", http.StatusOK, w.Code)
+	}
+}
+
+func TestDispatcherInvalidEvent(t *testing.T) {
+	// the ID of the ticket is a number instead of a string, so it can't be decoded into TicketEvent
+	body := []byte(`{"type": "zen:event-type:ticket.created", "detail": {"id": 35436}, "event": {}}`)
+
+	var raw *Event
+	d := &Dispatcher{
+		Ticket: func(ctx context.Context, e *TicketEvent) error {
+			t.Fatalf("unexpected ticket event %v", e)
+			return nil
+		},
+		Raw: func(ctx context.Context, e *Event) error {
+			raw = e
+			return nil
+		},
+	}
+	if err := d.Dispatch(ctx, body); err != nil {
+		t.Fatalf("Failed to dispatch ticket event: %s", err)
+	}
+	if raw == nil || raw.Type != EventTicketCreated || string(raw.Detail) != `{"id": 35436}` {
+		t.Fatalf("expected ticket event to be passed to Raw, but got %v", raw)
+	}
+
+	// without Raw, the event is rejected with 400 so that Zendesk doesn't retry it
+	d.Raw = nil
+	if err := d.Dispatch(ctx, body); !errors.Is(err, ErrInvalidPayload) {
+		t.Fatalf("expected error wraps %v, but got %v", ErrInvalidPayload, err)
+	}
+
+	w := httptest.NewRecorder()
+	d.Handler(&Verifier{Secret: TestSigningSecret, Now: testNow}).ServeHTTP(w, newSignedRequest(TestSigningSecret, body))
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status is %d, but got %d", http.StatusBadRequest, w.Code)
+	}
+}
@@ -0,0 +1,103 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+)
+
+// Middleware verifies requests before passing them to next. Requests which can't
+// be verified are rejected with 401 Unauthorized, and replayed requests are
+// acknowledged with 200 OK so that Zendesk doesn't retry them.
+// Requests which next responds to with a 5xx status are forgotten by the replay
+// cache, so that their retries are passed to next again.
+// The body of the request can be read again by next.
+func (v *Verifier) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, err := v.VerifyRequest(r); err != nil {
+			writeVerifyError(w, err)
+			return
+		}
+		rw := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(rw, r)
+		if rw.status >= http.StatusInternalServerError {
+			v.Forget(r)
+		}
+	})
+}
+
+// statusRecorder records the status written to the response
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (w *statusRecorder) WriteHeader(status int) {
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
+
+// ErrInvalidPayload is wrapped by the errors returned when a payload can't be decoded
+var ErrInvalidPayload = errors.New("webhook: invalid payload")
+
+// Handler verifies requests and decodes their JSON body into T before calling fn.
+// It responds with 400 Bad Request when the body can't be decoded or fn returns an
+// error wrapping ErrInvalidPayload, so that Zendesk doesn't retry a request which
+// can never succeed, and with 500 Internal Server Error when fn returns another
+// error so that Zendesk retries the request.
+// The request is then forgotten by the replay cache, so that its retry is accepted.
+// T is the payload defined in the trigger or automation notifying the webhook.
+// Use Dispatcher for event subscriptions.
+type Handler[T any] struct {
+	Verifier *Verifier
+	Func     func(ctx context.Context, payload T) error
+}
+
+// NewHandler creates a Handler calling fn with decoded payloads
+func NewHandler[T any](v *Verifier, fn func(ctx context.Context, payload T) error) *Handler[T] {
+	return &Handler[T]{Verifier: v, Func: fn}
+}
+
+// ServeHTTP implements http.Handler
+func (h *Handler[T]) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := h.Verifier.VerifyRequest(r)
+	if err != nil {
+		writeVerifyError(w, err)
+		return
+	}
+
+	var payload T
+	if err := json.Unmarshal(body, &payload); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := h.Func(r.Context(), payload); err != nil {
+		if errors.Is(err, ErrInvalidPayload) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		h.Verifier.Forget(r)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func writeVerifyError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, ErrReplayed):
+		w.WriteHeader(http.StatusOK)
+	case errors.Is(err, ErrMissingSignature), errors.Is(err, ErrInvalidSignature),
+		errors.Is(err, ErrInvalidTimestamp), errors.Is(err, ErrExpiredTimestamp):
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+	default:
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	}
+}
@@ -0,0 +1,144 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newSignedRequest(secret string, body []byte) *http.Request {
+	r := httptest.NewRequest(http.MethodPost, "/zendesk", bytes.NewReader(body))
+	r.Header.Set(SignatureHeader, Sign(secret, testTimestamp, body))
+	r.Header.Set(SignatureTimestampHeader, testTimestamp)
+	return r
+}
+
+func TestMiddleware(t *testing.T) {
+	v := &Verifier{Secret: TestSigningSecret, Replay: NewMemoryReplayCache(), Now: testNow}
+
+	calls := 0
+	h := v.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		body, _ := io.ReadAll(r.Body)
+		if !bytes.Equal(body, testBody) {
+			t.Errorf("unexpected body %s", body)
+		}
+	}))
+
+	cases := []struct {
+		name     string
+		request  *http.Request
+		expected int
+	}{
+		{"valid", newSignedRequest(TestSigningSecret, testBody), http.StatusOK},
+		{"replayed", newSignedRequest(TestSigningSecret, testBody), http.StatusOK},
+		{"invalid signature", newSignedRequest("secret", testBody), http.StatusUnauthorized},
+		{"unsigned", httptest.NewRequest(http.MethodPost, "/zendesk", bytes.NewReader(testBody)), http.StatusUnauthorized},
+	}
+	for _, c := range cases {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, c.request)
+		if w.Code != c.expected {
+			t.Errorf("%s: expected status is %d, but got %d", c.name, c.expected, w.Code)
+		}
+	}
+
+	if calls != 1 {
+		t.Fatalf("expected number of calls is 1, but got %d", calls)
+	}
+}
+
+func TestHandler(t *testing.T) {
+	type payload struct {
+		TicketID string `json:"ticket_id"`
+		Status   string `json:"status"`
+	}
+
+	v := &Verifier{Secret: TestSigningSecret, Now: testNow}
+
+	var received payload
+	h := NewHandler(v, func(ctx context.Context, p payload) error {
+		received = p
+		if p.Status == "closed" {
+			return errors.New("closed")
+		}
+		return nil
+	})
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, newSignedRequest(TestSigningSecret, testBody))
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status is 200, but got %d", w.Code)
+	}
+	if received.TicketID != "35436" || received.Status != "open" {
+		t.Fatalf("unexpected payload %+v", received)
+	}
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, newSignedRequest(TestSigningSecret, []byte(`{"ticket_id":"1","status":"closed"}`)))
+	if w.Code != http.StatusInternalServerError {
+		t.Fatalf("expected status is 500, but got %d", w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, newSignedRequest(TestSigningSecret, []byte(`not json`)))
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status is 400, but got %d", w.Code)
+	}
+}
+
+func TestMiddlewareRetryAfterFailure(t *testing.T) {
+	v := &Verifier{Secret: TestSigningSecret, Replay: NewMemoryReplayCache(), Now: testNow}
+
+	calls := 0
+	h := v.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+		}
+	}))
+
+	expected := []int{http.StatusServiceUnavailable, http.StatusOK, http.StatusOK}
+	for i, status := range expected {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, newSignedRequest(TestSigningSecret, testBody))
+		if w.Code != status {
+			t.Errorf("request %d: expected status is %d, but got %d", i, status, w.Code)
+		}
+	}
+
+	if calls != 2 {
+		t.Fatalf("expected number of calls is 2, but got %d", calls)
+	}
+}
+
+func TestHandlerRetryAfterFailure(t *testing.T) {
+	v := &Verifier{Secret: TestSigningSecret, Replay: NewMemoryReplayCache(), Now: testNow}
+
+	calls := 0
+	h := NewHandler(v, func(ctx context.Context, p json.RawMessage) error {
+		calls++
+		if calls == 1 {
+			return errors.New("database is unavailable")
+		}
+		return nil
+	})
+
+	expected := []int{http.StatusInternalServerError, http.StatusOK, http.StatusOK}
+	for i, status := range expected {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, newSignedRequest(TestSigningSecret, testBody))
+		if w.Code != status {
+			t.Errorf("request %d: expected status is %d, but got %d", i, status, w.Code)
+		}
+	}
+
+	if calls != 2 {
+		t.Fatalf("expected number of calls is 2, but got %d", calls)
+	}
+}
@@ -0,0 +1,200 @@
+// Package webhook verifies and decodes the requests sent by Zendesk webhooks.
+//
+// Zendesk signs each request with the signing secret of the webhook, which can be
+// fetched with zendesk.Client.GetWebhookSigningSecret:
+//
+//	secret, err := client.GetWebhookSigningSecret(ctx, webhookID)
+//	...
+//	v := &webhook.Verifier{Secret: secret.Secret, Replay: webhook.NewMemoryReplayCache()}
+//	http.Handle("/zendesk", v.Middleware(handler))
+//
+// Events of webhooks subscribed to Zendesk events, such as zen:event-type:ticket.created,
+// are decoded by Dispatcher.
+//
+// ref: https://developer.zendesk.com/documentation/webhooks/verifying/
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Headers set by Zendesk on webhook requests
+const (
+	SignatureHeader          = "X-Zendesk-Webhook-Signature"
+	SignatureTimestampHeader = "X-Zendesk-Webhook-Signature-Timestamp"
+)
+
+// TestSigningSecret is the secret used to sign the requests sent by the test webhook endpoint
+// and by webhooks which are not created yet
+const TestSigningSecret = "dGhpc19zZWNyZXRfaXNfZm9yX3Rlc3Rpbmdfb25seQ=="
+
+// DefaultTolerance is the maximum age of a request accepted by default
+const DefaultTolerance = 5 * time.Minute
+
+// Errors returned when a request can't be verified
+var (
+	ErrMissingSignature = errors.New("webhook: missing signature")
+	ErrInvalidSignature = errors.New("webhook: invalid signature")
+	ErrInvalidTimestamp = errors.New("webhook: invalid signature timestamp")
+	ErrExpiredTimestamp = errors.New("webhook: signature timestamp is out of tolerance")
+	ErrReplayed         = errors.New("webhook: request has already been received")
+)
+
+// Sign returns the signature of the body sent at timestamp,
+// which is the base64 encoded HMAC-SHA256 of the timestamp followed by the body
+func Sign(secret string, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write(body)
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks the signature of the body with the secret, and that the timestamp
+// is within DefaultTolerance of the current time.
+// Use Verifier to change the tolerance or to reject replayed requests.
+func Verify(secret string, signature string, timestamp string, body []byte) error {
+	v := &Verifier{Secret: secret}
+	return v.Verify(signature, timestamp, body)
+}
+
+// ReplayCache remembers the signatures of received requests
+type ReplayCache interface {
+	// Seen reports whether the signature has been seen before, and remembers it for ttl otherwise
+	Seen(signature string, ttl time.Duration) bool
+
+	// Forget removes the signature, so that the request is accepted again when it's retried
+	Forget(signature string)
+}
+
+// Verifier verifies the signature of webhook requests
+type Verifier struct {
+	// Secret is the signing secret of the webhook
+	Secret string
+
+	// Tolerance is the maximum difference between the signature timestamp and the
+	// current time. DefaultTolerance is used if it's zero, and the timestamp is not
+	// checked if it's negative.
+	Tolerance time.Duration
+
+	// Replay rejects requests which have already been received. Replayed requests
+	// are accepted if it's nil.
+	Replay ReplayCache
+
+	// Now returns the current time. time.Now is used if it's nil.
+	Now func() time.Time
+}
+
+// Verify checks the signature and the timestamp of the body
+func (v *Verifier) Verify(signature string, timestamp string, body []byte) error {
+	if signature == "" || timestamp == "" {
+		return ErrMissingSignature
+	}
+
+	expected := Sign(v.Secret, timestamp, body)
+	if !hmac.Equal([]byte(signature), []byte(expected)) {
+		return ErrInvalidSignature
+	}
+
+	t, err := time.Parse(time.RFC3339, timestamp)
+	if err != nil {
+		return ErrInvalidTimestamp
+	}
+
+	now := time.Now()
+	if v.Now != nil {
+		now = v.Now()
+	}
+
+	tolerance := v.Tolerance
+	if tolerance == 0 {
+		tolerance = DefaultTolerance
+	}
+	if tolerance > 0 {
+		if d := now.Sub(t); d > tolerance || d < -tolerance {
+			return ErrExpiredTimestamp
+		}
+	}
+
+	if v.Replay != nil {
+		// a signature can be accepted while its timestamp is within the tolerance on either side
+		ttl := 2 * tolerance
+		if tolerance < 0 {
+			ttl = 2 * DefaultTolerance
+		}
+		if v.Replay.Seen(signature, ttl) {
+			return ErrReplayed
+		}
+	}
+	return nil
+}
+
+// Forget removes the signature of the request from the replay cache. It's called
+// when the request fails to be handled, so that the retry by Zendesk isn't rejected.
+func (v *Verifier) Forget(r *http.Request) {
+	if v.Replay != nil {
+		v.Replay.Forget(r.Header.Get(SignatureHeader))
+	}
+}
+
+// VerifyRequest verifies the request and returns its body.
+// The body of the request is replaced so that it can be read again.
+func (v *Verifier) VerifyRequest(r *http.Request) ([]byte, error) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	r.Body.Close()
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	err = v.Verify(r.Header.Get(SignatureHeader), r.Header.Get(SignatureTimestampHeader), body)
+	if err != nil {
+		return nil, err
+	}
+	return body, nil
+}
+
+// MemoryReplayCache is a ReplayCache kept in memory. It only protects a single process.
+type MemoryReplayCache struct {
+	mu      sync.Mutex
+	entries map[string]time.Time
+	now     func() time.Time
+}
+
+// NewMemoryReplayCache creates an empty MemoryReplayCache
+func NewMemoryReplayCache() *MemoryReplayCache {
+	return &MemoryReplayCache{entries: make(map[string]time.Time), now: time.Now}
+}
+
+// Seen implements ReplayCache. Expired signatures are removed on each call.
+func (c *MemoryReplayCache) Seen(signature string, ttl time.Duration) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	for s, e := range c.entries {
+		if now.After(e) {
+			delete(c.entries, s)
+		}
+	}
+
+	if _, ok := c.entries[signature]; ok {
+		return true
+	}
+	c.entries[signature] = now.Add(ttl)
+	return false
+}
+
+// Forget implements ReplayCache
+func (c *MemoryReplayCache) Forget(signature string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, signature)
+}
@@ -0,0 +1,91 @@
+package webhook
+
+import (
+	"testing"
+	"time"
+)
+
+var (
+	testBody      = []byte(`{"ticket_id":"35436","status":"open"}`)
+	testTimestamp = "2021-10-20T08:16:28Z"
+	testNow       = func() time.Time { return time.Date(2021, 10, 20, 8, 17, 0, 0, time.UTC) }
+)
+
+func TestSign(t *testing.T) {
+	// The signature computed by `echo -n "$timestamp$body" | openssl dgst -sha256 -hmac "$secret" -binary | base64`
+	expected := "EVXFN9ZbsCtBW7IdSgkIZmuXV9n8xFDTa8zWSuOCLgg="
+	if signature := Sign(TestSigningSecret, testTimestamp, testBody); signature != expected {
+		t.Fatalf("expected signature is %s, but got %s", expected, signature)
+	}
+}
+
+func TestVerifier(t *testing.T) {
+	signature := Sign(TestSigningSecret, testTimestamp, testBody)
+
+	cases := []struct {
+		name      string
+		secret    string
+		signature string
+		timestamp string
+		body      []byte
+		tolerance time.Duration
+		expected  error
+	}{
+		{"valid", TestSigningSecret, signature, testTimestamp, testBody, 0, nil},
+		{"missing signature", TestSigningSecret, "", testTimestamp, testBody, 0, ErrMissingSignature},
+		{"wrong secret", "secret", signature, testTimestamp, testBody, 0, ErrInvalidSignature},
+		{"tampered body", TestSigningSecret, signature, testTimestamp, []byte(`{}`), 0, ErrInvalidSignature},
+		{"expired", TestSigningSecret, signature, testTimestamp, testBody, 10 * time.Second, ErrExpiredTimestamp},
+		{"tolerance disabled", TestSigningSecret, signature, testTimestamp, testBody, -1, nil},
+		{"invalid timestamp", TestSigningSecret, Sign(TestSigningSecret, "yesterday", testBody), "yesterday", testBody, 0, ErrInvalidTimestamp},
+	}
+
+	for _, c := range cases {
+		v := &Verifier{Secret: c.secret, Tolerance: c.tolerance, Now: testNow}
+		if err := v.Verify(c.signature, c.timestamp, c.body); err != c.expected {
+			t.Errorf("%s: expected error is %v, but got %v", c.name, c.expected, err)
+		}
+	}
+}
+
+func TestVerifierReplay(t *testing.T) {
+	v := &Verifier{Secret: TestSigningSecret, Replay: NewMemoryReplayCache(), Now: testNow}
+	signature := Sign(TestSigningSecret, testTimestamp, testBody)
+
+	if err := v.Verify(signature, testTimestamp, testBody); err != nil {
+		t.Fatalf("Failed to verify: %s", err)
+	}
+	if err := v.Verify(signature, testTimestamp, testBody); err != ErrReplayed {
+		t.Fatalf("expected error is %v, but got %v", ErrReplayed, err)
+	}
+}
+
+func TestMemoryReplayCacheExpiry(t *testing.T) {
+	now := time.Date(2021, 10, 20, 8, 0, 0, 0, time.UTC)
+	c := NewMemoryReplayCache()
+	c.now = func() time.Time { return now }
+
+	if c.Seen("signature", time.Minute) {
+		t.Fatalf("signature has not been seen yet")
+	}
+	if !c.Seen("signature", time.Minute) {
+		t.Fatalf("signature has been seen")
+	}
+
+	now = now.Add(2 * time.Minute)
+	if c.Seen("signature", time.Minute) {
+		t.Fatalf("signature has expired")
+	}
+}
+
+func TestMemoryReplayCacheForget(t *testing.T) {
+	c := NewMemoryReplayCache()
+
+	if c.Seen("signature", time.Minute) {
+		t.Fatalf("signature has not been seen yet")
+	}
+	c.Forget("signature")
+	if c.Seen("signature", time.Minute) {
+		t.Fatalf("signature has been forgotten")
+	}
+}
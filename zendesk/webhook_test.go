@@ -2,11 +2,42 @@ package zendesk
 
 import (
 	"context"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"path/filepath"
 	"testing"
+	"time"
 )
 
+func TestGetWebhooks(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/webhooks" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		if q := r.URL.Query(); q.Get("filter[status]") != "active" || q.Get("page[size]") != "2" {
+			t.Errorf("unexpected query %s", r.URL.RawQuery)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "webhooks.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	opts := &WebhookListOptions{Status: "active"}
+	opts.PageSize = 2
+	hooks, meta, err := client.GetWebhooks(ctx, opts)
+	if err != nil {
+		t.Fatalf("Failed to get webhooks: %s", err)
+	}
+
+	if len(hooks) != 2 {
+		t.Fatalf("expected length of webhooks is 2, but got %d", len(hooks))
+	}
+	if !meta.HasMore || meta.AfterCursor == "" {
+		t.Fatalf("unexpected meta %+v", meta)
+	}
+}
+
 func TestCreateWebhook(t *testing.T) {
 	mockAPI := newMockAPI(http.MethodPost, "webhooks.json")
 	client := newTestClient(mockAPI)
@@ -80,3 +111,126 @@ func TestDeleteWebhook(t *testing.T) {
 		t.Fatalf("Failed to delete webhook: %s", err)
 	}
 }
+
+func TestCloneWebhook(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Query().Get("clone_webhook_id") != "01EJFTSCC78X5V07NPY2MHR00M" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write(readFixture(filepath.Join(http.MethodPost, "webhooks.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	hook, err := client.CloneWebhook(ctx, "01EJFTSCC78X5V07NPY2MHR00M")
+	if err != nil {
+		t.Fatalf("Failed to clone webhook: %s", err)
+	}
+	if hook.ID == "" {
+		t.Fatalf("Cloned webhook does not have an ID")
+	}
+}
+
+func TestPatchWebhook(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.Method != http.MethodPatch || string(body) != `{"webhook":{"status":"inactive"}}` {
+			t.Errorf("unexpected request %s %s", r.Method, body)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	status := "inactive"
+	err := client.PatchWebhook(ctx, "01EJFTSCC78X5V07NPY2MHR00M", &WebhookPatch{Status: &status})
+	if err != nil {
+		t.Fatalf("Failed to patch webhook: %s", err)
+	}
+}
+
+func TestTestWebhook(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/webhooks/test" || r.URL.Query().Get("webhook_id") != "01EJFTSCC78X5V07NPY2MHR00M" {
+			t.Errorf("unexpected request %s", r.URL)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodPost, "webhook_test.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	resp, err := client.TestWebhook(ctx, "01EJFTSCC78X5V07NPY2MHR00M", &WebhookTestRequest{
+		Payload: `{"ticket_id":"35436"}`,
+	})
+	if err != nil {
+		t.Fatalf("Failed to test webhook: %s", err)
+	}
+	if resp.Status != http.StatusOK || len(resp.Headers) != 1 {
+		t.Fatalf("unexpected test response %v", resp)
+	}
+}
+
+func TestResetWebhookSigningSecret(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodPost, "webhook_signing_secret.json", http.StatusCreated)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	secret, err := client.ResetWebhookSigningSecret(ctx, "01EJFTSCC78X5V07NPY2MHR00M")
+	if err != nil {
+		t.Fatalf("Failed to reset signing secret: %s", err)
+	}
+	if secret.Algorithm != "SHA256" || secret.Secret == "" {
+		t.Fatalf("unexpected signing secret %v", secret)
+	}
+}
+
+func TestGetWebhookInvocations(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/webhooks/01EJFTSCC78X5V07NPY2MHR00M/invocations" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		if q := r.URL.Query(); q.Get("filter[status]") != "failed" || q.Get("filter[from_ts]") != "2022-11-10T00:00:00Z" {
+			t.Errorf("unexpected query %s", r.URL.RawQuery)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "webhook_invocations.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	invocations, _, err := client.GetWebhookInvocations(ctx, "01EJFTSCC78X5V07NPY2MHR00M", &WebhookInvocationListOptions{
+		FromTS: time.Date(2022, 11, 10, 0, 0, 0, 0, time.UTC),
+		Status: WebhookInvocationFailed,
+	})
+	if err != nil {
+		t.Fatalf("Failed to get webhook invocations: %s", err)
+	}
+
+	if len(invocations) != 2 {
+		t.Fatalf("expected length of invocations is 2, but got %d", len(invocations))
+	}
+	if invocations[0].Status != WebhookInvocationFailed || invocations[0].StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("unexpected invocation %v", invocations[0])
+	}
+}
+
+func TestGetWebhookInvocationAttempts(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "webhook_invocation_attempts.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	attempts, err := client.GetWebhookInvocationAttempts(ctx, "01EJFTSCC78X5V07NPY2MHR00M", "01GHF4FR5GXHPF7Q0Q4XHG0B63")
+	if err != nil {
+		t.Fatalf("Failed to get invocation attempts: %s", err)
+	}
+
+	if len(attempts) != 2 {
+		t.Fatalf("expected length of attempts is 2, but got %d", len(attempts))
+	}
+	if d := attempts[0].LatencyDuration(); d != 10012*time.Millisecond {
+		t.Fatalf("expected latency is 10.012s, but got %s", d)
+	}
+	if attempts[0].Response == nil || attempts[0].Response.Status != http.StatusServiceUnavailable {
+		t.Fatalf("unexpected response %v", attempts[0].Response)
+	}
+}